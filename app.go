@@ -0,0 +1,194 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authorize
+
+import (
+	"sort"
+
+	"github.com/caddyserver/caddy/v2"
+	"github.com/caddyserver/caddy/v2/caddyconfig"
+	"github.com/caddyserver/caddy/v2/caddyconfig/caddyfile"
+	"github.com/caddyserver/caddy/v2/caddyconfig/httpcaddyfile"
+	"github.com/greenpau/caddy-authorize/pkg/authz"
+	"github.com/greenpau/caddy-authorize/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const appName = "authorization"
+
+func init() {
+	caddy.RegisterModule(App{})
+	httpcaddyfile.RegisterGlobalOption(appName, parseCaddyfileGlobalOption)
+}
+
+// App holds the named authorization policies. The authorize handlers
+// reference the policies by name, e.g. authorize with <name>. Each policy is
+// the primary instance of its own context, and it is provisioned with its
+// own instance manager, so that its settings do not depend on the other
+// instances of the plugin.
+type App struct {
+	Policies map[string]*authz.Authorizer `json:"policies,omitempty" xml:"policies,omitempty" yaml:"policies,omitempty"`
+	manager  *authz.InstanceManager
+}
+
+// CaddyModule returns the Caddy module information.
+func (App) CaddyModule() caddy.ModuleInfo {
+	return caddy.ModuleInfo{
+		ID:  appName,
+		New: func() caddy.Module { return new(App) },
+	}
+}
+
+// Provision provisions the named authorization policies.
+func (a *App) Provision(ctx caddy.Context) error {
+	a.manager = authz.NewInstanceManager()
+	authz.RegisterInstanceManager(a.manager)
+	logger := ctx.Logger(a)
+	for _, name := range a.getPolicyNames() {
+		p := a.Policies[name]
+		if p == nil {
+			return errors.ErrPolicyNil.WithArgs(name)
+		}
+		if p.Context != "" && p.Context != name {
+			return errors.ErrPolicyScopedConfig.WithArgs(name)
+		}
+		p.Name = name
+		p.Context = name
+		p.PrimaryInstance = true
+		opts := map[string]interface{}{
+			"logger":  logger.With(zap.String("policy", name)),
+			"context": ctx.Context,
+		}
+		if err := p.ProvisionWithManager(a.manager, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate implements caddy.Validator.
+func (a *App) Validate() error {
+	for _, name := range a.getPolicyNames() {
+		if err := a.Policies[name].ValidateWithManager(a.manager); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Start implements caddy.App.
+func (a *App) Start() error {
+	return nil
+}
+
+// Stop implements caddy.App.
+func (a *App) Stop() error {
+	return nil
+}
+
+// Cleanup implements caddy.CleanerUpper.
+func (a *App) Cleanup() error {
+	if a.manager != nil {
+		authz.UnregisterInstanceManager(a.manager)
+	}
+	for _, p := range a.Policies {
+		if p == nil {
+			continue
+		}
+		if err := p.Cleanup(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getPolicy returns the provisioned policy with the name.
+func (a *App) getPolicy(name string) (*authz.Authorizer, error) {
+	p, exists := a.Policies[name]
+	if !exists {
+		return nil, errors.ErrPolicyNotFound.WithArgs(name)
+	}
+	if p == nil {
+		return nil, errors.ErrPolicyNil.WithArgs(name)
+	}
+	return p, nil
+}
+
+func (a *App) getPolicyNames() []string {
+	var names []string
+	for name := range a.Policies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// parseCaddyfileGlobalOption parses the authorization global option. Syntax:
+//
+//     authorization {
+//       policy <name> {
+//         <authorize directives>
+//       }
+//     }
+//
+// The policy block supports the directives of the authorize block, except
+// the primary and context directives.
+func parseCaddyfileGlobalOption(d *caddyfile.Dispenser, _ interface{}) (interface{}, error) {
+	app := &App{
+		Policies: make(map[string]*authz.Authorizer),
+	}
+	for d.Next() {
+		if d.NextArg() {
+			return nil, d.ArgErr()
+		}
+		for nesting := d.Nesting(); d.NextBlock(nesting); {
+			switch d.Val() {
+			case "policy":
+				if !d.NextArg() {
+					return nil, d.ArgErr()
+				}
+				name := d.Val()
+				if _, exists := app.Policies[name]; exists {
+					return nil, d.Errf("%s policy %q is duplicate", appName, name)
+				}
+				// The segment begins with the name of the policy, followed by
+				// the block parsed as the authorize block.
+				p, err := parseCaddyfile(httpcaddyfile.Helper{Dispenser: d.NewFromNextSegment()})
+				if err != nil {
+					return nil, err
+				}
+				if p.PrimaryInstance || p.Context != "default" {
+					return nil, d.Errf("%v", errors.ErrPolicyScopedConfig.WithArgs(name))
+				}
+				p.Context = name
+				app.Policies[name] = p
+			default:
+				return nil, d.Errf("%s directive %q is unsupported", appName, d.Val())
+			}
+		}
+	}
+	return httpcaddyfile.App{
+		Name:  appName,
+		Value: caddyconfig.JSON(app, nil),
+	}, nil
+}
+
+// Interface guards
+var (
+	_ caddy.App          = (*App)(nil)
+	_ caddy.Provisioner  = (*App)(nil)
+	_ caddy.Validator    = (*App)(nil)
+	_ caddy.CleanerUpper = (*App)(nil)
+)
@@ -0,0 +1,197 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authorize
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/caddyserver/caddy/v2"
+	"github.com/caddyserver/caddy/v2/caddyconfig/caddyfile"
+	"github.com/caddyserver/caddy/v2/caddyconfig/httpcaddyfile"
+	"github.com/greenpau/caddy-authorize/internal/tests"
+	"github.com/greenpau/caddy-authorize/pkg/authz"
+	"github.com/greenpau/caddy-authorize/pkg/errors"
+	"testing"
+)
+
+func TestParseCaddyfileGlobalOption(t *testing.T) {
+	var testcases = []struct {
+		name      string
+		config    string
+		want      []string
+		shouldErr bool
+		err       error
+	}{
+		{
+			name: "parse named policies",
+			config: `
+            authorization {
+                policy users {
+                    crypto key verify foobar
+                    allow roles authp/user
+                }
+                policy admins {
+                    crypto key verify foobar
+                    allow roles authp/admin
+                }
+            }`,
+			want: []string{"admins", "users"},
+		},
+		{
+			name: "parse duplicate policy",
+			config: `
+            authorization {
+                policy users {
+                    allow roles authp/user
+                }
+                policy users {
+                    allow roles authp/admin
+                }
+            }`,
+			shouldErr: true,
+			err:       fmt.Errorf(`Testfile:6 - Error during parsing: authorization policy "users" is duplicate`),
+		},
+		{
+			name: "parse policy with context",
+			config: `
+            authorization {
+                policy users {
+                    context users
+                    allow roles authp/user
+                }
+            }`,
+			shouldErr: true,
+			err: fmt.Errorf(
+				"Testfile:6 - Error during parsing: %v",
+				errors.ErrPolicyScopedConfig.WithArgs("users"),
+			),
+		},
+		{
+			name: "parse unsupported directive",
+			config: `
+            authorization {
+                foo bar
+            }`,
+			shouldErr: true,
+			err:       fmt.Errorf(`Testfile:3 - Error during parsing: authorization directive "foo" is unsupported`),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			v, err := parseCaddyfileGlobalOption(caddyfile.NewTestDispenser(tc.config), nil)
+			if tests.EvalErr(t, err, tc.config, tc.shouldErr, tc.err) {
+				return
+			}
+			app := &App{}
+			if err := json.Unmarshal(v.(httpcaddyfile.App).Value, app); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			tests.EvalObjects(t, "policies", tc.want, app.getPolicyNames())
+			for _, name := range tc.want {
+				tests.EvalObjects(t, "context", name, app.Policies[name].Context)
+			}
+		})
+	}
+}
+
+func TestParseAuthorizeDirective(t *testing.T) {
+	var testcases = []struct {
+		name      string
+		config    string
+		want      string
+		shouldErr bool
+		err       error
+	}{
+		{
+			name:   "reference named policy",
+			config: `authorize with users`,
+			want:   "users",
+		},
+		{
+			name: "reference named policy with block",
+			config: `
+            authorize with users {
+                allow roles authp/user
+            }`,
+			shouldErr: true,
+			err: fmt.Errorf(
+				"Testfile:3 - Error during parsing: %v",
+				errors.ErrPolicyMixedConfig.WithArgs("users"),
+			),
+		},
+		{
+			name:      "reference named policy without name",
+			config:    `authorize with`,
+			shouldErr: true,
+			err:       fmt.Errorf(`Testfile:1 - Error during parsing: authorize directive "with" is unsupported`),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			mw, err := parseAuthorizeDirective(httpcaddyfile.Helper{Dispenser: caddyfile.NewTestDispenser(tc.config)})
+			if tests.EvalErr(t, err, tc.config, tc.shouldErr, tc.err) {
+				return
+			}
+			tests.EvalObjects(t, "policy", tc.want, mw.Policy)
+			if mw.Authorizer != nil {
+				t.Fatalf("unexpected authorizer in %q", tc.config)
+			}
+		})
+	}
+}
+
+func TestAppProvision(t *testing.T) {
+	ctx, cancel := caddy.NewContext(caddy.Context{Context: context.Background()})
+	defer cancel()
+	v, err := parseCaddyfileGlobalOption(caddyfile.NewTestDispenser(`
+    authorization {
+        policy users {
+            crypto key verify foobar
+            allow roles authp/user
+        }
+    }`), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	app := &App{}
+	if err := json.Unmarshal(v.(httpcaddyfile.App).Value, app); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := app.Provision(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := app.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer app.Cleanup()
+
+	m, err := app.getPolicy("users")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tests.EvalObjects(t, "name", "users", m.Name)
+	result, err := m.Eval(&authz.EvalRequest{
+		Claims: map[string]interface{}{"roles": []interface{}{"authp/user"}},
+		URL:    "https://app.contoso.com/",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tests.EvalObjects(t, "allow", true, result.Allow)
+
+	_, err = app.getPolicy("admins")
+	tests.EvalErr(t, err, "admins", true, errors.ErrPolicyNotFound.WithArgs("admins"))
+}
@@ -15,6 +15,7 @@
 package authorize
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -26,7 +27,9 @@ import (
 
 	"github.com/greenpau/caddy-authorize/pkg/acl"
 	"github.com/greenpau/caddy-authorize/pkg/authz"
+	"github.com/greenpau/caddy-authorize/pkg/errors"
 	"github.com/greenpau/caddy-authorize/pkg/kms"
+	"github.com/greenpau/caddy-authorize/pkg/user"
 	"github.com/greenpau/caddy-authorize/pkg/utils/cfgutils"
 	// "go.uber.org/zap"
 )
@@ -64,9 +67,17 @@ func init() {
 //       set user identity <claim_field>
 //       set redirect query parameter <value>
 //       set redirect status <3xx>
+//       set <unauthorized|forbidden|redirect> template <path>
+//       set trusted proxies <ip|cidr> ... <ip|cidr>
+//       set redirect hosts <host> ... <host>
+//       set auth url hosts <host> ... <host>
+//       set cookie domain <domain> [for <TOKEN_NAME>]
+//       set cookie path <path> [for <TOKEN_NAME>]
+//       set cookie samesite <lax|strict|none> [for <TOKEN_NAME>]
 //
 //       disable auth redirect query
 //       disable auth redirect
+//       disable cookie httponly [for <TOKEN_NAME>]
 //
 //       allow <field> <value...>
 //       allow <field> <value...> with <get|post|put|patch|delete> to <uri>
@@ -78,26 +89,79 @@ func init() {
 //         [exact|partial|prefix|suffix|regex|always] match <field> <value> ... <valueN>
 //         [exact|partial|prefix|suffix|regex|always] match method <http_method_name>
 //         [exact|partial|prefix|suffix|regex|always] match path <http_path_uri>
+//         [exact|partial|prefix|suffix|regex|always] match host <http_host>
+//         [exact|partial|prefix|suffix|regex|always] match scheme <http|https>
+//         [exact|partial|prefix|suffix|regex|always] match header.<http_header_name> <value> ... <valueN>
+//         [exact|partial|prefix|suffix|regex|always] match query.<http_query_parameter_name> <value> ... <valueN>
+//         [exact|partial|prefix|suffix|regex|always] match claim.<dotted.claim.path> <value> ... <valueN>
+//         not <condition>
+//         [not] <any|all> {
+//           <condition>
+//         }
 //         <allow|deny> [stop] [counter] [log <error|warn|info|debug>]
 //       }
 //
+//       acl file <path/to/rules.json|rules.yaml>
+//
+//       acl policy <name> {
+//         crypto key <verify|sign-verify|auto> <SHARED_SECRET>
+//         crypto key <ID> <verify|sign-verify|auto> from <directory|file> <PATH>
+//         set token sources <value...>
+//         validate <path acl|source address|bearer header>
+//         allow <field> <value...> [with <method>] [to <uri>]
+//         deny <field> <value...> [with <method>] [to <uri>]
+//         acl rule {
+//           <condition>
+//           <allow|deny> [stop] [counter] [log <error|warn|info|debug>]
+//         }
+//       }
+//       acl route <path_prefix> [with <method> ... <method>] policy <name>
+//
+//       role hierarchy {
+//         <role_name> implies <role_name> ... <role_name>
+//         <alias_name> alias of <role_name>
+//       }
+//
 //       validate path acl
 //       validate source address
 //       validate bearer header
 //
 //       enable js redirect
 //       enable strip token
+//       enable acl trace [header <name> roles <role_name> ... <role_name>]
+//       enable session refresh window <SECONDS> max age <SECONDS> [lifetime <SECONDS>]
+//       enable cookie secure [for <TOKEN_NAME>]
+//
+//       shadow acl rule {
+//         <condition>
+//         <allow|deny> [stop] [counter] [log <error|warn|info|debug>]
+//       }
+//       shadow acl default <allow|deny>
+//       shadow <allow|deny> <field> <value> ... [with <method> [to <path>]]
 //
 //       bypass uri <exact|partial|prefix|suffix|regex> <uri_path>
 //
 //       inject headers with claims
 //
-//       inject header <header_name> from <field_name>
+//       inject header <header_name> from <field_name> [separator <separator>] [when roles <role_name> ...]
+//       inject header <header_name> template <template> [separator <separator>] [when roles <role_name> ...]
+//       inject header <header_name> base64 json from <field_name> ... [when roles <role_name> ...]
+//
+//       remove header <header_name> ...
+//
+//       upstream token crypto key <ID> <sign|sign-verify> <SHARED_SECRET>
+//       upstream token crypto key <ID> <sign|sign-verify> from <directory|file> <PATH>
+//       upstream token audience <audience> ...
+//       upstream token issuer <issuer>
+//       upstream token claims <field_name> ...
+//       upstream token lifetime <SECONDS>
+//       upstream token sign method <method>
 //     }
 //
 func parseCaddyfile(h httpcaddyfile.Helper) (*authz.Authorizer, error) {
 	var cryptoKeyConfig, cryptoKeyStoreConfig []string
 	var cryptoKeyConfigFound, cryptoKeyStoreConfigFound bool
+	var upstreamCryptoKeyConfig []string
 	p := authz.Authorizer{
 		PrimaryInstance:  false,
 		Context:          "default",
@@ -145,109 +209,85 @@ func parseCaddyfile(h httpcaddyfile.Helper) (*authz.Authorizer, error) {
 				}
 			case "acl":
 				args := h.RemainingArgs()
-				if len(args) == 0 {
-					return nil, h.Errf("%s directive has no value", rootDirective)
-				}
-				switch args[0] {
-				case "rule":
-					if len(args) > 1 {
-						return nil, h.Errf("%s directive %q is too long", rootDirective, strings.Join(args, " "))
+				if len(args) > 0 && args[0] == "file" {
+					if len(args) != 2 {
+						return nil, h.Errf("%s directive %q is invalid", rootDirective, cfgutils.EncodeArgs(args))
 					}
-					rule := &acl.RuleConfiguration{}
-					for subNesting := h.Nesting(); h.NextBlock(subNesting); {
-						k := h.Val()
-						rargs := h.RemainingArgs()
-						if len(args) == 0 {
-							return nil, h.Errf("%s %s directive %v has no values", rootDirective, args[0], k)
-						}
-						rargs = append([]string{k}, rargs...)
-						switch k {
-						case "comment":
-							rule.Comment = cfgutils.EncodeArgs(rargs)
-						case "allow", "deny":
-							rule.Action = cfgutils.EncodeArgs(rargs)
-						default:
-							rule.Conditions = append(rule.Conditions, cfgutils.EncodeArgs(rargs))
-						}
+					if p.AccessListFile != "" {
+						return nil, h.Errf("%s directive %q is duplicate", rootDirective, cfgutils.EncodeArgs(args))
 					}
-					p.AccessListRules = append(p.AccessListRules, rule)
-				case "default":
+					p.AccessListFile = args[1]
+					continue
+				}
+				if len(args) > 0 && args[0] == "policy" {
 					if len(args) != 2 {
-						return nil, h.Errf("%s directive %q is too long", rootDirective, strings.Join(args, " "))
+						return nil, h.Errf("%s directive %q is invalid", rootDirective, cfgutils.EncodeArgs(args))
 					}
-					rule := &acl.RuleConfiguration{
-						Conditions: []string{"always match iss any"},
+					policy, err := parseACLPolicy(h, repl, rootDirective, args[1])
+					if err != nil {
+						return nil, err
 					}
-					switch args[1] {
-					case "allow", "deny":
-						rule.Action = args[1]
-					default:
-						return nil, h.Errf("%s directive %q must have either allow or deny", rootDirective, strings.Join(args, " "))
+					p.AccessListPolicies = append(p.AccessListPolicies, policy)
+					continue
+				}
+				if len(args) > 0 && args[0] == "route" {
+					route, err := parseACLRoute(args[1:])
+					if err != nil {
+						return nil, h.Errf("%s directive %q is invalid: %v", rootDirective, cfgutils.EncodeArgs(args), err)
 					}
-					p.AccessListRules = append(p.AccessListRules, rule)
-				default:
-					return nil, h.Errf("%s directive value of %q is unsupported", rootDirective, strings.Join(args, " "))
+					p.AccessListRoutes = append(p.AccessListRoutes, route)
+					continue
 				}
+				rule, err := parseACLDirective(h, rootDirective, args)
+				if err != nil {
+					return nil, err
+				}
+				p.AccessListRules = append(p.AccessListRules, rule)
 			case "allow", "deny":
+				rule, methodPathFound, err := parseACLShortcut(h, rootDirective, rootDirective, h.RemainingArgs())
+				if err != nil {
+					return nil, err
+				}
+				if methodPathFound {
+					p.ValidateMethodPath = true
+				}
+				p.AccessListRules = append(p.AccessListRules, rule)
+			case "shadow":
 				args := h.RemainingArgs()
 				if len(args) == 0 {
 					return nil, h.Errf("%s directive has no value", rootDirective)
 				}
-				if len(args) < 2 {
-					return nil, h.Errf("%s directive %q is too short", rootDirective, strings.Join(args, " "))
-				}
-				rule := &acl.RuleConfiguration{}
-				// rule.Action = cfgutils.EncodeArgs([]string{rootDirective, "log", "warn"})
-
-				mode := "field"
-				var cond []string
-				var matchMethod, matchPath string
-				var matchAlways bool
-				for _, arg := range args {
-					switch arg {
-					case "with":
-						mode = "method"
-						continue
-					case "to":
-						mode = "path"
-						continue
-					}
-					switch mode {
-					case "field":
-						if arg == "*" || arg == "any" {
-							matchAlways = true
-						}
-						cond = append(cond, arg)
-					case "method":
-						matchMethod = strings.ToUpper(arg)
-						mode = "path"
-					case "path":
-						matchPath = arg
-						mode = "complete"
-					default:
-						return nil, h.Errf("%s directive value of %q is unsupported", rootDirective, strings.Join(args, " "))
-					}
+				var rule *acl.RuleConfiguration
+				var methodPathFound bool
+				var err error
+				switch args[0] {
+				case "acl":
+					rule, err = parseACLDirective(h, rootDirective+" "+args[0], args[1:])
+				case "allow", "deny":
+					rule, methodPathFound, err = parseACLShortcut(h, rootDirective+" "+args[0], args[0], args[1:])
+				default:
+					return nil, h.Errf("%s directive value of %q is unsupported", rootDirective, strings.Join(args, " "))
 				}
-				if matchAlways {
-					rule.Conditions = append(rule.Conditions, cfgutils.EncodeArgs(append([]string{"always", "match"}, cond...)))
-				} else {
-					rule.Conditions = append(rule.Conditions, cfgutils.EncodeArgs(append([]string{"match"}, cond...)))
+				if err != nil {
+					return nil, err
 				}
-				if matchMethod != "" {
-					rule.Conditions = append(rule.Conditions, cfgutils.EncodeArgs([]string{"match", "method", matchMethod}))
+				if methodPathFound {
 					p.ValidateMethodPath = true
 				}
-				if matchPath != "" {
-					rule.Conditions = append(rule.Conditions, cfgutils.EncodeArgs([]string{"partial", "match", "path", matchPath}))
-					p.ValidateMethodPath = true
+				p.ShadowAccessListRules = append(p.ShadowAccessListRules, rule)
+			case "role":
+				args := h.RemainingArgs()
+				if cfgutils.EncodeArgs(args) != "hierarchy" {
+					return nil, h.Errf("%s directive %q is unsupported", rootDirective, cfgutils.EncodeArgs(args))
 				}
-				if rootDirective == "allow" {
-					rule.Action = cfgutils.EncodeArgs([]string{rootDirective, "log", "debug"})
-				} else {
-					rule.Action = cfgutils.EncodeArgs([]string{rootDirective, "stop", "log", "warn"})
+				if p.RoleHierarchy != nil {
+					return nil, h.Errf("%s hierarchy directive is duplicate", rootDirective)
 				}
-				// log.Debug("acl rule", zap.String("action", rule.Action), zap.Any("conditions", rule.Conditions))
-				p.AccessListRules = append(p.AccessListRules, rule)
+				rh, err := parseRoleHierarchy(h, rootDirective)
+				if err != nil {
+					return nil, err
+				}
+				p.RoleHierarchy = rh
 			case "disable":
 				args := strings.Join(h.RemainingArgs(), " ")
 				args = strings.TrimSpace(args)
@@ -259,7 +299,12 @@ func parseCaddyfile(h httpcaddyfile.Helper) (*authz.Authorizer, error) {
 				case "":
 					return nil, h.Errf("%s directive has no value", rootDirective)
 				default:
-					return nil, h.Errf("%s directive %q is unsupported", rootDirective, args)
+					if !strings.HasPrefix(args, "cookie ") {
+						return nil, h.Errf("%s directive %q is unsupported", rootDirective, args)
+					}
+					if err := parseCookieConfig(&p, "disable", strings.Fields(args)[1:]); err != nil {
+						return nil, h.Errf("%s %s erred: %v", rootDirective, args, err)
+					}
 				}
 			case "bypass":
 				args := h.RemainingArgs()
@@ -302,6 +347,12 @@ func parseCaddyfile(h httpcaddyfile.Helper) (*authz.Authorizer, error) {
 				switch {
 				case strings.HasPrefix(args, "token sources"):
 					p.AllowedTokenSources = strings.Split(strings.TrimPrefix(args, "token sources "), " ")
+				case strings.HasPrefix(args, "auth url hosts "):
+					p.AuthURLHosts = strings.Fields(strings.TrimPrefix(args, "auth url hosts "))
+				case strings.HasPrefix(args, "redirect hosts "):
+					p.RedirectHosts = strings.Fields(strings.TrimPrefix(args, "redirect hosts "))
+				case strings.HasPrefix(args, "trusted proxies "):
+					p.TrustedProxies = strings.Fields(strings.TrimPrefix(args, "trusted proxies "))
 				case strings.HasPrefix(args, "auth url"):
 					p.AuthURLPath = strings.TrimPrefix(args, "auth url ")
 				case strings.HasPrefix(args, "forbidden url "):
@@ -317,20 +368,49 @@ func parseCaddyfile(h httpcaddyfile.Helper) (*authz.Authorizer, error) {
 						return nil, h.Errf("%s %s directive contains invalid value", rootDirective, args)
 					}
 					p.AuthRedirectStatusCode = n
+				case strings.HasPrefix(args, "cookie "):
+					if err := parseCookieConfig(&p, "set", strings.Fields(args)[1:]); err != nil {
+						return nil, h.Errf("%s %s erred: %v", rootDirective, args, err)
+					}
 				case strings.HasPrefix(args, "user identity "):
 					p.UserIdentityField = strings.TrimPrefix(args, "user identity ")
+				case strings.HasPrefix(args, "unauthorized template "),
+					strings.HasPrefix(args, "forbidden template "),
+					strings.HasPrefix(args, "redirect template "):
+					kv := strings.SplitN(args, " template ", 2)
+					if p.PageTemplates == nil {
+						p.PageTemplates = make(map[string]string)
+					}
+					p.PageTemplates[kv[0]] = kv[1]
 				case args == "":
 					return nil, h.Errf("%s directive has no value", rootDirective)
 				default:
 					return nil, h.Errf("%s directive %q is unsupported", rootDirective, args)
 				}
 			case "enable":
-				args := strings.Join(h.RemainingArgs(), " ")
-				switch args {
-				case "js redirect":
+				rargs := h.RemainingArgs()
+				args := strings.Join(rargs, " ")
+				switch {
+				case args == "js redirect":
 					p.RedirectWithJavascript = true
-				case "strip token":
+				case args == "strip token":
 					p.StripTokenEnabled = true
+				case len(rargs) > 1 && rargs[0] == "acl" && rargs[1] == "trace":
+					cfg, err := parseACLTraceConfig(rargs[2:])
+					if err != nil {
+						return nil, h.Errf("%s %s erred: %v", rootDirective, args, err)
+					}
+					p.AccessListTrace = cfg
+				case len(rargs) > 1 && rargs[0] == "cookie":
+					if err := parseCookieConfig(&p, "enable", rargs[1:]); err != nil {
+						return nil, h.Errf("%s %s erred: %v", rootDirective, args, err)
+					}
+				case len(rargs) > 1 && rargs[0] == "session" && rargs[1] == "refresh":
+					cfg, err := parseSessionRefreshConfig(rargs[2:])
+					if err != nil {
+						return nil, h.Errf("%s %s erred: %v", rootDirective, args, err)
+					}
+					p.SessionRefreshConfig = cfg
 				default:
 					return nil, h.Errf("unsupported directive for %s: %s", rootDirective, args)
 				}
@@ -343,23 +423,54 @@ func parseCaddyfile(h httpcaddyfile.Helper) (*authz.Authorizer, error) {
 				case cfgutils.EncodeArgs(args) == "headers with claims":
 					p.PassClaimsWithHeaders = true
 				case args[0] == "header":
-					if len(args) != 4 {
-						return nil, h.Errf("%s directive %q is invalid", rootDirective, cfgutils.EncodeArgs(args))
-					}
-					if args[2] != "from" {
-						return nil, h.Errf("%s directive %q has invalid syntax", rootDirective, cfgutils.EncodeArgs(args))
-					}
-					cfg := &authz.HeaderInjectionConfig{
-						Header: args[1],
-						Field:  args[3],
-					}
-					if err := cfg.Validate(); err != nil {
+					cfg, err := parseHeaderInjectionConfig(args)
+					if err != nil {
 						return nil, h.Errf("%s %s erred: %v", rootDirective, cfgutils.EncodeArgs(args), err)
 					}
 					p.HeaderInjectionConfigs = append(p.HeaderInjectionConfigs, cfg)
 				default:
 					return nil, h.Errf("unsupported directive for %s: %s", rootDirective, cfgutils.EncodeArgs(args))
 				}
+			case "upstream":
+				args := h.RemainingArgs()
+				if len(args) < 3 || args[0] != "token" {
+					return nil, h.Errf("%s directive %q is invalid", rootDirective, cfgutils.EncodeArgs(args))
+				}
+				if p.UpstreamTokenConfig == nil {
+					p.UpstreamTokenConfig = &authz.UpstreamTokenConfig{}
+				}
+				cfg := p.UpstreamTokenConfig
+				switch args[1] {
+				case "crypto":
+					encodedArgs := cfgutils.EncodeArgs(args[1:])
+					encodedArgs = repl.ReplaceAll(encodedArgs, badRepl)
+					upstreamCryptoKeyConfig = append(upstreamCryptoKeyConfig, encodedArgs)
+				case "audience":
+					cfg.Audience = append(cfg.Audience, args[2:]...)
+				case "issuer":
+					cfg.Issuer = args[2]
+				case "claims":
+					cfg.Claims = append(cfg.Claims, args[2:]...)
+				case "lifetime":
+					lifetime, err := strconv.Atoi(args[2])
+					if err != nil {
+						return nil, h.Errf("%s directive %q is invalid: %v", rootDirective, cfgutils.EncodeArgs(args), err)
+					}
+					cfg.Lifetime = lifetime
+				case "sign":
+					if len(args) != 4 || args[2] != "method" {
+						return nil, h.Errf("%s directive %q is invalid", rootDirective, cfgutils.EncodeArgs(args))
+					}
+					cfg.SignMethod = args[3]
+				default:
+					return nil, h.Errf("unsupported directive for %s: %s", rootDirective, cfgutils.EncodeArgs(args))
+				}
+			case "remove":
+				args := h.RemainingArgs()
+				if len(args) < 2 || args[0] != "header" {
+					return nil, h.Errf("%s directive must be followed by header <header_name>", rootDirective)
+				}
+				p.RemoveHeaders = append(p.RemoveHeaders, args[1:]...)
 			default:
 				return nil, h.Errf("unsupported root directive: %s", rootDirective)
 			}
@@ -386,18 +497,458 @@ func parseCaddyfile(h httpcaddyfile.Helper) (*authz.Authorizer, error) {
 		p.CryptoKeyStoreConfig = configs
 	}
 
+	if len(upstreamCryptoKeyConfig) > 0 {
+		configs, err := kms.ParseCryptoKeyConfigs(strings.Join(upstreamCryptoKeyConfig, "\n"))
+		if err != nil {
+			return nil, h.Errf("upstream token crypto key config error: %v", err)
+		}
+		p.UpstreamTokenConfig.CryptoKeyConfigs = configs
+	}
+
 	return &p, nil
 }
 
 func getMiddlewareFromParseCaddyfile(h httpcaddyfile.Helper) (caddyhttp.MiddlewareHandler, error) {
-	p, err := parseCaddyfile(h)
+	mw, err := parseAuthorizeDirective(h)
 	if err != nil {
 		return nil, err
 	}
 
 	return caddyauth.Authentication{
 		ProvidersRaw: caddy.ModuleMap{
-			"authorize": caddyconfig.JSON(AuthMiddleware{Authorizer: p}, nil),
+			"authorize": caddyconfig.JSON(mw, nil),
 		},
 	}, nil
 }
+
+// parseAuthorizeDirective parses the authorize directive. The directive
+// either has the block with the configuration of the instance, or
+// references the policy defined in the authorization global option, i.e.
+// authorize with <name>.
+func parseAuthorizeDirective(h httpcaddyfile.Helper) (*AuthMiddleware, error) {
+	for h.Next() {
+		args := h.RemainingArgs()
+		if len(args) == 0 {
+			break
+		}
+		if len(args) != 2 || args[0] != "with" {
+			return nil, h.Errf("authorize directive %q is unsupported", cfgutils.EncodeArgs(args))
+		}
+		if h.NextBlock(h.Nesting()) {
+			return nil, h.Errf("%v", errors.ErrPolicyMixedConfig.WithArgs(args[1]))
+		}
+		return &AuthMiddleware{Policy: args[1]}, nil
+	}
+	h.Reset()
+	p, err := parseCaddyfile(h)
+	if err != nil {
+		return nil, err
+	}
+	return &AuthMiddleware{Authorizer: p}, nil
+}
+
+// parseACLDirective parses the acl directive, i.e. acl rule and acl default.
+func parseACLDirective(h httpcaddyfile.Helper, rootDirective string, args []string) (*acl.RuleConfiguration, error) {
+	if len(args) == 0 {
+		return nil, h.Errf("%s directive has no value", rootDirective)
+	}
+	switch args[0] {
+	case "rule":
+		if len(args) > 1 {
+			return nil, h.Errf("%s directive %q is too long", rootDirective, strings.Join(args, " "))
+		}
+		rule := &acl.RuleConfiguration{}
+		for subNesting := h.Nesting(); h.NextBlock(subNesting); {
+			k := h.Val()
+			rargs := h.RemainingArgs()
+			if len(args) == 0 {
+				return nil, h.Errf("%s %s directive %v has no values", rootDirective, args[0], k)
+			}
+			rargs = append([]string{k}, rargs...)
+			switch {
+			case k == "comment":
+				rule.Comment = cfgutils.EncodeArgs(rargs)
+			case k == "allow", k == "deny":
+				rule.Action = cfgutils.EncodeArgs(rargs)
+			case isACLRuleCondGroup(rargs):
+				conditions, err := parseACLRuleCondGroup(h, rootDirective, rargs)
+				if err != nil {
+					return nil, err
+				}
+				rule.Conditions = append(rule.Conditions, conditions...)
+			default:
+				rule.Conditions = append(rule.Conditions, cfgutils.EncodeArgs(rargs))
+			}
+		}
+		return rule, nil
+	case "default":
+		if len(args) != 2 {
+			return nil, h.Errf("%s directive %q is too long", rootDirective, strings.Join(args, " "))
+		}
+		rule := &acl.RuleConfiguration{
+			Conditions: []string{"always match iss any"},
+		}
+		switch args[1] {
+		case "allow", "deny":
+			rule.Action = args[1]
+		default:
+			return nil, h.Errf("%s directive %q must have either allow or deny", rootDirective, strings.Join(args, " "))
+		}
+		return rule, nil
+	}
+	return nil, h.Errf("%s directive value of %q is unsupported", rootDirective, strings.Join(args, " "))
+}
+
+// parseACLPolicy parses the block of a named access list policy. The block
+// contains the acl rule, acl default, allow, and deny directives, along with
+// the crypto key, set token sources, and validate directives overriding the
+// ones of the instance. The policy validates the methods and the paths of
+// the requests when its rules have method or path conditions.
+func parseACLPolicy(h httpcaddyfile.Helper, repl *caddy.Replacer, rootDirective, name string) (*authz.AccessListPolicy, error) {
+	policy := &authz.AccessListPolicy{Name: name}
+	var cryptoKeyConfig []string
+	for nesting := h.Nesting(); h.NextBlock(nesting); {
+		k := h.Val()
+		args := h.RemainingArgs()
+		var rule *acl.RuleConfiguration
+		var err error
+		switch k {
+		case "acl":
+			rule, err = parseACLDirective(h, rootDirective+" policy "+k, args)
+		case "allow", "deny":
+			var found bool
+			rule, found, err = parseACLShortcut(h, rootDirective+" policy "+k, k, args)
+			if found {
+				policy.ValidateMethodPath = true
+			}
+		case "crypto":
+			if len(args) < 3 || args[0] != "key" {
+				return nil, h.Errf("%s policy %s directive %q is unsupported", rootDirective, name, cfgutils.EncodeArgs(append([]string{k}, args...)))
+			}
+			encodedArgs := cfgutils.EncodeArgs(args)
+			encodedArgs = repl.ReplaceAll(encodedArgs, badRepl)
+			cryptoKeyConfig = append(cryptoKeyConfig, encodedArgs)
+			continue
+		case "set":
+			if len(args) < 3 || args[0] != "token" || args[1] != "sources" {
+				return nil, h.Errf("%s policy %s directive %q is unsupported", rootDirective, name, cfgutils.EncodeArgs(append([]string{k}, args...)))
+			}
+			policy.AllowedTokenSources = args[2:]
+			continue
+		case "validate":
+			switch strings.Join(args, " ") {
+			case "path acl":
+				policy.ValidateAccessListPathClaim = true
+				policy.ValidateMethodPath = true
+			case "source address":
+				policy.ValidateSourceAddress = true
+			case "bearer header":
+				policy.ValidateBearerHeader = true
+			default:
+				return nil, h.Errf("%s policy %s directive %q is unsupported", rootDirective, name, cfgutils.EncodeArgs(append([]string{k}, args...)))
+			}
+			continue
+		default:
+			return nil, h.Errf("%s policy %s directive %q is unsupported", rootDirective, name, k)
+		}
+		if err != nil {
+			return nil, err
+		}
+		policy.Rules = append(policy.Rules, rule)
+	}
+	if len(cryptoKeyConfig) > 0 {
+		configs, err := kms.ParseCryptoKeyConfigs(strings.Join(cryptoKeyConfig, "\n"))
+		if err != nil {
+			return nil, h.Errf("%s policy %s crypto key config error: %v", rootDirective, name, err)
+		}
+		policy.CryptoKeyConfigs = configs
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, h.Errf("%s policy %s erred: %v", rootDirective, name, err)
+	}
+	return policy, nil
+}
+
+// parseACLRoute parses the arguments of the acl route directive, i.e.
+// <path_prefix> [with <method> ... <method>] policy <name>.
+func parseACLRoute(args []string) (*authz.AccessListRoute, error) {
+	if len(args) < 3 || args[len(args)-2] != "policy" {
+		return nil, fmt.Errorf("must be followed by <path_prefix> [with <method>] policy <name>")
+	}
+	route := &authz.AccessListRoute{
+		Path:   args[0],
+		Policy: args[len(args)-1],
+	}
+	methods := args[1 : len(args)-2]
+	if len(methods) > 0 {
+		if methods[0] != "with" || len(methods) == 1 {
+			return nil, fmt.Errorf("must be followed by <path_prefix> [with <method>] policy <name>")
+		}
+		route.Methods = methods[1:]
+	}
+	if err := route.Validate(); err != nil {
+		return nil, err
+	}
+	return route, nil
+}
+
+// parseACLShortcut parses the one-liner allow and deny directives. It
+// returns true when the rule has method or path conditions.
+func parseACLShortcut(h httpcaddyfile.Helper, rootDirective, action string, args []string) (*acl.RuleConfiguration, bool, error) {
+	if len(args) == 0 {
+		return nil, false, h.Errf("%s directive has no value", rootDirective)
+	}
+	if len(args) < 2 {
+		return nil, false, h.Errf("%s directive %q is too short", rootDirective, strings.Join(args, " "))
+	}
+	rule := &acl.RuleConfiguration{}
+	// rule.Action = cfgutils.EncodeArgs([]string{action, "log", "warn"})
+
+	mode := "field"
+	var cond []string
+	var matchMethod, matchPath string
+	var matchAlways bool
+	for _, arg := range args {
+		switch arg {
+		case "with":
+			mode = "method"
+			continue
+		case "to":
+			mode = "path"
+			continue
+		}
+		switch mode {
+		case "field":
+			if arg == "*" || arg == "any" {
+				matchAlways = true
+			}
+			cond = append(cond, arg)
+		case "method":
+			matchMethod = strings.ToUpper(arg)
+			mode = "path"
+		case "path":
+			matchPath = arg
+			mode = "complete"
+		default:
+			return nil, false, h.Errf("%s directive value of %q is unsupported", rootDirective, strings.Join(args, " "))
+		}
+	}
+	if matchAlways {
+		rule.Conditions = append(rule.Conditions, cfgutils.EncodeArgs(append([]string{"always", "match"}, cond...)))
+	} else {
+		rule.Conditions = append(rule.Conditions, cfgutils.EncodeArgs(append([]string{"match"}, cond...)))
+	}
+	if matchMethod != "" {
+		rule.Conditions = append(rule.Conditions, cfgutils.EncodeArgs([]string{"match", "method", matchMethod}))
+	}
+	if matchPath != "" {
+		rule.Conditions = append(rule.Conditions, cfgutils.EncodeArgs([]string{"partial", "match", "path", matchPath}))
+	}
+	if action == "allow" {
+		rule.Action = cfgutils.EncodeArgs([]string{action, "log", "debug"})
+	} else {
+		rule.Action = cfgutils.EncodeArgs([]string{action, "stop", "log", "warn"})
+	}
+	// log.Debug("acl rule", zap.String("action", rule.Action), zap.Any("conditions", rule.Conditions))
+	return rule, matchMethod != "" || matchPath != "", nil
+}
+
+// isACLRuleCondGroup returns true when the arguments start a condition
+// group block, i.e. any, all, not any, or not all.
+func isACLRuleCondGroup(args []string) bool {
+	if len(args) > 0 && args[0] == "not" {
+		args = args[1:]
+	}
+	if len(args) != 1 {
+		return false
+	}
+	switch args[0] {
+	case "any", "all":
+		return true
+	}
+	return false
+}
+
+// parseACLRuleCondGroup parses the condition group block, including nested
+// groups, of an acl rule, and returns the conditions of the group enclosed
+// with the group start and end markers.
+func parseACLRuleCondGroup(h httpcaddyfile.Helper, rootDirective string, group []string) ([]string, error) {
+	conditions := []string{cfgutils.EncodeArgs(append(group, "{"))}
+	for nesting := h.Nesting(); h.NextBlock(nesting); {
+		args := append([]string{h.Val()}, h.RemainingArgs()...)
+		switch {
+		case isACLRuleCondGroup(args):
+			nested, err := parseACLRuleCondGroup(h, rootDirective, args)
+			if err != nil {
+				return nil, err
+			}
+			conditions = append(conditions, nested...)
+		case args[0] == "comment", args[0] == "allow", args[0] == "deny":
+			return nil, h.Errf("%s directive %q is not supported in %s condition group", rootDirective, args[0], strings.Join(group, " "))
+		default:
+			conditions = append(conditions, cfgutils.EncodeArgs(args))
+		}
+	}
+	if len(conditions) == 1 {
+		return nil, h.Errf("%s %s condition group has no conditions", rootDirective, strings.Join(group, " "))
+	}
+	return append(conditions, "}"), nil
+}
+
+// parseRoleHierarchy parses the role hierarchy block, i.e. the roles implied
+// by other roles and the aliases of the roles.
+func parseRoleHierarchy(h httpcaddyfile.Helper, rootDirective string) (*user.RoleHierarchy, error) {
+	rh := user.NewRoleHierarchy()
+	for nesting := h.Nesting(); h.NextBlock(nesting); {
+		args := append([]string{h.Val()}, h.RemainingArgs()...)
+		switch {
+		case len(args) > 2 && args[1] == "implies":
+			if err := rh.AddRole(args[0], args[2:]...); err != nil {
+				return nil, h.Errf("%s hierarchy %s erred: %v", rootDirective, cfgutils.EncodeArgs(args), err)
+			}
+		case len(args) == 4 && args[1] == "alias" && args[2] == "of":
+			if err := rh.AddAlias(args[0], args[3]); err != nil {
+				return nil, h.Errf("%s hierarchy %s erred: %v", rootDirective, cfgutils.EncodeArgs(args), err)
+			}
+		default:
+			return nil, h.Errf("%s hierarchy directive %q is invalid", rootDirective, cfgutils.EncodeArgs(args))
+		}
+	}
+	if len(rh.Implies) == 0 && len(rh.Aliases) == 0 {
+		return nil, h.Errf("%s hierarchy directive has no entries", rootDirective)
+	}
+	if err := rh.Validate(); err != nil {
+		return nil, h.Errf("%s hierarchy directive erred: %v", rootDirective, err)
+	}
+	return rh, nil
+}
+
+// parseACLTraceConfig parses the arguments of "enable acl trace", i.e.
+// the optional response header and the roles allowed to receive it.
+func parseACLTraceConfig(args []string) (*authz.AccessListTraceConfig, error) {
+	cfg := &authz.AccessListTraceConfig{}
+	if len(args) == 0 {
+		return cfg, nil
+	}
+	if len(args) < 4 || args[0] != "header" || args[2] != "roles" {
+		return nil, fmt.Errorf("must be followed by header <name> roles <role_name>")
+	}
+	cfg.Header = args[1]
+	cfg.Roles = args[3:]
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// parseSessionRefreshConfig parses the arguments of the enable session
+// refresh directive.
+func parseSessionRefreshConfig(args []string) (*authz.SessionRefreshConfig, error) {
+	cfg := &authz.SessionRefreshConfig{}
+	for len(args) > 0 {
+		var k string
+		switch {
+		case len(args) > 1 && args[0] == "window":
+			k, args = args[0], args[1:]
+		case len(args) > 1 && args[0] == "lifetime":
+			k, args = args[0], args[1:]
+		case len(args) > 2 && args[0] == "max" && args[1] == "age":
+			k, args = "max age", args[2:]
+		default:
+			return nil, fmt.Errorf("must be followed by window <seconds> max age <seconds> [lifetime <seconds>]")
+		}
+		v, err := strconv.Atoi(args[0])
+		if err != nil {
+			return nil, fmt.Errorf("%s value %q is invalid", k, args[0])
+		}
+		args = args[1:]
+		switch k {
+		case "window":
+			cfg.Window = v
+		case "lifetime":
+			cfg.Lifetime = v
+		default:
+			cfg.MaxSessionAge = v
+		}
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// parseCookieConfig parses the arguments of the set cookie, enable cookie,
+// and disable cookie directives, and applies them to the cookie config of
+// the token name following the for keyword, or to the default cookie
+// config.
+func parseCookieConfig(p *authz.Authorizer, action string, args []string) error {
+	var tokenName string
+	if n := len(args); n > 2 && args[n-2] == "for" {
+		tokenName, args = args[n-1], args[:n-2]
+	}
+	var cfg *authz.CookieConfig
+	for _, c := range p.CookieConfigs {
+		if c.TokenName == tokenName {
+			cfg = c
+			break
+		}
+	}
+	if cfg == nil {
+		cfg = &authz.CookieConfig{TokenName: tokenName}
+		p.CookieConfigs = append(p.CookieConfigs, cfg)
+	}
+	switch {
+	case action == "set" && len(args) == 2 && args[0] == "domain":
+		cfg.Domain = args[1]
+	case action == "set" && len(args) == 2 && args[0] == "path":
+		cfg.Path = args[1]
+	case action == "set" && len(args) == 2 && args[0] == "samesite":
+		cfg.SameSite = strings.ToLower(args[1])
+	case action == "enable" && len(args) == 1 && args[0] == "secure":
+		cfg.Secure = true
+	case action == "disable" && len(args) == 1 && args[0] == "httponly":
+		cfg.HTTPOnlyDisabled = true
+	default:
+		return fmt.Errorf("cookie directive %q is unsupported", cfgutils.EncodeArgs(args))
+	}
+	return cfg.Validate()
+}
+
+// parseHeaderInjectionConfig parses the arguments of the inject header
+// directive.
+func parseHeaderInjectionConfig(args []string) (*authz.HeaderInjectionConfig, error) {
+	if len(args) < 4 || args[0] != "header" {
+		return nil, fmt.Errorf("must be followed by header <name> and the value")
+	}
+	cfg := &authz.HeaderInjectionConfig{Header: args[1]}
+	args = args[2:]
+	for i, arg := range args {
+		if arg != "when" {
+			continue
+		}
+		if len(args) < i+3 || args[i+1] != "roles" {
+			return nil, fmt.Errorf("when must be followed by roles <role_name>")
+		}
+		cfg.Roles = args[i+2:]
+		args = args[:i]
+		break
+	}
+	if len(args) > 3 && args[len(args)-2] == "separator" && args[0] != "base64" {
+		cfg.Separator = args[len(args)-1]
+		args = args[:len(args)-2]
+	}
+	switch {
+	case len(args) == 2 && args[0] == "from":
+		cfg.Field = args[1]
+	case len(args) == 2 && args[0] == "template":
+		cfg.Template = args[1]
+	case len(args) > 3 && cfgutils.EncodeArgs(args[:3]) == "base64 json from":
+		cfg.Fields = args[3:]
+	default:
+		return nil, fmt.Errorf("has invalid syntax")
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
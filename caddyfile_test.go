@@ -102,6 +102,167 @@ func TestParser(t *testing.T) {
                 enable strip token
             }`,
 		},
+		{
+			name: "enable acl decision trace",
+			config: `
+            authorize {
+                primary yes
+                crypto key verify foobar
+                enable acl trace header X-Authorize-Trace roles admin
+            }`,
+		},
+		{
+			name: "enable acl decision trace without roles",
+			config: `
+            authorize {
+                enable acl trace header X-Authorize-Trace
+            }`,
+			shouldErr: true,
+			err:       fmt.Errorf("Testfile:3 - Error during parsing: enable acl trace header X-Authorize-Trace erred: must be followed by header <name> roles <role_name>"),
+		},
+		{
+			name: "configure shadow acl",
+			config: `
+            authorize {
+                primary yes
+                crypto key verify foobar
+                allow roles admin editor viewer
+                shadow acl rule {
+                  match roles admin editor
+                  allow stop counter
+                }
+                shadow deny roles viewer with post
+                shadow acl default deny
+            }`,
+		},
+		{
+			name: "configure invalid shadow acl",
+			config: `
+            authorize {
+                shadow acl foobar
+            }`,
+			shouldErr: true,
+			err:       fmt.Errorf(`Testfile:3 - Error during parsing: shadow acl directive value of "foobar" is unsupported`),
+		},
+		{
+			name: "configure shadow acl without value",
+			config: `
+            authorize {
+                shadow
+            }`,
+			shouldErr: true,
+			err:       fmt.Errorf(`Testfile:3 - Error during parsing: shadow directive has no value`),
+		},
+		{
+			name: "configure acl file",
+			config: `
+            authorize {
+                primary yes
+                crypto key verify foobar
+                acl file /etc/caddy/acl.yaml
+            }`,
+		},
+		{
+			name: "configure invalid acl file",
+			config: `
+            authorize {
+                acl file
+            }`,
+			shouldErr: true,
+			err:       fmt.Errorf(`Testfile:3 - Error during parsing: acl directive "file" is invalid`),
+		},
+		{
+			name: "configure access list policies with routes",
+			config: `
+            authorize {
+                primary yes
+                crypto key verify foobar
+                allow roles viewer
+                acl policy api {
+                    crypto key verify barfoo
+                    set token sources header
+                    validate bearer header
+                    allow roles api
+                    deny roles guest with post
+                }
+                acl policy admin {
+                    acl rule {
+                        match roles admin
+                        allow
+                    }
+                }
+                acl route /api policy api
+                acl route /admin with get post policy admin
+            }`,
+		},
+		{
+			name: "configure access list policy without rules",
+			config: `
+            authorize {
+                acl policy api {
+                }
+            }`,
+			shouldErr: true,
+			err:       fmt.Errorf(`Testfile:4 - Error during parsing: acl policy api erred: access list policy "api" has no rules`),
+		},
+		{
+			name: "configure access list policy with unsupported directive",
+			config: `
+            authorize {
+                acl policy api {
+                    set auth url /login
+                    allow roles api
+                }
+            }`,
+			shouldErr: true,
+			err:       fmt.Errorf(`Testfile:4 - Error during parsing: acl policy api directive "set auth url /login" is unsupported`),
+		},
+		{
+			name: "configure invalid access list route",
+			config: `
+            authorize {
+                acl route api policy api
+            }`,
+			shouldErr: true,
+			err:       fmt.Errorf(`Testfile:3 - Error during parsing: acl directive "route api policy api" is invalid: access list route path "api" must begin with a slash`),
+		},
+		{
+			name: "configure role hierarchy",
+			config: `
+            authorize {
+                primary yes
+                crypto key verify foobar
+                role hierarchy {
+                    admin implies editor
+                    editor implies viewer
+                    administrator alias of admin
+                }
+                allow roles viewer
+            }`,
+		},
+		{
+			name: "configure role hierarchy with cycle",
+			config: `
+            authorize {
+                role hierarchy {
+                    admin implies editor
+                    editor implies admin
+                }
+            }`,
+			shouldErr: true,
+			err:       fmt.Errorf(`Testfile:6 - Error during parsing: role hierarchy directive erred: role hierarchy has a cycle: admin -> editor -> admin`),
+		},
+		{
+			name: "configure invalid role hierarchy entry",
+			config: `
+            authorize {
+                role hierarchy {
+                    admin editor
+                }
+            }`,
+			shouldErr: true,
+			err:       fmt.Errorf(`Testfile:4 - Error during parsing: role hierarchy directive "admin editor" is invalid`),
+		},
 		{
 			name: "enable invalid request handling parameters",
 			config: `
@@ -156,6 +317,99 @@ func TestParser(t *testing.T) {
 			shouldErr: true,
 			err:       fmt.Errorf(`Testfile:3 - Error during parsing: crypto directive value of "foobar barfoo foobar" is unsupported`),
 		},
+		{
+			name: "configure templated header claim injection",
+			config: `
+            authorize {
+                primary yes
+                crypto key verify foobar
+                inject header "X-User" template "{name} <{email}>"
+                inject header "X-Groups" from roles separator ","
+                inject header "X-Userinfo" base64 json from sub email roles when roles admin
+                remove header X-Forwarded-User X-Remote-User
+            }`,
+		},
+		{
+			name: "configure session refresh",
+			config: `
+            authorize {
+                primary yes
+                crypto key sign-verify foobar
+                enable session refresh window 300 max age 86400 lifetime 900
+            }`,
+		},
+		{
+			name: "configure session refresh without max age",
+			config: `
+            authorize {
+                enable session refresh window 300
+            }`,
+			shouldErr: true,
+			err:       fmt.Errorf(`Testfile:3 - Error during parsing: enable session refresh window 300 erred: session refresh max age 0 is invalid`),
+		},
+		{
+			name: "configure cookie attributes",
+			config: `
+            authorize {
+                primary yes
+                crypto key verify foobar
+                set cookie domain contoso.com
+                set cookie path /app for access_token
+                set cookie samesite strict for access_token
+                enable cookie secure
+                disable cookie httponly for access_token
+            }`,
+		},
+		{
+			name: "configure cookie with unsupported samesite",
+			config: `
+            authorize {
+                set cookie samesite default
+            }`,
+			shouldErr: true,
+			err:       fmt.Errorf(`Testfile:3 - Error during parsing: set cookie samesite default erred: cookie samesite "default" is unsupported`),
+		},
+		{
+			name: "configure unsupported cookie attribute",
+			config: `
+            authorize {
+                enable cookie partitioned
+            }`,
+			shouldErr: true,
+			err:       fmt.Errorf(`Testfile:3 - Error during parsing: enable cookie partitioned erred: cookie directive "partitioned" is unsupported`),
+		},
+		{
+			name: "configure upstream token",
+			config: `
+            authorize {
+                primary yes
+                crypto key verify foobar
+                upstream token crypto key sign barfoo
+                upstream token audience https://api.internal
+                upstream token issuer https://gateway.internal
+                upstream token claims sub email roles
+                upstream token lifetime 60
+                upstream token sign method HS256
+            }`,
+		},
+		{
+			name: "configure upstream token with invalid lifetime",
+			config: `
+            authorize {
+                upstream token lifetime foo
+            }`,
+			shouldErr: true,
+			err:       fmt.Errorf(`Testfile:3 - Error during parsing: upstream directive "token lifetime foo" is invalid: strconv.Atoi: parsing "foo": invalid syntax`),
+		},
+		{
+			name: "configure header claim injection with invalid template",
+			config: `
+            authorize {
+                inject header "X-User" template "{name"
+            }`,
+			shouldErr: true,
+			err:       fmt.Errorf(`Testfile:3 - Error during parsing: inject header X-User template {name erred: header "X-User" template "{name" is invalid: unclosed brace`),
+		},
 		{
 			name: "configure invalid header claim injection",
 			config: `
@@ -285,6 +539,27 @@ func TestParser(t *testing.T) {
                 set auth url /xauth
                 set forbidden url /forbidden.html
                 set user identity mail
+            }`,
+		},
+		{
+			name: "set page templates",
+			config: `
+            authorize {
+                primary yes
+                set unauthorized template /etc/caddy/unauthorized.html
+                set forbidden template /etc/caddy/forbidden.html
+                set redirect template /etc/caddy/redirect.html
+            }`,
+		},
+		{
+			name: "set redirect protection",
+			config: `
+            authorize {
+                primary yes
+                set auth url https://auth.contoso.com/auth
+                set trusted proxies 10.0.0.0/8 192.168.1.1
+                set redirect hosts app.contoso.com *.apps.contoso.com
+                set auth url hosts login.contoso.com
             }`,
 		},
 		{
@@ -346,6 +621,44 @@ func TestParser(t *testing.T) {
               }
             }`,
 		},
+		{
+			name: "acl with negated conditions and condition groups",
+			config: `
+            authorize {
+              primary yes
+              crypto key verify foobar
+
+              acl rule {
+                match roles admin
+                not prefix match path /billing
+                any {
+                  match org nyc
+                  not all {
+                    match org sfo
+                    match method POST
+                  }
+                }
+                allow stop
+              }
+            }`,
+		},
+		{
+			name: "acl with empty condition group",
+			config: `
+            authorize {
+              primary yes
+              crypto key verify foobar
+
+              acl rule {
+                match roles admin
+                any {
+                }
+                allow
+              }
+            }`,
+			shouldErr: true,
+			err:       fmt.Errorf("Testfile:9 - Error during parsing: acl any condition group has no conditions"),
+		},
 	}
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
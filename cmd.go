@@ -0,0 +1,448 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authorize
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/caddyserver/caddy/v2"
+	"github.com/caddyserver/caddy/v2/caddyconfig"
+	caddycmd "github.com/caddyserver/caddy/v2/cmd"
+	"github.com/greenpau/caddy-authorize/pkg/authz"
+	"github.com/greenpau/caddy-authorize/pkg/errors"
+	"go.uber.org/zap"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	// exitCodeDenied is the exit code of the eval subcommand when the
+	// request is denied.
+	exitCodeDenied = 2
+	// exitCodePolicyTestFailed is the exit code of the test subcommand when
+	// a policy test case failed.
+	exitCodePolicyTestFailed = 2
+)
+
+func init() {
+	caddycmd.RegisterCommand(caddycmd.Command{
+		Name:  "authorize",
+		Func:  cmdAuthorize,
+		Usage: "eval|test --config <path> [--adapter <name>] [--instance <name>] [<subcommand flags>]",
+		Short: "Evaluates requests against the authorize plugin configuration",
+		Long: `
+The subcommands load the configuration of the authorize plugin from a
+Caddyfile or Caddy JSON config, and evaluate requests against it without
+running traffic. The requests are authorized by the keystore, the request
+validation, and the access list of the plugin instance. When the config has
+more than one instance of the plugin, the --instance flag selects the
+instance by its name. By default, the first instance is selected.
+
+  eval (--token <jwt> | --claims <path>) [--method <method>] --url <url>
+       [--source <ip>] [--json]
+
+The eval subcommand evaluates a single request. The token is verified by the
+keystore of the instance. Alternatively, the --claims flag takes the path to
+a JSON file with the claims of the user, which are trusted as if they came
+from a valid token. The subcommand prints the verdict and the access list
+rule which decided it. It exits with 0 when the request is allowed, and
+with 2 when it is denied.
+
+  test --cases <path> [--verbose]
+
+The test subcommand runs the policy test cases in the YAML file. Each test
+case has the claims of the user, or a token, the request, and the expected
+outcome, i.e. whether the request is allowed and the tag of the access list
+rule which decided it. The subcommand prints the difference between the
+expected and the actual outcome of every failed test case. It exits with 0
+when all test cases passed, and with 2 otherwise.`,
+	})
+}
+
+func cmdAuthorize(fl caddycmd.Flags) (int, error) {
+	args := fl.Args()
+	if len(args) == 0 {
+		return caddy.ExitCodeFailedStartup, fmt.Errorf("subcommand is required, expected eval or test")
+	}
+	switch args[0] {
+	case "eval":
+		return cmdAuthorizeEval(args[1:])
+	case "test":
+		return cmdAuthorizeTest(args[1:])
+	}
+	return caddy.ExitCodeFailedStartup, fmt.Errorf("unsupported subcommand %q, expected eval or test", args[0])
+}
+
+// instanceFlags are the flags selecting the plugin instance.
+type instanceFlags struct {
+	configPath   *string
+	adapterName  *string
+	instanceName *string
+}
+
+func addInstanceFlags(fs *flag.FlagSet) *instanceFlags {
+	return &instanceFlags{
+		configPath:   fs.String("config", "", "Caddyfile or Caddy JSON config file"),
+		adapterName:  fs.String("adapter", "", "Name of config adapter"),
+		instanceName: fs.String("instance", "", "Name of plugin instance"),
+	}
+}
+
+// load provisions the instances of the plugin found in the config and
+// returns the selected one. The cleanup function stops the instances.
+func (f *instanceFlags) load() (*authz.Authorizer, func(), error) {
+	if *f.configPath == "" {
+		return nil, nil, fmt.Errorf("--config is required")
+	}
+	authorizers, err := loadAuthorizers(*f.configPath, *f.adapterName)
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup := func() {
+		for _, m := range authorizers {
+			m.Cleanup()
+		}
+	}
+	m, err := selectAuthorizer(authorizers, *f.instanceName)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	return m, cleanup, nil
+}
+
+func cmdAuthorizeEval(args []string) (int, error) {
+	fs := flag.NewFlagSet("authorize eval", flag.ContinueOnError)
+	instance := addInstanceFlags(fs)
+	token := fs.String("token", "", "Token to evaluate")
+	claimsPath := fs.String("claims", "", "JSON file with user claims to evaluate")
+	method := fs.String("method", "GET", "HTTP request method")
+	reqURL := fs.String("url", "", "HTTP request URL")
+	sourceAddr := fs.String("source", "", "HTTP request source address")
+	jsonOutput := fs.Bool("json", false, "Print the result in JSON format")
+	if err := fs.Parse(args); err != nil {
+		return caddy.ExitCodeFailedStartup, err
+	}
+	if *reqURL == "" {
+		return caddy.ExitCodeFailedStartup, fmt.Errorf("--url is required")
+	}
+
+	req := &authz.EvalRequest{
+		Token:         *token,
+		Method:        *method,
+		URL:           *reqURL,
+		SourceAddress: *sourceAddr,
+	}
+	if *claimsPath != "" {
+		b, err := ioutil.ReadFile(*claimsPath)
+		if err != nil {
+			return caddy.ExitCodeFailedStartup, fmt.Errorf("reading claims: %v", err)
+		}
+		if err := json.Unmarshal(b, &req.Claims); err != nil {
+			return caddy.ExitCodeFailedStartup, fmt.Errorf("parsing claims: %v", err)
+		}
+	}
+
+	m, cleanup, err := instance.load()
+	if err != nil {
+		return caddy.ExitCodeFailedStartup, err
+	}
+	defer cleanup()
+
+	result, err := m.Eval(req)
+	if err != nil {
+		return caddy.ExitCodeFailedStartup, err
+	}
+	if *jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(result); err != nil {
+			return caddy.ExitCodeFailedStartup, err
+		}
+	} else {
+		printEvalResult(os.Stdout, result)
+	}
+	if !result.Allow {
+		return exitCodeDenied, nil
+	}
+	return caddy.ExitCodeSuccess, nil
+}
+
+func cmdAuthorizeTest(args []string) (int, error) {
+	fs := flag.NewFlagSet("authorize test", flag.ContinueOnError)
+	instance := addInstanceFlags(fs)
+	casesPath := fs.String("cases", "", "YAML file with policy test cases")
+	verbose := fs.Bool("verbose", false, "Print passed test cases")
+	if err := fs.Parse(args); err != nil {
+		return caddy.ExitCodeFailedStartup, err
+	}
+	if *casesPath == "" {
+		return caddy.ExitCodeFailedStartup, fmt.Errorf("--cases is required")
+	}
+	b, err := ioutil.ReadFile(*casesPath)
+	if err != nil {
+		return caddy.ExitCodeFailedStartup, fmt.Errorf("reading policy test cases: %v", err)
+	}
+
+	m, cleanup, err := instance.load()
+	if err != nil {
+		return caddy.ExitCodeFailedStartup, err
+	}
+	defer cleanup()
+
+	suite, err := authz.NewPolicyTestSuite(m, b)
+	if err != nil {
+		return caddy.ExitCodeFailedStartup, err
+	}
+	var failed int
+	for i := 0; i < suite.Len(); i++ {
+		name, diff, err := suite.Run(i)
+		switch {
+		case err != nil:
+			failed++
+			fmt.Fprintf(os.Stdout, "FAIL: %s\n    %v\n", name, err)
+		case diff != "":
+			failed++
+			fmt.Fprintf(os.Stdout, "FAIL: %s (-want +got):\n%s", name, diff)
+		case *verbose:
+			fmt.Fprintf(os.Stdout, "PASS: %s\n", name)
+		}
+	}
+	fmt.Fprintf(os.Stdout, "%d passed, %d failed\n", suite.Len()-failed, failed)
+	if failed > 0 {
+		return exitCodePolicyTestFailed, nil
+	}
+	return caddy.ExitCodeSuccess, nil
+}
+
+// loadAuthorizers loads the config file, adapting it to Caddy JSON when
+// necessary, and provisions the instances of the plugin found in it. The
+// instances are in the order of their routes, with the routes authorizing
+// with a named policy resolved to the policy, followed by the policies not
+// referenced by any route. The instances are provisioned with their own
+// instance managers, as opposed to the global AuthManager, and the named
+// policies with a manager of their own, as in the authorization app.
+func loadAuthorizers(configPath, adapterName string) ([]*authz.Authorizer, error) {
+	b, err := ioutil.ReadFile(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading config file: %v", err)
+	}
+	if adapterName == "" && (filepath.Ext(configPath) == ".caddyfile" ||
+		strings.HasPrefix(filepath.Base(configPath), "Caddyfile")) {
+		adapterName = "caddyfile"
+	}
+	if adapterName != "" {
+		adapter := caddyconfig.GetAdapter(adapterName)
+		if adapter == nil {
+			return nil, fmt.Errorf("unrecognized config adapter: %s", adapterName)
+		}
+		b, _, err = adapter.Adapt(b, map[string]interface{}{"filename": configPath})
+		if err != nil {
+			return nil, fmt.Errorf("adapting config using %s: %v", adapterName, err)
+		}
+	}
+
+	var cfg interface{}
+	if err := json.Unmarshal(b, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing config: %v", err)
+	}
+	policies, err := findAuthorizationPolicies(cfg)
+	if err != nil {
+		return nil, err
+	}
+	var authorizers, instances []*authz.Authorizer
+	referenced := make(map[string]bool)
+	for _, raw := range findAuthorizeProviders(cfg, nil) {
+		b, err := json.Marshal(raw)
+		if err != nil {
+			return nil, err
+		}
+		mw := &AuthMiddleware{}
+		if err := json.Unmarshal(b, mw); err != nil {
+			return nil, fmt.Errorf("parsing authorize config: %v", err)
+		}
+		switch {
+		case mw.Policy != "":
+			if mw.Authorizer != nil {
+				return nil, errors.ErrPolicyMixedConfig.WithArgs(mw.Policy)
+			}
+			p := getAuthorizationPolicy(policies, mw.Policy)
+			if p == nil {
+				return nil, errors.ErrPolicyNotFound.WithArgs(mw.Policy)
+			}
+			if !referenced[mw.Policy] {
+				referenced[mw.Policy] = true
+				authorizers = append(authorizers, p)
+			}
+		case mw.Authorizer != nil:
+			instances = append(instances, mw.Authorizer)
+			authorizers = append(authorizers, mw.Authorizer)
+		}
+	}
+	for _, p := range policies {
+		if !referenced[p.Name] {
+			authorizers = append(authorizers, p)
+		}
+	}
+	if len(authorizers) == 0 {
+		return nil, fmt.Errorf("no authorize config found in %s", configPath)
+	}
+
+	for _, m := range authorizers {
+		if m.AccessListTrace == nil {
+			m.AccessListTrace = &authz.AccessListTraceConfig{}
+		}
+	}
+	opts := map[string]interface{}{"logger": zap.NewNop()}
+	instanceManager := authz.NewInstanceManager()
+	for _, m := range instances {
+		if err := m.ProvisionWithManager(instanceManager, opts); err != nil {
+			return nil, err
+		}
+	}
+	policyManager := authz.NewInstanceManager()
+	for _, p := range policies {
+		if err := p.ProvisionWithManager(policyManager, opts); err != nil {
+			return nil, err
+		}
+	}
+	for _, m := range instances {
+		if err := m.ValidateWithManager(instanceManager); err != nil {
+			return nil, err
+		}
+	}
+	for _, p := range policies {
+		if err := p.ValidateWithManager(policyManager); err != nil {
+			return nil, err
+		}
+	}
+	return authorizers, nil
+}
+
+// findAuthorizeProviders returns the configs of the authorize providers of
+// the authentication handlers. The routes are searched in their order, and
+// the other keys in alphabetical order.
+func findAuthorizeProviders(v interface{}, found []interface{}) []interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if providers, ok := v["providers"].(map[string]interface{}); ok {
+			if cfg, exists := providers["authorize"]; exists {
+				found = append(found, cfg)
+			}
+		}
+		var keys []string
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if k == "providers" {
+				continue
+			}
+			found = findAuthorizeProviders(v[k], found)
+		}
+	case []interface{}:
+		for _, entry := range v {
+			found = findAuthorizeProviders(entry, found)
+		}
+	}
+	return found
+}
+
+// findAuthorizationPolicies returns the named policies of the authorization
+// app, sorted by name. The policies are the primary instances of the
+// contexts named after them, and the instances are named after them too.
+func findAuthorizationPolicies(cfg interface{}) ([]*authz.Authorizer, error) {
+	root, _ := cfg.(map[string]interface{})
+	apps, _ := root["apps"].(map[string]interface{})
+	raw, exists := apps[appName]
+	if !exists {
+		return nil, nil
+	}
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	app := &App{}
+	if err := json.Unmarshal(b, app); err != nil {
+		return nil, fmt.Errorf("parsing %s app config: %v", appName, err)
+	}
+	var policies []*authz.Authorizer
+	for _, name := range app.getPolicyNames() {
+		p := app.Policies[name]
+		if p == nil {
+			return nil, errors.ErrPolicyNil.WithArgs(name)
+		}
+		p.Name = name
+		p.Context = name
+		p.PrimaryInstance = true
+		policies = append(policies, p)
+	}
+	return policies, nil
+}
+
+// getAuthorizationPolicy returns the named policy with the name, if any.
+func getAuthorizationPolicy(policies []*authz.Authorizer, name string) *authz.Authorizer {
+	for _, p := range policies {
+		if p.Name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+func selectAuthorizer(authorizers []*authz.Authorizer, name string) (*authz.Authorizer, error) {
+	if name == "" {
+		return authorizers[0], nil
+	}
+	var names []string
+	for _, m := range authorizers {
+		if m.Name == name {
+			return m, nil
+		}
+		names = append(names, m.Name)
+	}
+	return nil, fmt.Errorf("instance %q not found, available instances: %s", name, strings.Join(names, ", "))
+}
+
+func printEvalResult(w io.Writer, result *authz.EvalResult) {
+	verdict := "deny"
+	if result.Allow {
+		verdict = "allow"
+	}
+	fmt.Fprintf(w, "instance: %s\n", result.Instance)
+	fmt.Fprintf(w, "verdict: %s\n", verdict)
+	if result.Trace != nil {
+		fmt.Fprintf(w, "reason: %s\n", result.Trace.Reason)
+	}
+	if result.Rule != nil {
+		fmt.Fprintf(w, "rule: %d (%s) %s", result.Rule.Index, result.Rule.Tag, result.Rule.Verdict)
+		if result.Rule.Comment != "" {
+			fmt.Fprintf(w, " # %s", result.Rule.Comment)
+		}
+		fmt.Fprintln(w)
+		for _, cond := range result.Rule.Conditions {
+			fmt.Fprintf(w, "  %s: %t\n", cond.Condition, cond.Match)
+		}
+	}
+	if result.Error != "" {
+		fmt.Fprintf(w, "error: %s\n", result.Error)
+	}
+}
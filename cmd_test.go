@@ -0,0 +1,155 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authorize
+
+import (
+	"github.com/caddyserver/caddy/v2/caddyconfig/caddyfile"
+	"github.com/caddyserver/caddy/v2/caddyconfig/httpcaddyfile"
+	"github.com/greenpau/caddy-authorize/internal/tests"
+	"github.com/greenpau/caddy-authorize/pkg/authz"
+	"github.com/greenpau/caddy-authorize/pkg/errors"
+	"github.com/greenpau/caddy-authorize/pkg/utils"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPolicyTests(t *testing.T) {
+	config := `
+    authorize {
+      primary yes
+      context policy
+      acl rule {
+        match roles authp/admin
+        allow stop tag admins
+      }
+      acl rule {
+        exact match host admin.contoso.com
+        deny stop tag admin-host
+      }
+      acl rule {
+        match roles authp/user
+        allow tag users
+      }
+    }`
+	m, err := parseCaddyfile(httpcaddyfile.Helper{Dispenser: caddyfile.NewTestDispenser(config)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	m.AccessListTrace = &authz.AccessListTraceConfig{}
+	if err := m.Provision(map[string]interface{}{"logger": utils.NewLogger()}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer m.Cleanup()
+
+	b, err := ioutil.ReadFile("testdata/policy/acl_test.yaml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	suite, err := authz.NewPolicyTestSuite(m, b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tests.EvalPolicyTests(t, suite)
+}
+
+func TestLoadAuthorizers(t *testing.T) {
+	var testcases = []struct {
+		name      string
+		config    string
+		want      []string
+		shouldErr bool
+		err       error
+	}{
+		{
+			name: "routes with named policies",
+			config: `
+            {
+              authorization {
+                policy admins {
+                  crypto key verify foobar
+                  allow roles authp/admin
+                }
+                policy users {
+                  crypto key verify foobar
+                  allow roles authp/user
+                }
+              }
+            }
+            localhost {
+              route /admin* {
+                authorize with admins
+              }
+              route /app* {
+                authorize {
+                  primary yes
+                  crypto key verify foobar
+                  allow roles authp/guest
+                }
+              }
+              route /settings* {
+                authorize with admins
+              }
+            }`,
+			want: []string{"admins", "default", "users"},
+		},
+		{
+			name: "route with unknown named policy",
+			config: `
+            {
+              authorization {
+                policy users {
+                  crypto key verify foobar
+                  allow roles authp/user
+                }
+              }
+            }
+            localhost {
+              route /admin* {
+                authorize with admins
+              }
+            }`,
+			shouldErr: true,
+			err:       errors.ErrPolicyNotFound.WithArgs("admins"),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "caddy-authorize")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer os.RemoveAll(dir)
+			configPath := filepath.Join(dir, "Caddyfile")
+			if err := ioutil.WriteFile(configPath, []byte(tc.config), 0600); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			// The instances are provisioned with their own managers, so that
+			// the config loads more than once.
+			for i := 0; i < 2; i++ {
+				authorizers, err := loadAuthorizers(configPath, "")
+				if tests.EvalErr(t, err, tc.config, tc.shouldErr, tc.err) {
+					return
+				}
+				var got []string
+				for _, m := range authorizers {
+					got = append(got, m.Context)
+				}
+				tests.EvalObjects(t, "contexts", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authorize
+
+import (
+	"github.com/caddyserver/caddy/v2"
+	"github.com/caddyserver/caddy/v2/caddyconfig/httpcaddyfile"
+	"github.com/caddyserver/caddy/v2/modules/caddyhttp"
+	"github.com/greenpau/caddy-authorize/pkg/authz"
+	"github.com/greenpau/caddy-authorize/pkg/errors"
+	"github.com/greenpau/caddy-authorize/pkg/utils/cfgutils"
+	"net/http"
+)
+
+func init() {
+	caddy.RegisterModule(WhoamiHandler{})
+	caddy.RegisterModule(LogoutHandler{})
+	httpcaddyfile.RegisterHandlerDirective("authorize_whoami", getWhoamiHandlerFromParseCaddyfile)
+	httpcaddyfile.RegisterHandlerDirective("authorize_logout", getLogoutHandlerFromParseCaddyfile)
+}
+
+// WhoamiHandler responds with the identity and the claims of the user as
+// JSON. The tokens are validated by either the named policy of the
+// authorization app, or the primary instance of the context.
+type WhoamiHandler struct {
+	// The name of the authorization policy defined in the authorization app.
+	Policy string `json:"policy,omitempty" xml:"policy,omitempty" yaml:"policy,omitempty"`
+	// The context of the primary instance, when the policy is not set.
+	// Defaults to the default context.
+	Context    string `json:"context,omitempty" xml:"context,omitempty" yaml:"context,omitempty"`
+	authorizer *authz.Authorizer
+}
+
+// LogoutHandler expires the auth cookies of either the named policy of
+// the authorization app, or the primary instance of the context, and
+// redirects the user. The cookies are expired with the attributes of the
+// cookie configs of the instance. Only the same origin POST requests are
+// accepted.
+type LogoutHandler struct {
+	// The name of the authorization policy defined in the authorization app.
+	Policy string `json:"policy,omitempty" xml:"policy,omitempty" yaml:"policy,omitempty"`
+	// The context of the primary instance, when the policy is not set.
+	// Defaults to the default context.
+	Context    string              `json:"context,omitempty" xml:"context,omitempty" yaml:"context,omitempty"`
+	Config     *authz.LogoutConfig `json:"config,omitempty" xml:"config,omitempty" yaml:"config,omitempty"`
+	authorizer *authz.Authorizer
+}
+
+// CaddyModule returns the Caddy module information.
+func (WhoamiHandler) CaddyModule() caddy.ModuleInfo {
+	return caddy.ModuleInfo{
+		ID:  "http.handlers.authorize_whoami",
+		New: func() caddy.Module { return new(WhoamiHandler) },
+	}
+}
+
+// Provision provisions the whoami endpoint.
+func (h *WhoamiHandler) Provision(ctx caddy.Context) (err error) {
+	h.authorizer, err = getEndpointPolicy(ctx, h.Policy, h.Context)
+	return err
+}
+
+// ServeHTTP responds with the identity of the user.
+func (h WhoamiHandler) ServeHTTP(w http.ResponseWriter, r *http.Request, _ caddyhttp.Handler) error {
+	m, err := getEndpointAuthorizer(h.authorizer, h.Context)
+	if err != nil {
+		return caddyhttp.Error(http.StatusInternalServerError, err)
+	}
+	// The errors of the authorization are responded to by the handler.
+	m.HandleWhoami(w, r)
+	return nil
+}
+
+// CaddyModule returns the Caddy module information.
+func (LogoutHandler) CaddyModule() caddy.ModuleInfo {
+	return caddy.ModuleInfo{
+		ID:  "http.handlers.authorize_logout",
+		New: func() caddy.Module { return new(LogoutHandler) },
+	}
+}
+
+// Provision provisions the logout endpoint.
+func (h *LogoutHandler) Provision(ctx caddy.Context) (err error) {
+	if h.Config != nil {
+		if err := h.Config.Validate(); err != nil {
+			return err
+		}
+	}
+	h.authorizer, err = getEndpointPolicy(ctx, h.Policy, h.Context)
+	return err
+}
+
+// ServeHTTP expires the auth cookies and redirects the user.
+func (h LogoutHandler) ServeHTTP(w http.ResponseWriter, r *http.Request, _ caddyhttp.Handler) error {
+	m, err := getEndpointAuthorizer(h.authorizer, h.Context)
+	if err != nil {
+		return caddyhttp.Error(http.StatusInternalServerError, err)
+	}
+	// The errors of the logout are responded to by the handler.
+	m.HandleLogout(w, r, h.Config)
+	return nil
+}
+
+// getEndpointPolicy returns the named policy of the authorization app. When
+// the policy is not set, the instance is looked up on each request, because
+// the primary instance of the context may be provisioned after the
+// endpoint.
+func getEndpointPolicy(ctx caddy.Context, policy, context string) (*authz.Authorizer, error) {
+	if policy == "" {
+		return nil, nil
+	}
+	if context != "" {
+		return nil, errors.ErrEndpointMixedConfig.WithArgs(policy, context)
+	}
+	app, err := ctx.App(appName)
+	if err != nil {
+		return nil, err
+	}
+	return app.(*App).getPolicy(policy)
+}
+
+// getEndpointAuthorizer returns the named policy, when present, or the
+// primary instance of the context.
+func getEndpointAuthorizer(m *authz.Authorizer, context string) (*authz.Authorizer, error) {
+	if m != nil {
+		return m, nil
+	}
+	if context == "" {
+		context = "default"
+	}
+	return authz.AuthManager.GetPrimaryInstance(context)
+}
+
+func getWhoamiHandlerFromParseCaddyfile(h httpcaddyfile.Helper) (caddyhttp.MiddlewareHandler, error) {
+	return parseWhoamiDirective(h)
+}
+
+func getLogoutHandlerFromParseCaddyfile(h httpcaddyfile.Helper) (caddyhttp.MiddlewareHandler, error) {
+	return parseLogoutDirective(h)
+}
+
+// parseWhoamiDirective parses the authorize_whoami directive. Syntax:
+//
+//     authorize_whoami [with <name>] {
+//       context <name>
+//     }
+func parseWhoamiDirective(h httpcaddyfile.Helper) (*WhoamiHandler, error) {
+	handler := &WhoamiHandler{}
+	for h.Next() {
+		policy, err := parseEndpointArgs(h)
+		if err != nil {
+			return nil, err
+		}
+		handler.Policy = policy
+		for nesting := h.Nesting(); h.NextBlock(nesting); {
+			k := h.Val()
+			args := h.RemainingArgs()
+			switch {
+			case k == "context" && len(args) == 1:
+				handler.Context = args[0]
+			default:
+				return nil, h.Errf("authorize_whoami directive %q is unsupported", cfgutils.EncodeArgs(append([]string{k}, args...)))
+			}
+		}
+	}
+	if handler.Policy != "" && handler.Context != "" {
+		return nil, h.Errf("%v", errors.ErrEndpointMixedConfig.WithArgs(handler.Policy, handler.Context))
+	}
+	return handler, nil
+}
+
+// parseLogoutDirective parses the authorize_logout directive. Syntax:
+//
+//     authorize_logout [with <name>] {
+//       context <name>
+//       redirect url <url>
+//       allow <get|post> ...
+//     }
+func parseLogoutDirective(h httpcaddyfile.Helper) (*LogoutHandler, error) {
+	handler := &LogoutHandler{}
+	cfg := &authz.LogoutConfig{}
+	for h.Next() {
+		policy, err := parseEndpointArgs(h)
+		if err != nil {
+			return nil, err
+		}
+		handler.Policy = policy
+		for nesting := h.Nesting(); h.NextBlock(nesting); {
+			k := h.Val()
+			args := h.RemainingArgs()
+			encodedArgs := cfgutils.EncodeArgs(append([]string{k}, args...))
+			switch {
+			case k == "context" && len(args) == 1:
+				handler.Context = args[0]
+			case k == "redirect" && len(args) == 2 && args[0] == "url":
+				cfg.RedirectURL = args[1]
+			case k == "allow" && len(args) > 0:
+				cfg.AllowedMethods = append(cfg.AllowedMethods, args...)
+			default:
+				return nil, h.Errf("authorize_logout directive %q is unsupported", encodedArgs)
+			}
+		}
+	}
+	if handler.Policy != "" && handler.Context != "" {
+		return nil, h.Errf("%v", errors.ErrEndpointMixedConfig.WithArgs(handler.Policy, handler.Context))
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, h.Errf("%v", err)
+	}
+	if cfg.RedirectURL != "" || len(cfg.AllowedMethods) > 0 {
+		handler.Config = cfg
+	}
+	return handler, nil
+}
+
+// parseEndpointArgs parses the arguments of the endpoint directives, i.e.
+// with <name>.
+func parseEndpointArgs(h httpcaddyfile.Helper) (string, error) {
+	directive := h.Val()
+	args := h.RemainingArgs()
+	switch {
+	case len(args) == 0:
+		return "", nil
+	case len(args) == 2 && args[0] == "with":
+		return args[1], nil
+	}
+	return "", h.Errf("%s directive %q is unsupported", directive, cfgutils.EncodeArgs(args))
+}
+
+// Interface guards
+var (
+	_ caddy.Provisioner           = (*WhoamiHandler)(nil)
+	_ caddyhttp.MiddlewareHandler = (*WhoamiHandler)(nil)
+	_ caddy.Provisioner           = (*LogoutHandler)(nil)
+	_ caddyhttp.MiddlewareHandler = (*LogoutHandler)(nil)
+)
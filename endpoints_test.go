@@ -0,0 +1,169 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authorize
+
+import (
+	"fmt"
+	"github.com/caddyserver/caddy/v2/caddyconfig/caddyfile"
+	"github.com/caddyserver/caddy/v2/caddyconfig/httpcaddyfile"
+	"github.com/greenpau/caddy-authorize/internal/tests"
+	"github.com/greenpau/caddy-authorize/pkg/authz"
+	"github.com/greenpau/caddy-authorize/pkg/errors"
+	"testing"
+)
+
+func TestParseWhoamiDirective(t *testing.T) {
+	var testcases = []struct {
+		name      string
+		config    string
+		want      *WhoamiHandler
+		shouldErr bool
+		err       error
+	}{
+		{
+			name:   "whoami with default context",
+			config: `authorize_whoami`,
+			want:   &WhoamiHandler{},
+		},
+		{
+			name:   "whoami with named policy",
+			config: `authorize_whoami with users`,
+			want:   &WhoamiHandler{Policy: "users"},
+		},
+		{
+			name: "whoami with context",
+			config: `
+            authorize_whoami {
+                context internal
+            }`,
+			want: &WhoamiHandler{Context: "internal"},
+		},
+		{
+			name: "whoami with named policy and context",
+			config: `
+            authorize_whoami with users {
+                context internal
+            }`,
+			shouldErr: true,
+			err: fmt.Errorf(
+				"Testfile:4 - Error during parsing: %v",
+				errors.ErrEndpointMixedConfig.WithArgs("users", "internal"),
+			),
+		},
+		{
+			name:      "whoami with unsupported arguments",
+			config:    `authorize_whoami for users`,
+			shouldErr: true,
+			err:       fmt.Errorf(`Testfile:1 - Error during parsing: authorize_whoami directive "for users" is unsupported`),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler, err := parseWhoamiDirective(httpcaddyfile.Helper{Dispenser: caddyfile.NewTestDispenser(tc.config)})
+			if tests.EvalErr(t, err, tc.config, tc.shouldErr, tc.err) {
+				return
+			}
+			tests.EvalObjects(t, "policy", tc.want.Policy, handler.Policy)
+			tests.EvalObjects(t, "context", tc.want.Context, handler.Context)
+		})
+	}
+}
+
+func TestParseLogoutDirective(t *testing.T) {
+	var testcases = []struct {
+		name      string
+		config    string
+		want      *LogoutHandler
+		shouldErr bool
+		err       error
+	}{
+		{
+			name:   "logout with default config",
+			config: `authorize_logout`,
+			want:   &LogoutHandler{},
+		},
+		{
+			name: "logout with named policy and config",
+			config: `
+            authorize_logout with users {
+                redirect url https://auth.contoso.com/logout
+            }`,
+			want: &LogoutHandler{
+				Policy: "users",
+				Config: &authz.LogoutConfig{
+					RedirectURL: "https://auth.contoso.com/logout",
+				},
+			},
+		},
+		{
+			name: "logout with allowed methods",
+			config: `
+            authorize_logout {
+                allow post
+            }`,
+			want: &LogoutHandler{
+				Config: &authz.LogoutConfig{
+					AllowedMethods: []string{"POST"},
+				},
+			},
+		},
+		{
+			name: "logout with unsupported method",
+			config: `
+            authorize_logout {
+                allow get delete
+            }`,
+			shouldErr: true,
+			err:       fmt.Errorf(`Testfile:4 - Error during parsing: logout method "delete" is unsupported`),
+		},
+		{
+			name: "logout with invalid redirect url",
+			config: `
+            authorize_logout {
+                redirect url logout
+            }`,
+			shouldErr: true,
+			err:       fmt.Errorf(`Testfile:4 - Error during parsing: logout redirect url "logout" is invalid`),
+		},
+		{
+			name: "logout with cookie config",
+			config: `
+            authorize_logout {
+                cookie domain contoso.com
+            }`,
+			shouldErr: true,
+			err:       fmt.Errorf(`Testfile:3 - Error during parsing: authorize_logout directive "cookie domain contoso.com" is unsupported`),
+		},
+		{
+			name: "logout with unsupported directive",
+			config: `
+            authorize_logout {
+                redirect to /login
+            }`,
+			shouldErr: true,
+			err:       fmt.Errorf(`Testfile:3 - Error during parsing: authorize_logout directive "redirect to /login" is unsupported`),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler, err := parseLogoutDirective(httpcaddyfile.Helper{Dispenser: caddyfile.NewTestDispenser(tc.config)})
+			if tests.EvalErr(t, err, tc.config, tc.shouldErr, tc.err) {
+				return
+			}
+			tests.EvalObjects(t, "policy", tc.want.Policy, handler.Policy)
+			tests.EvalObjects(t, "config", tc.want.Config, handler.Config)
+		})
+	}
+}
@@ -8,7 +8,9 @@ require (
 	github.com/google/go-cmp v0.5.5
 	github.com/iancoleman/strcase v0.1.3
 	github.com/niemeyer/pretty v0.0.0-20200227124842-a10e7caefd8e // indirect
+	github.com/prometheus/client_golang v1.10.1-0.20210603120351-253906201bda
 	github.com/satori/go.uuid v1.2.0
 	go.uber.org/zap v1.17.0
 	gopkg.in/check.v1 v1.0.0-20200227125254-8fa46927fb4f // indirect
+	gopkg.in/yaml.v2 v2.4.0
 )
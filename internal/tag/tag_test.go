@@ -23,6 +23,8 @@ import (
 	"github.com/greenpau/caddy-authorize/pkg/acl"
 	"github.com/greenpau/caddy-authorize/pkg/authz"
 	"github.com/greenpau/caddy-authorize/pkg/cache"
+	"github.com/greenpau/caddy-authorize/pkg/grantor"
+	"github.com/greenpau/caddy-authorize/pkg/handlers"
 	"github.com/greenpau/caddy-authorize/pkg/kms"
 	"github.com/greenpau/caddy-authorize/pkg/options"
 	"github.com/greenpau/caddy-authorize/pkg/user"
@@ -58,6 +60,11 @@ func TestTagCompliance(t *testing.T) {
 			entry: &user.User{},
 			opts:  &Options{},
 		},
+		{
+			name:  "test user.RoleHierarchy struct",
+			entry: &user.RoleHierarchy{},
+			opts:  &Options{},
+		},
 		{
 			name:  "test user.Authenticator struct",
 			entry: &user.Authenticator{},
@@ -78,6 +85,110 @@ func TestTagCompliance(t *testing.T) {
 			entry: &acl.RuleConfiguration{},
 			opts:  &Options{},
 		},
+		{
+			name:  "test acl.RuleCounter struct",
+			entry: &acl.RuleCounter{},
+			opts: &Options{
+				DisableTagOnEmpty: true,
+			},
+		},
+		{
+			name:  "test acl.ShadowCounters struct",
+			entry: &acl.ShadowCounters{},
+			opts: &Options{
+				DisableTagOnEmpty: true,
+			},
+		},
+		{
+			name:  "test acl.DecisionTrace struct",
+			entry: &acl.DecisionTrace{},
+			opts: &Options{
+				DisableTagOnEmpty: true,
+			},
+		},
+		{
+			name:  "test acl.Decision struct",
+			entry: &acl.Decision{},
+			opts: &Options{
+				DisableTagOnEmpty: true,
+			},
+		},
+		{
+			name:  "test acl.RuleTrace struct",
+			entry: &acl.RuleTrace{},
+			opts: &Options{
+				DisableTagOnEmpty: true,
+			},
+		},
+		{
+			name:  "test acl.ConditionTrace struct",
+			entry: &acl.ConditionTrace{},
+			opts: &Options{
+				DisableTagOnEmpty: true,
+			},
+		},
+		{
+			name:  "test authz.AccessListTraceConfig struct",
+			entry: &authz.AccessListTraceConfig{},
+			opts:  &Options{},
+		},
+		{
+			name:  "test authz.EvalRequest struct",
+			entry: &authz.EvalRequest{},
+			opts:  &Options{},
+		},
+		{
+			name:  "test authz.EvalResult struct",
+			entry: &authz.EvalResult{},
+			opts:  &Options{},
+		},
+		{
+			name:  "test authz.PolicyTestCase struct",
+			entry: &authz.PolicyTestCase{},
+			opts:  &Options{},
+		},
+		{
+			name:  "test authz.PolicyTestRequest struct",
+			entry: &authz.PolicyTestRequest{},
+			opts:  &Options{},
+		},
+		{
+			name:  "test authz.PolicyTestOutcome struct",
+			entry: &authz.PolicyTestOutcome{},
+			opts: &Options{
+				DisableTagOnEmpty: true,
+			},
+		},
+		{
+			name:  "test authz.PolicyTestSuite struct",
+			entry: &authz.PolicyTestSuite{},
+			opts:  &Options{},
+		},
+		{
+			name:  "test authz.AccessListPolicy struct",
+			entry: &authz.AccessListPolicy{},
+			opts:  &Options{},
+		},
+		{
+			name:  "test handlers.PageData struct",
+			entry: &handlers.PageData{},
+			opts:  &Options{},
+		},
+		{
+			name:  "test authz.AccessListRoute struct",
+			entry: &authz.AccessListRoute{},
+			opts:  &Options{},
+		},
+		{
+			name:  "test authz.InstanceRuleCounters struct",
+			entry: &authz.InstanceRuleCounters{},
+			opts:  &Options{},
+		},
+		{
+			name:  "test authorize.AdminAPI struct",
+			entry: &authorize.AdminAPI{},
+			opts:  &Options{},
+		},
 		{
 			name:  "test authz.InstanceManager struct",
 			entry: &authz.InstanceManager{},
@@ -105,6 +216,11 @@ func TestTagCompliance(t *testing.T) {
 				DisableTagMismatch: true,
 			},
 		},
+		{
+			name:  "test authorize.App struct",
+			entry: &authorize.App{},
+			opts:  &Options{},
+		},
 		{
 			name:  "test authorize.AuthMiddleware struct",
 			entry: &authorize.AuthMiddleware{},
@@ -159,6 +275,46 @@ func TestTagCompliance(t *testing.T) {
 			entry: &authz.HeaderInjectionConfig{},
 			opts:  &Options{},
 		},
+		{
+			name:  "test authz.UpstreamTokenConfig struct",
+			entry: &authz.UpstreamTokenConfig{},
+			opts:  &Options{},
+		},
+		{
+			name:  "test authz.SessionRefreshConfig struct",
+			entry: &authz.SessionRefreshConfig{},
+			opts:  &Options{},
+		},
+		{
+			name:  "test authz.CookieConfig struct",
+			entry: &authz.CookieConfig{},
+			opts:  &Options{},
+		},
+		{
+			name:  "test authz.LogoutConfig struct",
+			entry: &authz.LogoutConfig{},
+			opts:  &Options{},
+		},
+		{
+			name:  "test authz.WhoamiResponse struct",
+			entry: &authz.WhoamiResponse{},
+			opts:  &Options{},
+		},
+		{
+			name:  "test authorize.WhoamiHandler struct",
+			entry: &authorize.WhoamiHandler{},
+			opts:  &Options{},
+		},
+		{
+			name:  "test authorize.LogoutHandler struct",
+			entry: &authorize.LogoutHandler{},
+			opts:  &Options{},
+		},
+		{
+			name:  "test grantor.TokenGrantor struct",
+			entry: &grantor.TokenGrantor{},
+			opts:  &Options{},
+		},
 	}
 
 	for _, tc := range testcases {
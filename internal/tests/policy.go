@@ -0,0 +1,43 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"testing"
+)
+
+// PolicyTestSuite is a set of policy test cases, e.g. authz.PolicyTestSuite.
+// The Run function returns the name of the test case at the index and the
+// difference between its expected and actual outcome.
+type PolicyTestSuite interface {
+	Len() int
+	Run(int) (string, string, error)
+}
+
+// EvalPolicyTests runs each test case of the policy test suite as a subtest
+// and reports the difference between the expected and actual outcomes.
+func EvalPolicyTests(t *testing.T, suite PolicyTestSuite) {
+	for i := 0; i < suite.Len(); i++ {
+		name, diff, err := suite.Run(i)
+		t.Run(name, func(t *testing.T) {
+			if err != nil {
+				t.Fatalf("policy test %q failed: %v", name, err)
+			}
+			if diff != "" {
+				t.Errorf("policy test %q mismatch (-want +got):\n%s", name, diff)
+			}
+		})
+	}
+}
@@ -18,25 +18,55 @@ import (
 	// "github.com/greenpau/caddy-authorize/pkg/errors"
 	"context"
 	"go.uber.org/zap"
+	"sort"
+	"sync/atomic"
 )
 
 // AccessList is a collection of access list rules.
 type AccessList struct {
-	config       []*RuleConfiguration
-	rules        []aclRule
-	logger       *zap.Logger
-	defaultAllow bool
+	config        []*RuleConfiguration
+	rules         []*aclRule
+	logger        *zap.Logger
+	defaultAllow  bool
+	requestFields map[string]bool
+	claimFields   map[string]bool
+	placeholders  map[string]bool
+	slotCount     int
+	inputFields   []string
+	traceEnabled  bool
+	// Enables the recording of the decisions in the contexts created with
+	// NewDecisionContext.
+	decisionsEnabled bool
+	shadow           *shadowPolicy
+	file             *filePolicy
 }
 
+// RuleCounter is the snapshot of the counters of an access list rule
+// having the counter action.
+type RuleCounter struct {
+	Index   int    `json:"index" xml:"index" yaml:"index"`
+	Comment string `json:"comment" xml:"comment" yaml:"comment"`
+	Tag     string `json:"tag" xml:"tag" yaml:"tag"`
+	Match   uint64 `json:"match" xml:"match" yaml:"match"`
+	Miss    uint64 `json:"miss" xml:"miss" yaml:"miss"`
+}
+
+// maxInputSlots is the maximum number of the input data fields, shared by
+// the rules of AccessList, whose values are looked up once per evaluation.
+const maxInputSlots = 16
+
 // NewAccessList returns an instance of AccessList.
 func NewAccessList() *AccessList {
 	return &AccessList{
-		rules: []aclRule{},
+		rules: []*aclRule{},
 	}
 }
 
 // GetRules returns configured ACL rules.
 func (acl *AccessList) GetRules() []*RuleConfiguration {
+	if acl.file != nil {
+		return acl.file.load().GetRules()
+	}
 	return acl.config
 }
 
@@ -69,45 +99,257 @@ func (acl *AccessList) AddRule(ctx context.Context, cfg *RuleConfiguration) erro
 	}
 	acl.config = append(acl.config, cfg)
 	acl.rules = append(acl.rules, rule)
+	acl.assignInputSlots()
+	for _, field := range rule.getConfig(ctx).fields {
+		switch {
+		case IsRequestField(field):
+			if acl.requestFields == nil {
+				acl.requestFields = make(map[string]bool)
+			}
+			acl.requestFields[field] = true
+		case IsClaimField(field):
+			if acl.claimFields == nil {
+				acl.claimFields = make(map[string]bool)
+			}
+			acl.claimFields[field] = true
+		}
+	}
+	for _, placeholder := range rule.getConfig(ctx).placeholders {
+		if acl.placeholders == nil {
+			acl.placeholders = make(map[string]bool)
+		}
+		acl.placeholders[placeholder] = true
+	}
+	acl.updateInputFields()
 	return nil
 }
 
+// updateInputFields updates the names of the input data fields which are
+// not part of user data, i.e. the request fields, the claim fields, and the
+// placeholders.
+func (acl *AccessList) updateInputFields() {
+	acl.inputFields = append(acl.GetRequestFields(), acl.GetClaimFields()...)
+	acl.inputFields = append(acl.inputFields, acl.GetPlaceholders()...)
+}
+
+// assignInputSlots assigns the slots to the input data fields referenced by
+// more than one rule. The values of the fields having no slot are looked up
+// in the input data by each rule.
+func (acl *AccessList) assignInputSlots() {
+	refs := make(map[string]int)
+	for _, rule := range acl.rules {
+		for _, m := range rule.matchers {
+			refs[m.field]++
+		}
+	}
+	slots := make(map[string]int)
+	acl.slotCount = 0
+	for _, rule := range acl.rules {
+		for i, m := range rule.matchers {
+			slot, exists := slots[m.field]
+			if !exists {
+				slot = -1
+				if refs[m.field] > 1 && acl.slotCount < maxInputSlots {
+					slot = acl.slotCount
+					acl.slotCount++
+				}
+				slots[m.field] = slot
+			}
+			rule.matchers[i].slot = slot
+		}
+	}
+}
+
+// GetRequestFields returns the names of the fields derived from HTTP
+// requests, e.g. host or header.X-Tenant, referenced by the rules of
+// AccessList and its shadow AccessList.
+func (acl *AccessList) GetRequestFields() []string {
+	if acl.file != nil {
+		return acl.file.load().GetRequestFields()
+	}
+	if acl.shadow != nil {
+		return getSortedKeys(acl.requestFields, acl.shadow.accessList.requestFields)
+	}
+	return getSortedKeys(acl.requestFields)
+}
+
+// GetClaimFields returns the names of the fields resolved via dotted paths
+// into token claims, e.g. claim.metadata.department, referenced by the
+// rules of AccessList and its shadow AccessList.
+func (acl *AccessList) GetClaimFields() []string {
+	if acl.file != nil {
+		return acl.file.load().GetClaimFields()
+	}
+	if acl.shadow != nil {
+		return getSortedKeys(acl.claimFields, acl.shadow.accessList.claimFields)
+	}
+	return getSortedKeys(acl.claimFields)
+}
+
+// GetPlaceholders returns the placeholders, e.g. {http.request.uri.path.1}
+// or {claim.sub}, referenced by the condition values of the rules of
+// AccessList and its shadow AccessList. The values of the placeholders must be resolved per request
+// and passed in the input data under the names of the placeholders.
+func (acl *AccessList) GetPlaceholders() []string {
+	if acl.file != nil {
+		return acl.file.load().GetPlaceholders()
+	}
+	if acl.shadow != nil {
+		return getSortedKeys(acl.placeholders, acl.shadow.accessList.placeholders)
+	}
+	return getSortedKeys(acl.placeholders)
+}
+
+// GetInputFields returns the names of the request fields, the claim
+// fields, and the placeholders referenced by AccessList. The values of the
+// fields must be added to the user data passed to the Allow function.
+func (acl *AccessList) GetInputFields() []string {
+	if acl.file != nil {
+		return acl.file.load().inputFields
+	}
+	return acl.inputFields
+}
+
+// IsCacheable returns true when the decision of AccessList for a user
+// holds for the subsequent requests with the same token. It is not the
+// case when AccessList references the input fields, when the rules are
+// reloaded from a file, or when the shadow AccessList counts the decisions.
+func (acl *AccessList) IsCacheable() bool {
+	return acl.file == nil && acl.shadow == nil && len(acl.inputFields) == 0
+}
+
+func getSortedKeys(maps ...map[string]bool) []string {
+	var keys []string
+	seen := make(map[string]bool)
+	for _, m := range maps {
+		for k := range m {
+			if seen[k] {
+				continue
+			}
+			seen[k] = true
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// GetRuleCounters returns the snapshot of the counters of the rules of
+// AccessList having the counter action. The index of a rule is its position
+// in AccessList.
+func (acl *AccessList) GetRuleCounters() []*RuleCounter {
+	if acl.file != nil {
+		return acl.file.load().GetRuleCounters()
+	}
+	var counters []*RuleCounter
+	for i, rule := range acl.rules {
+		if rule.counter == nil {
+			continue
+		}
+		counters = append(counters, &RuleCounter{
+			Index:   i,
+			Comment: rule.config.comment,
+			Tag:     rule.config.tag,
+			Match:   atomic.LoadUint64(&rule.counter.counterMatch),
+			Miss:    atomic.LoadUint64(&rule.counter.counterMiss),
+		})
+	}
+	return counters
+}
+
 // Allow takes in client identity and metadata and returns an error when
 // denied access.
 func (acl *AccessList) Allow(ctx context.Context, data map[string]interface{}) bool {
-	var grantAccess bool
-	for _, rule := range acl.rules {
-		v := rule.eval(ctx, data)
-		switch v {
+	switch {
+	case acl.traceEnabled || acl.shadow != nil || acl.file != nil:
+		return acl.allowWithOptions(ctx, data)
+	case acl.slotCount > 0:
+		return acl.evaluateWithSlots(ctx, data)
+	}
+	return acl.evaluate(ctx, data, nil)
+}
+
+// evaluateWithSlots evaluates the rules sharing the slots of the input
+// data fields. The slots are kept out of the frame of Allow, because the
+// large frame slows down the access lists without the shared fields.
+//
+//go:noinline
+func (acl *AccessList) evaluateWithSlots(ctx context.Context, data map[string]interface{}) bool {
+	var slots ruleInputSlots
+	return acl.evaluate(ctx, data, &slots)
+}
+
+// evaluate evaluates the rules of AccessList. When the recording of the
+// decisions is enabled, the decision is recorded in the context created
+// with NewDecisionContext, at the cost of a single context lookup.
+func (acl *AccessList) evaluate(ctx context.Context, data map[string]interface{}, slots *ruleInputSlots) bool {
+	// The index of the rule deciding the outcome, i.e. the rule denying
+	// access or the last rule allowing it.
+	deciding := -1
+	allow := acl.defaultAllow
+	for i, rule := range acl.rules {
+		switch rule.evalInput(ctx, data, slots) {
 		case ruleVerdictAllowStop:
-			return true
+			return acl.decide(ctx, true, i)
 		case ruleVerdictAllow:
-			grantAccess = true
-		case ruleVerdictDenyStop:
-			return false
-		case ruleVerdictDeny:
-			return false
+			deciding = i
+			allow = true
+		case ruleVerdictDenyStop, ruleVerdictDeny:
+			return acl.decide(ctx, false, i)
 		}
 	}
-	if grantAccess || acl.defaultAllow {
-		return true
+	return acl.decide(ctx, allow, deciding)
+}
+
+// decide returns the outcome of the evaluation of AccessList. When the
+// recording of the decisions is enabled, it records the outcome along
+// with the index of the rule deciding it.
+func (acl *AccessList) decide(ctx context.Context, allow bool, index int) bool {
+	if acl.decisionsEnabled {
+		acl.recordDecision(ctx, allow, index)
 	}
-	return false
+	return allow
 }
 
-// GetFieldDataType return data type for a particular data field.
-func GetFieldDataType(s string) (string, string) {
-	k := s
-	dt := dataTypeUnknown
-	if v, exists := inputDataAliases[s]; exists {
-		if vdt, exists := inputDataTypes[v]; exists {
-			dt = vdt
-			k = v
+// allowWithOptions evaluates AccessList with the decision trace and the
+// shadow AccessList, when enabled. When the rules are loaded from a file,
+// the function evaluates the most recent rules.
+func (acl *AccessList) allowWithOptions(ctx context.Context, data map[string]interface{}) bool {
+	if acl.file != nil {
+		return acl.file.load().Allow(ctx, data)
+	}
+	var trace *DecisionTrace
+	if acl.traceEnabled {
+		trace, _ = ctx.Value(traceContextKey{}).(*DecisionTrace)
+	}
+	var allow bool
+	if trace != nil {
+		allow = acl.allowWithTrace(ctx, data, trace)
+		if acl.decisionsEnabled {
+			if decision, ok := ctx.Value(decisionContextKey{}).(*Decision); ok {
+				var tag string
+				if rule := trace.GetDecidingRule(); rule != nil {
+					tag = rule.Tag
+				}
+				decision.record(allow, tag)
+			}
 		}
+	} else if acl.slotCount > 0 {
+		allow = acl.evaluateWithSlots(ctx, data)
 	} else {
-		if sdt, exists := inputDataTypes[s]; exists {
-			dt = sdt
-		}
+		allow = acl.evaluate(ctx, data, nil)
+	}
+	if acl.shadow != nil {
+		acl.shadow.compare(ctx, data, allow, acl.logger)
+	}
+	return allow
+}
+
+// GetFieldDataType return data type for a particular data field.
+func GetFieldDataType(s string) (string, string) {
+	k, dt := getFieldNameDataType(s)
+	if dt == dataTypeUnknown {
+		k = s
 	}
 	switch dt {
 	case dataTypeListStr:
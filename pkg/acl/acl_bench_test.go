@@ -0,0 +1,149 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acl
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"testing"
+)
+
+func BenchmarkAccessListAllow(b *testing.B) {
+	var benchmarks = []struct {
+		name   string
+		config []*RuleConfiguration
+		input  map[string]interface{}
+	}{
+		{
+			name: "single condition allow stop",
+			config: []*RuleConfiguration{
+				{
+					Conditions: []string{"match roles admin editor viewer"},
+					Action:     `allow stop`,
+				},
+			},
+			input: map[string]interface{}{
+				"name":  "John Smith",
+				"roles": []string{"guest", "viewer"},
+			},
+		},
+		{
+			name: "match all conditions with counter",
+			config: []*RuleConfiguration{
+				{
+					Conditions: []string{
+						"match roles admin editor viewer",
+						"prefix match path /app/",
+						"suffix match email @contoso.com",
+					},
+					Action: `allow stop counter`,
+				},
+			},
+			input: map[string]interface{}{
+				"roles": []string{"viewer"},
+				"path":  "/app/dashboard",
+				"email": "jsmith@contoso.com",
+			},
+		},
+		{
+			name: "match any condition",
+			config: []*RuleConfiguration{
+				{
+					Conditions: []string{
+						"match roles admin",
+						"match org nyc sfo",
+						"exact match email jsmith@contoso.com",
+					},
+					Action: `allow any`,
+				},
+			},
+			input: map[string]interface{}{
+				"roles": []string{"viewer"},
+				"org":   []string{"sfo"},
+				"email": "jdoe@contoso.com",
+			},
+		},
+		{
+			name: "regex match with logging",
+			config: []*RuleConfiguration{
+				{
+					Conditions: []string{"regex match email ^[a-z]+@contoso\\.com$"},
+					Action:     `allow stop log debug`,
+				},
+			},
+			input: map[string]interface{}{
+				"email": "jsmith@contoso.com",
+			},
+		},
+		{
+			name: "ten rules with deny fall through",
+			config: []*RuleConfiguration{
+				{Conditions: []string{"match roles admin"}, Action: `deny stop counter`},
+				{Conditions: []string{"match org nyc"}, Action: `deny counter`},
+				{Conditions: []string{"match roles guest", "prefix match path /admin"}, Action: `deny stop`},
+				{Conditions: []string{"exact match method DELETE"}, Action: `deny`},
+				{Conditions: []string{"suffix match email @fabrikam.com"}, Action: `deny stop`},
+				{Conditions: []string{"partial match path /private/"}, Action: `deny`},
+				{Conditions: []string{"match scopes write:books"}, Action: `deny counter`},
+				{Conditions: []string{"match aud https://localhost/"}, Action: `deny`},
+				{Conditions: []string{"match roles editor", "match org lax"}, Action: `allow any counter`},
+				{Conditions: []string{"match roles viewer"}, Action: `allow stop`},
+			},
+			input: map[string]interface{}{
+				"roles":  []string{"viewer"},
+				"org":    []string{"sfo"},
+				"email":  "jsmith@contoso.com",
+				"path":   "/app/dashboard",
+				"method": "GET",
+				"scopes": []string{"read:books"},
+				"aud":    []string{"https://contoso.com/"},
+			},
+		},
+	}
+	for _, bm := range benchmarks {
+		// The plugin records the decisions of the access lists, so that the
+		// tag of the deciding rule could be published as a placeholder.
+		for _, decisionsEnabled := range []bool{false, true} {
+			name := bm.name
+			if decisionsEnabled {
+				name += " with decisions"
+			}
+			b.Run(name, func(b *testing.B) {
+				ctx := context.Background()
+				accessList := NewAccessList()
+				accessList.SetLogger(zap.NewNop())
+				if err := accessList.AddRules(ctx, bm.config); err != nil {
+					b.Fatalf("unexpected error: %v", err)
+				}
+				var decision *Decision
+				if decisionsEnabled {
+					accessList.EnableDecisions()
+					ctx, decision = NewDecisionContext(ctx)
+				}
+				if !accessList.Allow(ctx, bm.input) {
+					b.Fatalf("unexpected deny")
+				}
+				if decision != nil && !decision.Allow {
+					b.Fatalf("decision not recorded")
+				}
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					accessList.Allow(ctx, bm.input)
+				}
+			})
+		}
+	}
+}
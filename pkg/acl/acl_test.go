@@ -26,14 +26,16 @@ import (
 
 func TestNewAccessList(t *testing.T) {
 	var testcases = []struct {
-		name         string
-		config       []*RuleConfiguration
-		batch        bool
-		defaultAllow bool
-		input        map[string]interface{}
-		want         map[string]interface{}
-		shouldErr    bool
-		err          error
+		name          string
+		config        []*RuleConfiguration
+		batch         bool
+		defaultAllow  bool
+		input         map[string]interface{}
+		requestFields []string
+		claimFields   []string
+		want          map[string]interface{}
+		shouldErr     bool
+		err           error
 	}{
 		{
 			name: "new access list with logging",
@@ -188,6 +190,54 @@ func TestNewAccessList(t *testing.T) {
 				"allow": false,
 			},
 		},
+		{
+			name: "new access list with request fields",
+			config: []*RuleConfiguration{
+				{
+					Conditions: []string{
+						"exact match roles foobar",
+						"exact match header.x-tenant contoso",
+					},
+					Action: `allow stop`,
+				},
+				{
+					Conditions: []string{
+						"suffix match host .contoso.com",
+						"exact match scheme https",
+						"exact match path /foo",
+					},
+					Action: `deny`,
+				},
+			},
+			input: map[string]interface{}{
+				"roles":           []string{"foobar"},
+				"header.X-Tenant": []string{"contoso"},
+			},
+			requestFields: []string{"header.X-Tenant", "host", "scheme"},
+			want: map[string]interface{}{
+				"allow": true,
+			},
+		},
+		{
+			name: "new access list with claim fields",
+			config: []*RuleConfiguration{
+				{
+					Conditions: []string{
+						"exact match claim.email_verified true",
+						"exact match claim.metadata.department engineering",
+					},
+					Action: `allow`,
+				},
+			},
+			input: map[string]interface{}{
+				"claim.email_verified":      []string{"true"},
+				"claim.metadata.department": []string{"engineering"},
+			},
+			claimFields: []string{"claim.email_verified", "claim.metadata.department"},
+			want: map[string]interface{}{
+				"allow": true,
+			},
+		},
 		{
 			name: "new access list with default deny",
 			config: []*RuleConfiguration{
@@ -237,8 +287,80 @@ func TestNewAccessList(t *testing.T) {
 			got := make(map[string]interface{})
 			got["allow"] = accessList.Allow(ctx, tc.input)
 			got["rule_count"] = len(accessList.GetRules())
+			if len(tc.requestFields) > 0 {
+				tc.want["request_fields"] = tc.requestFields
+				got["request_fields"] = accessList.GetRequestFields()
+			}
+			if len(tc.claimFields) > 0 {
+				tc.want["claim_fields"] = tc.claimFields
+				got["claim_fields"] = accessList.GetClaimFields()
+			}
 
 			tests.EvalObjects(t, "eval", tc.want, got)
 		})
 	}
 }
+
+func TestAccessListRuleCounters(t *testing.T) {
+	var testcases = []struct {
+		name   string
+		config []*RuleConfiguration
+		inputs []map[string]interface{}
+		want   []*RuleCounter
+	}{
+		{
+			name: "count matches and misses of rules with counter",
+			config: []*RuleConfiguration{
+				{
+					Comment:    "deny guests",
+					Conditions: []string{"match roles guest"},
+					Action:     `deny stop counter`,
+				},
+				{
+					Conditions: []string{"match roles viewer"},
+					Action:     `allow`,
+				},
+				{
+					Conditions: []string{"match roles admin editor"},
+					Action:     `allow stop counter tag editors`,
+				},
+			},
+			inputs: []map[string]interface{}{
+				{"roles": []string{"guest"}},
+				{"roles": []string{"viewer"}},
+				{"roles": []string{"editor"}},
+				{"roles": []string{"admin"}},
+			},
+			want: []*RuleCounter{
+				{Index: 0, Comment: "deny guests", Tag: "rule0", Match: 1, Miss: 3},
+				{Index: 2, Tag: "editors", Match: 2, Miss: 1},
+			},
+		},
+		{
+			name: "no rules with counter",
+			config: []*RuleConfiguration{
+				{
+					Conditions: []string{"match roles viewer"},
+					Action:     `allow`,
+				},
+			},
+			inputs: []map[string]interface{}{
+				{"roles": []string{"viewer"}},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			accessList := NewAccessList()
+			accessList.SetLogger(utils.NewLogger())
+			if err := accessList.AddRules(ctx, tc.config); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, input := range tc.inputs {
+				accessList.Allow(ctx, input)
+			}
+			tests.EvalObjects(t, "counters", tc.want, accessList.GetRuleCounters())
+		})
+	}
+}
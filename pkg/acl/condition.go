@@ -17,6 +17,7 @@ package acl
 import (
 	"context"
 	"fmt"
+	"net/textproto"
 	"regexp"
 	"strings"
 )
@@ -40,6 +41,49 @@ var (
 		"method":   dataTypeStr,
 		"path":     dataTypeStr,
 		"username": dataTypeStr,
+		"host":     dataTypeStr,
+		"scheme":   dataTypeStr,
+	}
+
+	// requestDataFields are the fields derived from HTTP requests,
+	// as opposed to the fields derived from token claims. The method
+	// and path fields are not in the list, because they are the part of
+	// validate method path feature.
+	requestDataFields = map[string]bool{
+		"host":   true,
+		"scheme": true,
+	}
+
+	// requestDataPrefixes are the prefixes of the fields derived from
+	// HTTP request headers and query parameters, e.g. header.X-Tenant.
+	requestDataPrefixes = map[string]dataType{
+		"header.": dataTypeListStr,
+		"query.":  dataTypeListStr,
+	}
+
+	// claimDataPrefix is the prefix of the fields resolved via dotted
+	// paths into the claims of a token, e.g. claim.metadata.department.
+	claimDataPrefix = "claim."
+
+	// nonObjectClaims are the standard claims that could never hold
+	// nested claims, e.g. claim.email.domain never resolves.
+	nonObjectClaims = map[string]bool{
+		"aud":      true,
+		"exp":      true,
+		"jti":      true,
+		"iat":      true,
+		"iss":      true,
+		"nbf":      true,
+		"sub":      true,
+		"email":    true,
+		"name":     true,
+		"roles":    true,
+		"origin":   true,
+		"scopes":   true,
+		"org":      true,
+		"addr":     true,
+		"picture":  true,
+		"username": true,
 	}
 
 	inputDataAliases = map[string]string{
@@ -60,6 +104,8 @@ var (
 		"ipv4":         "addr",
 		"http_method":  "method",
 		"http_path":    "path",
+		"http_host":    "host",
+		"http_scheme":  "scheme",
 	}
 )
 
@@ -78,16 +124,6 @@ const (
 	fieldMatchAlways   fieldMatchStrategy = 7
 )
 
-type field struct {
-	name   string
-	length int
-}
-
-type expr struct {
-	value  string
-	length int
-}
-
 type config struct {
 	field         string
 	matchStrategy fieldMatchStrategy
@@ -97,509 +133,313 @@ type config struct {
 	exprDataType  dataType
 	inputDataType dataType
 	conditionType string
+	input         string
 }
 
+// aclRuleCondition is the condition of an access list rule. It matches
+// the value of a single input data field.
 type aclRuleCondition interface {
 	match(context.Context, interface{}) bool
 	getConfig(context.Context) *config
 }
 
-// ruleListStrCondExactMatchListStrInput matches a list of strings input against a
-// list of strings where any of the input values match at least one value of the
-// condition using exact match.
-type ruleListStrCondExactMatchListStrInput struct {
-	field  *field
-	exprs  []*expr
-	config *config
-}
-
-// ruleListStrCondPartialMatchListStrInput matches a list of strings input against
-// a list of strings where any of the input values match at least one value of the
-// condition using substring match.
-type ruleListStrCondPartialMatchListStrInput struct {
-	field  *field
-	exprs  []*expr
-	config *config
-}
-
-// ruleListStrCondPrefixMatchListStrInput matches a list of strings input against a
-// list of strings where any of the input values match at least one value of the
-// condition using string prefix match.
-type ruleListStrCondPrefixMatchListStrInput struct {
-	field  *field
-	exprs  []*expr
-	config *config
-}
-
-// ruleListStrCondSuffixMatchListStrInput matches a list of strings input against a
-// list of strings where any of the input values match at least one value of the
-// condition using string suffix match.
-type ruleListStrCondSuffixMatchListStrInput struct {
-	field  *field
-	exprs  []*expr
-	config *config
-}
-
-// ruleListStrCondRegexMatchListStrInput matches a list of strings input against a
-// list of strings where any of the input values match at least one value of the
-// condition using regular expressions match.
-type ruleListStrCondRegexMatchListStrInput struct {
-	field  *field
-	exprs  []*regexp.Regexp
-	config *config
+// stringMatcher matches an input string against the values of a condition.
+type stringMatcher interface {
+	matchString(string) bool
 }
 
-// ruleListStrCondAlwaysMatchListStrInput matches a list of strings input against a
-// list of strings where any of the input values match at least one value of the
-// condition using always match.
-type ruleListStrCondAlwaysMatchListStrInput struct {
-	field  *field
-	exprs  []*expr
+// ruleCondition holds the configuration of the compiled conditions.
+type ruleCondition struct {
 	config *config
 }
 
-// ruleStrCondExactMatchListStrInput matches a list of strings input against a
-// string condition using exact match.
-type ruleStrCondExactMatchListStrInput struct {
-	field  *field
-	expr   *expr
-	config *config
+// exactCondition matches when any of the input values equals at least one
+// value of the condition.
+type exactCondition struct {
+	ruleCondition
+	values []string
 }
 
-// ruleStrCondPartialMatchListStrInput matches a list of strings input against a
-// string condition using substring match.
-type ruleStrCondPartialMatchListStrInput struct {
-	field  *field
-	expr   *expr
-	config *config
+// partialCondition matches when any of the input values contains at least
+// one value of the condition.
+type partialCondition struct {
+	ruleCondition
+	values []string
 }
 
-// ruleStrCondPrefixMatchListStrInput matches a list of strings input against a
-// string condition using string prefix match.
-type ruleStrCondPrefixMatchListStrInput struct {
-	field  *field
-	expr   *expr
-	config *config
+// prefixCondition matches when any of the input values starts with at least
+// one value of the condition.
+type prefixCondition struct {
+	ruleCondition
+	values []string
 }
 
-// ruleStrCondSuffixMatchListStrInput matches a list of strings input against a
-// string condition using string suffix match.
-type ruleStrCondSuffixMatchListStrInput struct {
-	field  *field
-	expr   *expr
-	config *config
+// suffixCondition matches when any of the input values ends with at least
+// one value of the condition.
+type suffixCondition struct {
+	ruleCondition
+	values []string
 }
 
-// ruleStrCondRegexMatchListStrInput matches a list of strings input against a
-// string condition using regular expressions match.
-type ruleStrCondRegexMatchListStrInput struct {
-	field  *field
-	expr   *regexp.Regexp
-	config *config
+// exactValueCondition is exactCondition having a single value. It matches
+// when any of the input values equals the value of the condition.
+type exactValueCondition struct {
+	ruleCondition
+	value string
 }
 
-// ruleStrCondAlwaysMatchListStrInput matches a list of strings input against a
-// string condition using always match.
-type ruleStrCondAlwaysMatchListStrInput struct {
-	field  *field
-	expr   *expr
-	config *config
+// partialValueCondition is partialCondition having a single value. It matches
+// when any of the input values contains the value of the condition.
+type partialValueCondition struct {
+	ruleCondition
+	value string
 }
 
-// ruleListStrCondExactMatchStrInput matches an input string against a list of
-// strings where any of the input values match at least one value of the condition
-// using exact match.
-type ruleListStrCondExactMatchStrInput struct {
-	field  *field
-	exprs  []*expr
-	config *config
+// prefixValueCondition is prefixCondition having a single value. It matches
+// when any of the input values starts with the value of the condition.
+type prefixValueCondition struct {
+	ruleCondition
+	value string
 }
 
-// ruleListStrCondPartialMatchStrInput matches an input string against a list of
-// strings where any of the input values match at least one value of the condition
-// using substring match.
-type ruleListStrCondPartialMatchStrInput struct {
-	field  *field
-	exprs  []*expr
-	config *config
+// suffixValueCondition is suffixCondition having a single value. It matches
+// when any of the input values ends with the value of the condition.
+type suffixValueCondition struct {
+	ruleCondition
+	value string
 }
 
-// ruleListStrCondPrefixMatchStrInput matches an input string against a list of
-// strings where any of the input values match at least one value of the condition
-// using string prefix match.
-type ruleListStrCondPrefixMatchStrInput struct {
-	field  *field
-	exprs  []*expr
-	config *config
+// regexCondition matches when any of the input values matches at least one
+// regular expression of the condition.
+type regexCondition struct {
+	ruleCondition
+	exprs []*regexp.Regexp
 }
 
-// ruleListStrCondSuffixMatchStrInput matches an input string against a list of
-// strings where any of the input values match at least one value of the condition
-// using string suffix match.
-type ruleListStrCondSuffixMatchStrInput struct {
-	field  *field
-	exprs  []*expr
-	config *config
-}
-
-// ruleListStrCondRegexMatchStrInput matches an input string against a list of
-// strings where any of the input values match at least one value of the condition
-// using regular expressions match.
-type ruleListStrCondRegexMatchStrInput struct {
-	field  *field
-	exprs  []*regexp.Regexp
-	config *config
-}
-
-// ruleListStrCondAlwaysMatchStrInput matches an input string against a list of
-// strings where any of the input values match at least one value of the condition
-// using always match.
-type ruleListStrCondAlwaysMatchStrInput struct {
-	field  *field
-	exprs  []*expr
-	config *config
-}
-
-// ruleStrCondExactMatchStrInput matches an input string against a string condition
-// using exact match.
-type ruleStrCondExactMatchStrInput struct {
-	field  *field
-	expr   *expr
-	config *config
+// alwaysCondition matches any input.
+type alwaysCondition struct {
+	ruleCondition
 }
 
-// ruleStrCondPartialMatchStrInput matches an input string against a string
-// condition using substring match.
-type ruleStrCondPartialMatchStrInput struct {
-	field  *field
-	expr   *expr
-	config *config
-}
-
-// ruleStrCondPrefixMatchStrInput matches an input string against a string
-// condition using string prefix match.
-type ruleStrCondPrefixMatchStrInput struct {
-	field  *field
-	expr   *expr
-	config *config
-}
-
-// ruleStrCondSuffixMatchStrInput matches an input string against a string
-// condition using string suffix match.
-type ruleStrCondSuffixMatchStrInput struct {
-	field  *field
-	expr   *expr
-	config *config
-}
-
-// ruleStrCondRegexMatchStrInput matches an input string against a string condition
-// using regular expressions match.
-type ruleStrCondRegexMatchStrInput struct {
-	field  *field
-	expr   *regexp.Regexp
-	config *config
-}
-
-// ruleStrCondAlwaysMatchStrInput matches an input string against a string
-// condition using always match.
-type ruleStrCondAlwaysMatchStrInput struct {
-	field  *field
-	expr   *expr
-	config *config
+func (c *ruleCondition) getConfig(ctx context.Context) *config {
+	return c.config
 }
 
-func (c *ruleListStrCondExactMatchListStrInput) match(ctx context.Context, values interface{}) bool {
-	for _, exp := range c.exprs {
-		for _, v := range values.([]string) {
-			if v == exp.value {
-				return true
-			}
+func (c *exactCondition) matchString(s string) bool {
+	for _, v := range c.values {
+		if s == v {
+			return true
 		}
 	}
 	return false
 }
 
-func (c *ruleListStrCondPartialMatchListStrInput) match(ctx context.Context, values interface{}) bool {
-	for _, exp := range c.exprs {
-		for _, v := range values.([]string) {
-			if strings.Contains(v, exp.value) {
-				return true
-			}
+func (c *partialCondition) matchString(s string) bool {
+	for _, v := range c.values {
+		if strings.Contains(s, v) {
+			return true
 		}
 	}
 	return false
 }
 
-func (c *ruleListStrCondPrefixMatchListStrInput) match(ctx context.Context, values interface{}) bool {
-	for _, exp := range c.exprs {
-		for _, v := range values.([]string) {
-			if strings.HasPrefix(v, exp.value) {
-				return true
-			}
+func (c *prefixCondition) matchString(s string) bool {
+	for _, v := range c.values {
+		if strings.HasPrefix(s, v) {
+			return true
 		}
 	}
 	return false
 }
 
-func (c *ruleListStrCondSuffixMatchListStrInput) match(ctx context.Context, values interface{}) bool {
-	for _, exp := range c.exprs {
-		for _, v := range values.([]string) {
-			if strings.HasSuffix(v, exp.value) {
-				return true
-			}
+func (c *suffixCondition) matchString(s string) bool {
+	for _, v := range c.values {
+		if strings.HasSuffix(s, v) {
+			return true
 		}
 	}
 	return false
 }
 
-func (c *ruleListStrCondRegexMatchListStrInput) match(ctx context.Context, values interface{}) bool {
-	for _, exp := range c.exprs {
-		for _, v := range values.([]string) {
-			if exp.MatchString(v) {
-				return true
-			}
-		}
-	}
-	return false
+func (c *exactValueCondition) matchString(s string) bool {
+	return s == c.value
 }
 
-func (c *ruleListStrCondAlwaysMatchListStrInput) match(ctx context.Context, v interface{}) bool {
-	return true
+func (c *partialValueCondition) matchString(s string) bool {
+	return strings.Contains(s, c.value)
 }
 
-func (c *ruleStrCondExactMatchListStrInput) match(ctx context.Context, values interface{}) bool {
-	for _, v := range values.([]string) {
-		if v == c.expr.value {
-			return true
-		}
-	}
-	return false
+func (c *prefixValueCondition) matchString(s string) bool {
+	return strings.HasPrefix(s, c.value)
 }
 
-func (c *ruleStrCondPartialMatchListStrInput) match(ctx context.Context, values interface{}) bool {
-	for _, v := range values.([]string) {
-		if strings.Contains(v, c.expr.value) {
-			return true
-		}
-	}
-	return false
+func (c *suffixValueCondition) matchString(s string) bool {
+	return strings.HasSuffix(s, c.value)
 }
 
-func (c *ruleStrCondPrefixMatchListStrInput) match(ctx context.Context, values interface{}) bool {
-	for _, v := range values.([]string) {
-		if strings.HasPrefix(v, c.expr.value) {
+func (c *regexCondition) matchString(s string) bool {
+	for _, expr := range c.exprs {
+		if expr.MatchString(s) {
 			return true
 		}
 	}
 	return false
 }
 
-func (c *ruleStrCondSuffixMatchListStrInput) match(ctx context.Context, values interface{}) bool {
-	for _, v := range values.([]string) {
-		if strings.HasSuffix(v, c.expr.value) {
-			return true
+func (c *exactCondition) match(ctx context.Context, v interface{}) bool {
+	switch values := v.(type) {
+	case string:
+		return c.matchString(values)
+	case []string:
+		for _, s := range values {
+			if c.matchString(s) {
+				return true
+			}
 		}
+		return false
 	}
-	return false
+	return matchInterfaceValues(c, v)
 }
 
-func (c *ruleStrCondRegexMatchListStrInput) match(ctx context.Context, values interface{}) bool {
-	for _, v := range values.([]string) {
-		if c.expr.MatchString(v) {
-			return true
+func (c *partialCondition) match(ctx context.Context, v interface{}) bool {
+	switch values := v.(type) {
+	case string:
+		return c.matchString(values)
+	case []string:
+		for _, s := range values {
+			if c.matchString(s) {
+				return true
+			}
 		}
+		return false
 	}
-	return false
+	return matchInterfaceValues(c, v)
 }
 
-func (c *ruleStrCondAlwaysMatchListStrInput) match(ctx context.Context, v interface{}) bool {
-	return true
-}
-
-func (c *ruleListStrCondExactMatchStrInput) match(ctx context.Context, v interface{}) bool {
-	for _, exp := range c.exprs {
-		if v.(string) == exp.value {
-			return true
+func (c *prefixCondition) match(ctx context.Context, v interface{}) bool {
+	switch values := v.(type) {
+	case string:
+		return c.matchString(values)
+	case []string:
+		for _, s := range values {
+			if c.matchString(s) {
+				return true
+			}
 		}
+		return false
 	}
-	return false
+	return matchInterfaceValues(c, v)
 }
 
-func (c *ruleListStrCondPartialMatchStrInput) match(ctx context.Context, v interface{}) bool {
-	for _, exp := range c.exprs {
-		if strings.Contains(v.(string), exp.value) {
-			return true
+func (c *suffixCondition) match(ctx context.Context, v interface{}) bool {
+	switch values := v.(type) {
+	case string:
+		return c.matchString(values)
+	case []string:
+		for _, s := range values {
+			if c.matchString(s) {
+				return true
+			}
 		}
+		return false
 	}
-	return false
+	return matchInterfaceValues(c, v)
 }
 
-func (c *ruleListStrCondPrefixMatchStrInput) match(ctx context.Context, v interface{}) bool {
-	for _, exp := range c.exprs {
-		if strings.HasPrefix(v.(string), exp.value) {
-			return true
+func (c *exactValueCondition) match(ctx context.Context, v interface{}) bool {
+	switch values := v.(type) {
+	case string:
+		return c.matchString(values)
+	case []string:
+		for _, s := range values {
+			if c.matchString(s) {
+				return true
+			}
 		}
+		return false
 	}
-	return false
+	return matchInterfaceValues(c, v)
 }
 
-func (c *ruleListStrCondSuffixMatchStrInput) match(ctx context.Context, v interface{}) bool {
-	for _, exp := range c.exprs {
-		if strings.HasSuffix(v.(string), exp.value) {
-			return true
+func (c *partialValueCondition) match(ctx context.Context, v interface{}) bool {
+	switch values := v.(type) {
+	case string:
+		return c.matchString(values)
+	case []string:
+		for _, s := range values {
+			if c.matchString(s) {
+				return true
+			}
 		}
+		return false
 	}
-	return false
+	return matchInterfaceValues(c, v)
 }
 
-func (c *ruleListStrCondRegexMatchStrInput) match(ctx context.Context, v interface{}) bool {
-	for _, exp := range c.exprs {
-		if exp.MatchString(v.(string)) {
-			return true
+func (c *prefixValueCondition) match(ctx context.Context, v interface{}) bool {
+	switch values := v.(type) {
+	case string:
+		return c.matchString(values)
+	case []string:
+		for _, s := range values {
+			if c.matchString(s) {
+				return true
+			}
 		}
+		return false
 	}
-	return false
-}
-
-func (c *ruleListStrCondAlwaysMatchStrInput) match(ctx context.Context, v interface{}) bool {
-	return true
+	return matchInterfaceValues(c, v)
 }
 
-func (c *ruleStrCondExactMatchStrInput) match(ctx context.Context, v interface{}) bool {
-	if v.(string) == c.expr.value {
-		return true
+func (c *suffixValueCondition) match(ctx context.Context, v interface{}) bool {
+	switch values := v.(type) {
+	case string:
+		return c.matchString(values)
+	case []string:
+		for _, s := range values {
+			if c.matchString(s) {
+				return true
+			}
+		}
+		return false
 	}
-	return false
+	return matchInterfaceValues(c, v)
 }
 
-func (c *ruleStrCondPartialMatchStrInput) match(ctx context.Context, v interface{}) bool {
-	if strings.Contains(v.(string), c.expr.value) {
-		return true
+func (c *regexCondition) match(ctx context.Context, v interface{}) bool {
+	switch values := v.(type) {
+	case string:
+		return c.matchString(values)
+	case []string:
+		for _, s := range values {
+			if c.matchString(s) {
+				return true
+			}
+		}
+		return false
 	}
-	return false
+	return matchInterfaceValues(c, v)
 }
 
-func (c *ruleStrCondPrefixMatchStrInput) match(ctx context.Context, v interface{}) bool {
-	if strings.HasPrefix(v.(string), c.expr.value) {
-		return true
-	}
-	return false
+func (c *alwaysCondition) match(ctx context.Context, v interface{}) bool {
+	return true
 }
 
-func (c *ruleStrCondSuffixMatchStrInput) match(ctx context.Context, v interface{}) bool {
-	if strings.HasSuffix(v.(string), c.expr.value) {
-		return true
+// matchInterfaceValues matches the input values other than a string or
+// a list of strings, e.g. the list of claim values decoded from JSON.
+func matchInterfaceValues(m stringMatcher, v interface{}) bool {
+	values, ok := v.([]interface{})
+	if !ok {
+		return false
 	}
-	return false
-}
-
-func (c *ruleStrCondRegexMatchStrInput) match(ctx context.Context, v interface{}) bool {
-	if c.expr.MatchString(v.(string)) {
-		return true
+	for _, value := range values {
+		if s, ok := value.(string); ok && m.matchString(s) {
+			return true
+		}
 	}
 	return false
 }
 
-func (c *ruleStrCondAlwaysMatchStrInput) match(ctx context.Context, v interface{}) bool {
-	return true
-}
-
-func (c *ruleListStrCondExactMatchListStrInput) getConfig(ctx context.Context) *config {
-	return c.config
-}
-
-func (c *ruleListStrCondPartialMatchListStrInput) getConfig(ctx context.Context) *config {
-	return c.config
-}
-
-func (c *ruleListStrCondPrefixMatchListStrInput) getConfig(ctx context.Context) *config {
-	return c.config
-}
-
-func (c *ruleListStrCondSuffixMatchListStrInput) getConfig(ctx context.Context) *config {
-	return c.config
-}
-
-func (c *ruleListStrCondRegexMatchListStrInput) getConfig(ctx context.Context) *config {
-	return c.config
-}
-
-func (c *ruleListStrCondAlwaysMatchListStrInput) getConfig(ctx context.Context) *config {
-	return c.config
-}
-
-func (c *ruleStrCondExactMatchListStrInput) getConfig(ctx context.Context) *config {
-	return c.config
-}
-
-func (c *ruleStrCondPartialMatchListStrInput) getConfig(ctx context.Context) *config {
-	return c.config
-}
-
-func (c *ruleStrCondPrefixMatchListStrInput) getConfig(ctx context.Context) *config {
-	return c.config
-}
-
-func (c *ruleStrCondSuffixMatchListStrInput) getConfig(ctx context.Context) *config {
-	return c.config
-}
-
-func (c *ruleStrCondRegexMatchListStrInput) getConfig(ctx context.Context) *config {
-	return c.config
-}
-
-func (c *ruleStrCondAlwaysMatchListStrInput) getConfig(ctx context.Context) *config {
-	return c.config
-}
-
-func (c *ruleListStrCondExactMatchStrInput) getConfig(ctx context.Context) *config {
-	return c.config
-}
-
-func (c *ruleListStrCondPartialMatchStrInput) getConfig(ctx context.Context) *config {
-	return c.config
-}
-
-func (c *ruleListStrCondPrefixMatchStrInput) getConfig(ctx context.Context) *config {
-	return c.config
-}
-
-func (c *ruleListStrCondSuffixMatchStrInput) getConfig(ctx context.Context) *config {
-	return c.config
-}
-
-func (c *ruleListStrCondRegexMatchStrInput) getConfig(ctx context.Context) *config {
-	return c.config
-}
-
-func (c *ruleListStrCondAlwaysMatchStrInput) getConfig(ctx context.Context) *config {
-	return c.config
-}
-
-func (c *ruleStrCondExactMatchStrInput) getConfig(ctx context.Context) *config {
-	return c.config
-}
-
-func (c *ruleStrCondPartialMatchStrInput) getConfig(ctx context.Context) *config {
-	return c.config
-}
-
-func (c *ruleStrCondPrefixMatchStrInput) getConfig(ctx context.Context) *config {
-	return c.config
-}
-
-func (c *ruleStrCondSuffixMatchStrInput) getConfig(ctx context.Context) *config {
-	return c.config
-}
-
-func (c *ruleStrCondRegexMatchStrInput) getConfig(ctx context.Context) *config {
-	return c.config
-}
-
-func (c *ruleStrCondAlwaysMatchStrInput) getConfig(ctx context.Context) *config {
-	return c.config
-}
-
 func newACLRuleCondition(ctx context.Context, tokens []string) (aclRuleCondition, error) {
 	var matchStrategy fieldMatchStrategy
 	var condDataType, inputDataType dataType
@@ -640,12 +480,14 @@ func newACLRuleCondition(ctx context.Context, tokens []string) (aclRuleCondition
 				return nil, fmt.Errorf("invalid condition syntax, use of reserved %q keyword: %s", s, condInput)
 			}
 			if !fieldFound {
-				fieldName = s
-				if v, exists := inputDataAliases[s]; exists {
-					fieldName = v
+				var tp dataType
+				if strings.HasPrefix(s, claimDataPrefix) {
+					if err := validateClaimPath(strings.TrimPrefix(s, claimDataPrefix)); err != nil {
+						return nil, fmt.Errorf("invalid condition syntax, unresolvable claim path: %s, %v, condition: %s", s, err, condInput)
+					}
 				}
-				tp, exists := inputDataTypes[fieldName]
-				if !exists {
+				fieldName, tp = getFieldNameDataType(s)
+				if tp == dataTypeUnknown {
 					return nil, fmt.Errorf("invalid condition syntax, unsupported field: %s, condition: %s", s, condInput)
 				}
 				inputDataType = tp
@@ -670,413 +512,41 @@ func newACLRuleCondition(ctx context.Context, tokens []string) (aclRuleCondition
 		condDataType = dataTypeListStr
 	}
 
-	switch {
-
-	case matchStrategy == fieldMatchExact && condDataType == dataTypeListStr && inputDataType == dataTypeListStr:
-		// Match: Exact, Condition Type: ListStr, Input Type: ListStr
-		c := &ruleListStrCondExactMatchListStrInput{
-			config: &config{
-				field:         fieldName,
-				matchStrategy: fieldMatchExact,
-				values:        values,
-				regexEnabled:  false,
-				alwaysTrue:    false,
-				exprDataType:  condDataType,
-				inputDataType: inputDataType,
-				conditionType: `ruleListStrCondExactMatchListStrInput`,
-			},
-			field: &field{
-				name:   fieldName,
-				length: len(fieldName),
-			},
-		}
-		c.exprs = []*expr{}
-		for _, val := range values {
-			c.exprs = append(c.exprs, &expr{
-				value: val,
-			})
-		}
-		return c, nil
-	case matchStrategy == fieldMatchPartial && condDataType == dataTypeListStr && inputDataType == dataTypeListStr:
-		// Match: Partial, Condition Type: ListStr, Input Type: ListStr
-		c := &ruleListStrCondPartialMatchListStrInput{
-			config: &config{
-				field:         fieldName,
-				matchStrategy: fieldMatchPartial,
-				values:        values,
-				regexEnabled:  false,
-				alwaysTrue:    false,
-				exprDataType:  condDataType,
-				inputDataType: inputDataType,
-				conditionType: `ruleListStrCondPartialMatchListStrInput`,
-			},
-			field: &field{
-				name:   fieldName,
-				length: len(fieldName),
-			},
-		}
-		c.exprs = []*expr{}
-		for _, val := range values {
-			c.exprs = append(c.exprs, &expr{
-				value: val,
-			})
-		}
-		return c, nil
-	case matchStrategy == fieldMatchPrefix && condDataType == dataTypeListStr && inputDataType == dataTypeListStr:
-		// Match: Prefix, Condition Type: ListStr, Input Type: ListStr
-		c := &ruleListStrCondPrefixMatchListStrInput{
-			config: &config{
-				field:         fieldName,
-				matchStrategy: fieldMatchPrefix,
-				values:        values,
-				regexEnabled:  false,
-				alwaysTrue:    false,
-				exprDataType:  condDataType,
-				inputDataType: inputDataType,
-				conditionType: `ruleListStrCondPrefixMatchListStrInput`,
-			},
-			field: &field{
-				name:   fieldName,
-				length: len(fieldName),
-			},
-		}
-		c.exprs = []*expr{}
-		for _, val := range values {
-			c.exprs = append(c.exprs, &expr{
-				value: val,
-			})
-		}
-		return c, nil
-	case matchStrategy == fieldMatchSuffix && condDataType == dataTypeListStr && inputDataType == dataTypeListStr:
-		// Match: Suffix, Condition Type: ListStr, Input Type: ListStr
-		c := &ruleListStrCondSuffixMatchListStrInput{
-			config: &config{
-				field:         fieldName,
-				matchStrategy: fieldMatchSuffix,
-				values:        values,
-				regexEnabled:  false,
-				alwaysTrue:    false,
-				exprDataType:  condDataType,
-				inputDataType: inputDataType,
-				conditionType: `ruleListStrCondSuffixMatchListStrInput`,
-			},
-			field: &field{
-				name:   fieldName,
-				length: len(fieldName),
-			},
-		}
-		c.exprs = []*expr{}
-		for _, val := range values {
-			c.exprs = append(c.exprs, &expr{
-				value: val,
-			})
-		}
-		return c, nil
-	case matchStrategy == fieldMatchRegex && condDataType == dataTypeListStr && inputDataType == dataTypeListStr:
-		// Match: Regex, Condition Type: ListStr, Input Type: ListStr
-		c := &ruleListStrCondRegexMatchListStrInput{
-			config: &config{
-				field:         fieldName,
-				matchStrategy: fieldMatchRegex,
-				values:        values,
-				regexEnabled:  true,
-				alwaysTrue:    false,
-				exprDataType:  condDataType,
-				inputDataType: inputDataType,
-				conditionType: `ruleListStrCondRegexMatchListStrInput`,
-			},
-			field: &field{
-				name:   fieldName,
-				length: len(fieldName),
-			},
-		}
-		c.exprs = []*regexp.Regexp{}
-		for _, val := range values {
-			re, err := regexp.Compile(val)
-			if err != nil {
-				return nil, err
-			}
-			c.exprs = append(c.exprs, re)
-		}
-		return c, nil
-	case matchStrategy == fieldMatchAlways && condDataType == dataTypeListStr && inputDataType == dataTypeListStr:
-		// Match: Always, Condition Type: ListStr, Input Type: ListStr
-		c := &ruleListStrCondAlwaysMatchListStrInput{
-			config: &config{
-				field:         fieldName,
-				matchStrategy: fieldMatchAlways,
-				values:        values,
-				regexEnabled:  false,
-				alwaysTrue:    true,
-				exprDataType:  condDataType,
-				inputDataType: inputDataType,
-				conditionType: `ruleListStrCondAlwaysMatchListStrInput`,
-			},
-			field: &field{
-				name:   fieldName,
-				length: len(fieldName),
-			},
-		}
-		c.exprs = []*expr{}
-		for _, val := range values {
-			c.exprs = append(c.exprs, &expr{
-				value: val,
-			})
-		}
-		return c, nil
-	case matchStrategy == fieldMatchExact && condDataType == dataTypeStr && inputDataType == dataTypeListStr:
-		// Match: Exact, Condition Type: Str, Input Type: ListStr
-		c := &ruleStrCondExactMatchListStrInput{
-			config: &config{
-				field:         fieldName,
-				matchStrategy: fieldMatchExact,
-				values:        values,
-				regexEnabled:  false,
-				alwaysTrue:    false,
-				exprDataType:  condDataType,
-				inputDataType: inputDataType,
-				conditionType: `ruleStrCondExactMatchListStrInput`,
-			},
-			field: &field{
-				name:   fieldName,
-				length: len(fieldName),
-			},
-		}
-		c.expr = &expr{
-			value: values[0],
-		}
-		return c, nil
-	case matchStrategy == fieldMatchPartial && condDataType == dataTypeStr && inputDataType == dataTypeListStr:
-		// Match: Partial, Condition Type: Str, Input Type: ListStr
-		c := &ruleStrCondPartialMatchListStrInput{
-			config: &config{
-				field:         fieldName,
-				matchStrategy: fieldMatchPartial,
-				values:        values,
-				regexEnabled:  false,
-				alwaysTrue:    false,
-				exprDataType:  condDataType,
-				inputDataType: inputDataType,
-				conditionType: `ruleStrCondPartialMatchListStrInput`,
-			},
-			field: &field{
-				name:   fieldName,
-				length: len(fieldName),
-			},
-		}
-		c.expr = &expr{
-			value: values[0],
-		}
-		return c, nil
-	case matchStrategy == fieldMatchPrefix && condDataType == dataTypeStr && inputDataType == dataTypeListStr:
-		// Match: Prefix, Condition Type: Str, Input Type: ListStr
-		c := &ruleStrCondPrefixMatchListStrInput{
-			config: &config{
-				field:         fieldName,
-				matchStrategy: fieldMatchPrefix,
-				values:        values,
-				regexEnabled:  false,
-				alwaysTrue:    false,
-				exprDataType:  condDataType,
-				inputDataType: inputDataType,
-				conditionType: `ruleStrCondPrefixMatchListStrInput`,
-			},
-			field: &field{
-				name:   fieldName,
-				length: len(fieldName),
-			},
-		}
-		c.expr = &expr{
-			value: values[0],
-		}
-		return c, nil
-	case matchStrategy == fieldMatchSuffix && condDataType == dataTypeStr && inputDataType == dataTypeListStr:
-		// Match: Suffix, Condition Type: Str, Input Type: ListStr
-		c := &ruleStrCondSuffixMatchListStrInput{
-			config: &config{
-				field:         fieldName,
-				matchStrategy: fieldMatchSuffix,
-				values:        values,
-				regexEnabled:  false,
-				alwaysTrue:    false,
-				exprDataType:  condDataType,
-				inputDataType: inputDataType,
-				conditionType: `ruleStrCondSuffixMatchListStrInput`,
-			},
-			field: &field{
-				name:   fieldName,
-				length: len(fieldName),
-			},
-		}
-		c.expr = &expr{
-			value: values[0],
-		}
-		return c, nil
-	case matchStrategy == fieldMatchRegex && condDataType == dataTypeStr && inputDataType == dataTypeListStr:
-		// Match: Regex, Condition Type: Str, Input Type: ListStr
-		c := &ruleStrCondRegexMatchListStrInput{
-			config: &config{
-				field:         fieldName,
-				matchStrategy: fieldMatchRegex,
-				values:        values,
-				regexEnabled:  true,
-				alwaysTrue:    false,
-				exprDataType:  condDataType,
-				inputDataType: inputDataType,
-				conditionType: `ruleStrCondRegexMatchListStrInput`,
-			},
-			field: &field{
-				name:   fieldName,
-				length: len(fieldName),
-			},
-		}
-		re, err := regexp.Compile(values[0])
-		if err != nil {
-			return nil, err
-		}
-		c.expr = re
-		return c, nil
-	case matchStrategy == fieldMatchAlways && condDataType == dataTypeStr && inputDataType == dataTypeListStr:
-		// Match: Always, Condition Type: Str, Input Type: ListStr
-		c := &ruleStrCondAlwaysMatchListStrInput{
-			config: &config{
-				field:         fieldName,
-				matchStrategy: fieldMatchAlways,
-				values:        values,
-				regexEnabled:  false,
-				alwaysTrue:    true,
-				exprDataType:  condDataType,
-				inputDataType: inputDataType,
-				conditionType: `ruleStrCondAlwaysMatchListStrInput`,
-			},
-			field: &field{
-				name:   fieldName,
-				length: len(fieldName),
-			},
-		}
-		c.expr = &expr{
-			value: values[0],
-		}
-		return c, nil
-	case matchStrategy == fieldMatchExact && condDataType == dataTypeListStr && inputDataType == dataTypeStr:
-		// Match: Exact, Condition Type: ListStr, Input Type: Str
-		c := &ruleListStrCondExactMatchStrInput{
-			config: &config{
-				field:         fieldName,
-				matchStrategy: fieldMatchExact,
-				values:        values,
-				regexEnabled:  false,
-				alwaysTrue:    false,
-				exprDataType:  condDataType,
-				inputDataType: inputDataType,
-				conditionType: `ruleListStrCondExactMatchStrInput`,
-			},
-			field: &field{
-				name:   fieldName,
-				length: len(fieldName),
-			},
-		}
-		c.exprs = []*expr{}
-		for _, val := range values {
-			c.exprs = append(c.exprs, &expr{
-				value: val,
-			})
-		}
-		return c, nil
-	case matchStrategy == fieldMatchPartial && condDataType == dataTypeListStr && inputDataType == dataTypeStr:
-		// Match: Partial, Condition Type: ListStr, Input Type: Str
-		c := &ruleListStrCondPartialMatchStrInput{
-			config: &config{
-				field:         fieldName,
-				matchStrategy: fieldMatchPartial,
-				values:        values,
-				regexEnabled:  false,
-				alwaysTrue:    false,
-				exprDataType:  condDataType,
-				inputDataType: inputDataType,
-				conditionType: `ruleListStrCondPartialMatchStrInput`,
-			},
-			field: &field{
-				name:   fieldName,
-				length: len(fieldName),
-			},
-		}
-		c.exprs = []*expr{}
-		for _, val := range values {
-			c.exprs = append(c.exprs, &expr{
-				value: val,
-			})
-		}
-		return c, nil
-	case matchStrategy == fieldMatchPrefix && condDataType == dataTypeListStr && inputDataType == dataTypeStr:
-		// Match: Prefix, Condition Type: ListStr, Input Type: Str
-		c := &ruleListStrCondPrefixMatchStrInput{
-			config: &config{
-				field:         fieldName,
-				matchStrategy: fieldMatchPrefix,
-				values:        values,
-				regexEnabled:  false,
-				alwaysTrue:    false,
-				exprDataType:  condDataType,
-				inputDataType: inputDataType,
-				conditionType: `ruleListStrCondPrefixMatchStrInput`,
-			},
-			field: &field{
-				name:   fieldName,
-				length: len(fieldName),
-			},
-		}
-		c.exprs = []*expr{}
-		for _, val := range values {
-			c.exprs = append(c.exprs, &expr{
-				value: val,
-			})
-		}
-		return c, nil
-	case matchStrategy == fieldMatchSuffix && condDataType == dataTypeListStr && inputDataType == dataTypeStr:
-		// Match: Suffix, Condition Type: ListStr, Input Type: Str
-		c := &ruleListStrCondSuffixMatchStrInput{
-			config: &config{
-				field:         fieldName,
-				matchStrategy: fieldMatchSuffix,
-				values:        values,
-				regexEnabled:  false,
-				alwaysTrue:    false,
-				exprDataType:  condDataType,
-				inputDataType: inputDataType,
-				conditionType: `ruleListStrCondSuffixMatchStrInput`,
-			},
-			field: &field{
-				name:   fieldName,
-				length: len(fieldName),
-			},
-		}
-		c.exprs = []*expr{}
-		for _, val := range values {
-			c.exprs = append(c.exprs, &expr{
-				value: val,
-			})
-		}
-		return c, nil
-	case matchStrategy == fieldMatchRegex && condDataType == dataTypeListStr && inputDataType == dataTypeStr:
-		// Match: Regex, Condition Type: ListStr, Input Type: Str
-		c := &ruleListStrCondRegexMatchStrInput{
-			config: &config{
-				field:         fieldName,
-				matchStrategy: fieldMatchRegex,
-				values:        values,
-				regexEnabled:  true,
-				alwaysTrue:    false,
-				exprDataType:  condDataType,
-				inputDataType: inputDataType,
-				conditionType: `ruleListStrCondRegexMatchStrInput`,
-			},
-			field: &field{
-				name:   fieldName,
-				length: len(fieldName),
-			},
+	cfg := &config{
+		field:         fieldName,
+		matchStrategy: matchStrategy,
+		values:        values,
+		regexEnabled:  matchStrategy == fieldMatchRegex,
+		alwaysTrue:    matchStrategy == fieldMatchAlways,
+		exprDataType:  condDataType,
+		inputDataType: inputDataType,
+		conditionType: getConditionTypeName(matchStrategy, condDataType, inputDataType),
+		input:         condInput,
+	}
+	// The conditions having a single value do not loop over their values.
+	if len(values) == 1 {
+		switch matchStrategy {
+		case fieldMatchExact:
+			return &exactValueCondition{ruleCondition{cfg}, values[0]}, nil
+		case fieldMatchPartial:
+			return &partialValueCondition{ruleCondition{cfg}, values[0]}, nil
+		case fieldMatchPrefix:
+			return &prefixValueCondition{ruleCondition{cfg}, values[0]}, nil
+		case fieldMatchSuffix:
+			return &suffixValueCondition{ruleCondition{cfg}, values[0]}, nil
 		}
-		c.exprs = []*regexp.Regexp{}
+	}
+	switch matchStrategy {
+	case fieldMatchExact:
+		return &exactCondition{ruleCondition{cfg}, values}, nil
+	case fieldMatchPartial:
+		return &partialCondition{ruleCondition{cfg}, values}, nil
+	case fieldMatchPrefix:
+		return &prefixCondition{ruleCondition{cfg}, values}, nil
+	case fieldMatchSuffix:
+		return &suffixCondition{ruleCondition{cfg}, values}, nil
+	case fieldMatchRegex:
+		c := &regexCondition{ruleCondition: ruleCondition{cfg}}
 		for _, val := range values {
 			re, err := regexp.Compile(val)
 			if err != nil {
@@ -1085,168 +555,84 @@ func newACLRuleCondition(ctx context.Context, tokens []string) (aclRuleCondition
 			c.exprs = append(c.exprs, re)
 		}
 		return c, nil
-	case matchStrategy == fieldMatchAlways && condDataType == dataTypeListStr && inputDataType == dataTypeStr:
-		// Match: Always, Condition Type: ListStr, Input Type: Str
-		c := &ruleListStrCondAlwaysMatchStrInput{
-			config: &config{
-				field:         fieldName,
-				matchStrategy: fieldMatchAlways,
-				values:        values,
-				regexEnabled:  false,
-				alwaysTrue:    true,
-				exprDataType:  condDataType,
-				inputDataType: inputDataType,
-				conditionType: `ruleListStrCondAlwaysMatchStrInput`,
-			},
-			field: &field{
-				name:   fieldName,
-				length: len(fieldName),
-			},
-		}
-		c.exprs = []*expr{}
-		for _, val := range values {
-			c.exprs = append(c.exprs, &expr{
-				value: val,
-			})
-		}
-		return c, nil
-	case matchStrategy == fieldMatchExact && condDataType == dataTypeStr && inputDataType == dataTypeStr:
-		// Match: Exact, Condition Type: Str, Input Type: Str
-		c := &ruleStrCondExactMatchStrInput{
-			config: &config{
-				field:         fieldName,
-				matchStrategy: fieldMatchExact,
-				values:        values,
-				regexEnabled:  false,
-				alwaysTrue:    false,
-				exprDataType:  condDataType,
-				inputDataType: inputDataType,
-				conditionType: `ruleStrCondExactMatchStrInput`,
-			},
-			field: &field{
-				name:   fieldName,
-				length: len(fieldName),
-			},
-		}
-		c.expr = &expr{
-			value: values[0],
-		}
-		return c, nil
-	case matchStrategy == fieldMatchPartial && condDataType == dataTypeStr && inputDataType == dataTypeStr:
-		// Match: Partial, Condition Type: Str, Input Type: Str
-		c := &ruleStrCondPartialMatchStrInput{
-			config: &config{
-				field:         fieldName,
-				matchStrategy: fieldMatchPartial,
-				values:        values,
-				regexEnabled:  false,
-				alwaysTrue:    false,
-				exprDataType:  condDataType,
-				inputDataType: inputDataType,
-				conditionType: `ruleStrCondPartialMatchStrInput`,
-			},
-			field: &field{
-				name:   fieldName,
-				length: len(fieldName),
-			},
-		}
-		c.expr = &expr{
-			value: values[0],
-		}
-		return c, nil
-	case matchStrategy == fieldMatchPrefix && condDataType == dataTypeStr && inputDataType == dataTypeStr:
-		// Match: Prefix, Condition Type: Str, Input Type: Str
-		c := &ruleStrCondPrefixMatchStrInput{
-			config: &config{
-				field:         fieldName,
-				matchStrategy: fieldMatchPrefix,
-				values:        values,
-				regexEnabled:  false,
-				alwaysTrue:    false,
-				exprDataType:  condDataType,
-				inputDataType: inputDataType,
-				conditionType: `ruleStrCondPrefixMatchStrInput`,
-			},
-			field: &field{
-				name:   fieldName,
-				length: len(fieldName),
-			},
-		}
-		c.expr = &expr{
-			value: values[0],
-		}
-		return c, nil
-	case matchStrategy == fieldMatchSuffix && condDataType == dataTypeStr && inputDataType == dataTypeStr:
-		// Match: Suffix, Condition Type: Str, Input Type: Str
-		c := &ruleStrCondSuffixMatchStrInput{
-			config: &config{
-				field:         fieldName,
-				matchStrategy: fieldMatchSuffix,
-				values:        values,
-				regexEnabled:  false,
-				alwaysTrue:    false,
-				exprDataType:  condDataType,
-				inputDataType: inputDataType,
-				conditionType: `ruleStrCondSuffixMatchStrInput`,
-			},
-			field: &field{
-				name:   fieldName,
-				length: len(fieldName),
-			},
-		}
-		c.expr = &expr{
-			value: values[0],
-		}
-		return c, nil
-	case matchStrategy == fieldMatchRegex && condDataType == dataTypeStr && inputDataType == dataTypeStr:
-		// Match: Regex, Condition Type: Str, Input Type: Str
-		c := &ruleStrCondRegexMatchStrInput{
-			config: &config{
-				field:         fieldName,
-				matchStrategy: fieldMatchRegex,
-				values:        values,
-				regexEnabled:  true,
-				alwaysTrue:    false,
-				exprDataType:  condDataType,
-				inputDataType: inputDataType,
-				conditionType: `ruleStrCondRegexMatchStrInput`,
-			},
-			field: &field{
-				name:   fieldName,
-				length: len(fieldName),
-			},
-		}
-		re, err := regexp.Compile(values[0])
-		if err != nil {
-			return nil, err
+	case fieldMatchAlways:
+		return &alwaysCondition{ruleCondition{cfg}}, nil
+	}
+	return nil, fmt.Errorf("invalid condition syntax: %s", condInput)
+}
+
+// getConditionTypeName returns the name describing the condition, e.g.
+// ruleStrCondExactMatchListStrInput is the exact match of a list of strings
+// input against a single value.
+func getConditionTypeName(matchStrategy fieldMatchStrategy, condDataType, inputDataType dataType) string {
+	return "rule" + strings.TrimPrefix(getDataTypeName(condDataType), "dataType") +
+		"Cond" + strings.TrimPrefix(getMatchStrategyName(matchStrategy), "fieldMatch") +
+		"Match" + strings.TrimPrefix(getDataTypeName(inputDataType), "dataType") + "Input"
+}
+
+// getFieldNameDataType returns the canonical name and the data type of
+// an input data field. The names of the request header fields are
+// converted to canonical MIME header keys, e.g. header.x-tenant becomes
+// header.X-Tenant.
+func getFieldNameDataType(s string) (string, dataType) {
+	if v, exists := inputDataAliases[s]; exists {
+		s = v
+	}
+	if tp, exists := inputDataTypes[s]; exists {
+		return s, tp
+	}
+	if strings.HasPrefix(s, claimDataPrefix) && len(s) > len(claimDataPrefix) {
+		return s, dataTypeListStr
+	}
+	for prefix, tp := range requestDataPrefixes {
+		if !strings.HasPrefix(s, prefix) || len(s) == len(prefix) {
+			continue
 		}
-		c.expr = re
-		return c, nil
-	case matchStrategy == fieldMatchAlways && condDataType == dataTypeStr && inputDataType == dataTypeStr:
-		// Match: Always, Condition Type: Str, Input Type: Str
-		c := &ruleStrCondAlwaysMatchStrInput{
-			config: &config{
-				field:         fieldName,
-				matchStrategy: fieldMatchAlways,
-				values:        values,
-				regexEnabled:  false,
-				alwaysTrue:    true,
-				exprDataType:  condDataType,
-				inputDataType: inputDataType,
-				conditionType: `ruleStrCondAlwaysMatchStrInput`,
-			},
-			field: &field{
-				name:   fieldName,
-				length: len(fieldName),
-			},
+		if prefix == "header." {
+			return prefix + textproto.CanonicalMIMEHeaderKey(strings.TrimPrefix(s, prefix)), tp
 		}
-		c.expr = &expr{
-			value: values[0],
+		return s, tp
+	}
+	return s, dataTypeUnknown
+}
+
+// IsRequestField returns true when the field is derived from HTTP requests,
+// e.g. host, scheme, header.X-Tenant, or query.tenant.
+func IsRequestField(s string) bool {
+	if _, exists := requestDataFields[s]; exists {
+		return true
+	}
+	for prefix := range requestDataPrefixes {
+		if strings.HasPrefix(s, prefix) && len(s) > len(prefix) {
+			return true
 		}
-		return c, nil
+	}
+	return false
+}
+
+// IsClaimField returns true when the field is resolved via a dotted path
+// into the claims of a token, e.g. claim.metadata.department.
+func IsClaimField(s string) bool {
+	return strings.HasPrefix(s, claimDataPrefix) && len(s) > len(claimDataPrefix)
+}
 
+// validateClaimPath returns an error when a dotted claim path could never
+// resolve to a value, e.g. it has empty segments or it descends into
+// a standard claim that is not an object.
+func validateClaimPath(s string) error {
+	if s == "" {
+		return fmt.Errorf("empty path")
 	}
-	return nil, fmt.Errorf("invalid condition syntax: %s", condInput)
+	keys := strings.Split(s, ".")
+	for _, k := range keys {
+		if k == "" {
+			return fmt.Errorf("empty path segment")
+		}
+	}
+	if len(keys) > 1 && nonObjectClaims[keys[0]] {
+		return fmt.Errorf("claim %q has no nested claims", keys[0])
+	}
+	return nil
 }
 
 func getMatchStrategyName(s fieldMatchStrategy) string {
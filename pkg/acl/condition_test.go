@@ -18,7 +18,6 @@ import (
 	"context"
 	"fmt"
 	"github.com/greenpau/caddy-authorize/internal/tests"
-	"reflect"
 	"strings"
 	"testing"
 )
@@ -34,7 +33,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		{name: "exact match a list of strings input against a list of strings in groups field",
 			condition: `exact match groups barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondExactMatchListStrInput",
+				"condition_type":          "ruleListStrCondExactMatchListStrInput",
 				"field_name":              "roles",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -50,7 +49,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		{name: "exact match a list of strings input against a list of strings in roles field",
 			condition: `exact match roles barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondExactMatchListStrInput",
+				"condition_type":          "ruleListStrCondExactMatchListStrInput",
 				"field_name":              "roles",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -65,7 +64,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "default match a list of strings input against a list of strings in roles field",
 			condition: ` match roles barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondExactMatchListStrInput",
+				"condition_type":          "ruleListStrCondExactMatchListStrInput",
 				"field_name":              "roles",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -80,7 +79,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "exact match a list of strings input against a string condition in roles field",
 			condition: `exact match roles foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondExactMatchListStrInput",
+				"condition_type":          "ruleStrCondExactMatchListStrInput",
 				"field_name":              "roles",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -95,7 +94,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "default match a list of strings input against a string condition in roles field",
 			condition: ` match roles foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondExactMatchListStrInput",
+				"condition_type":          "ruleStrCondExactMatchListStrInput",
 				"field_name":              "roles",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -110,7 +109,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "partial match a list of strings input against a list of strings in roles field",
 			condition: `partial match roles barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondPartialMatchListStrInput",
+				"condition_type":          "ruleListStrCondPartialMatchListStrInput",
 				"field_name":              "roles",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPartial",
@@ -125,7 +124,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "partial match a list of strings input against a string condition in roles field",
 			condition: `partial match roles foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondPartialMatchListStrInput",
+				"condition_type":          "ruleStrCondPartialMatchListStrInput",
 				"field_name":              "roles",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPartial",
@@ -140,7 +139,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "prefix match a list of strings input against a list of strings in roles field",
 			condition: `prefix match roles barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondPrefixMatchListStrInput",
+				"condition_type":          "ruleListStrCondPrefixMatchListStrInput",
 				"field_name":              "roles",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPrefix",
@@ -155,7 +154,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "prefix match a list of strings input against a string condition in roles field",
 			condition: `prefix match roles foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondPrefixMatchListStrInput",
+				"condition_type":          "ruleStrCondPrefixMatchListStrInput",
 				"field_name":              "roles",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPrefix",
@@ -170,7 +169,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "suffix match a list of strings input against a list of strings in roles field",
 			condition: `suffix match roles barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondSuffixMatchListStrInput",
+				"condition_type":          "ruleListStrCondSuffixMatchListStrInput",
 				"field_name":              "roles",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchSuffix",
@@ -185,7 +184,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "suffix match a list of strings input against a string condition in roles field",
 			condition: `suffix match roles foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondSuffixMatchListStrInput",
+				"condition_type":          "ruleStrCondSuffixMatchListStrInput",
 				"field_name":              "roles",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchSuffix",
@@ -200,7 +199,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "regex match a list of strings input against a list of strings in roles field",
 			condition: `regex match roles barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondRegexMatchListStrInput",
+				"condition_type":          "ruleListStrCondRegexMatchListStrInput",
 				"field_name":              "roles",
 				"regex_enabled":           true,
 				"match_strategy":          "fieldMatchRegex",
@@ -220,7 +219,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "regex match a list of strings input against a string condition in roles field",
 			condition: `regex match roles foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondRegexMatchListStrInput",
+				"condition_type":          "ruleStrCondRegexMatchListStrInput",
 				"field_name":              "roles",
 				"regex_enabled":           true,
 				"match_strategy":          "fieldMatchRegex",
@@ -240,7 +239,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "always match a list of strings input against a list of strings in roles field",
 			condition: `always match roles barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondAlwaysMatchListStrInput",
+				"condition_type":          "ruleListStrCondAlwaysMatchListStrInput",
 				"field_name":              "roles",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchAlways",
@@ -255,7 +254,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "always match a list of strings input against a string condition in roles field",
 			condition: `always match roles foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondAlwaysMatchListStrInput",
+				"condition_type":          "ruleStrCondAlwaysMatchListStrInput",
 				"field_name":              "roles",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchAlways",
@@ -270,7 +269,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "exact match an input string against a list of strings in email field",
 			condition: `exact match email barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondExactMatchStrInput",
+				"condition_type":          "ruleListStrCondExactMatchStrInput",
 				"field_name":              "email",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -285,7 +284,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "default match an input string against a list of strings in email field",
 			condition: ` match email barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondExactMatchStrInput",
+				"condition_type":          "ruleListStrCondExactMatchStrInput",
 				"field_name":              "email",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -300,7 +299,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "exact match an input string against a string condition in email field",
 			condition: `exact match email foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondExactMatchStrInput",
+				"condition_type":          "ruleStrCondExactMatchStrInput",
 				"field_name":              "email",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -315,7 +314,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "default match an input string against a string condition in email field",
 			condition: ` match email foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondExactMatchStrInput",
+				"condition_type":          "ruleStrCondExactMatchStrInput",
 				"field_name":              "email",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -330,7 +329,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "partial match an input string against a list of strings in email field",
 			condition: `partial match email barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondPartialMatchStrInput",
+				"condition_type":          "ruleListStrCondPartialMatchStrInput",
 				"field_name":              "email",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPartial",
@@ -345,7 +344,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "partial match an input string against a string condition in email field",
 			condition: `partial match email foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondPartialMatchStrInput",
+				"condition_type":          "ruleStrCondPartialMatchStrInput",
 				"field_name":              "email",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPartial",
@@ -360,7 +359,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "prefix match an input string against a list of strings in email field",
 			condition: `prefix match email barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondPrefixMatchStrInput",
+				"condition_type":          "ruleListStrCondPrefixMatchStrInput",
 				"field_name":              "email",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPrefix",
@@ -375,7 +374,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "prefix match an input string against a string condition in email field",
 			condition: `prefix match email foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondPrefixMatchStrInput",
+				"condition_type":          "ruleStrCondPrefixMatchStrInput",
 				"field_name":              "email",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPrefix",
@@ -390,7 +389,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "suffix match an input string against a list of strings in email field",
 			condition: `suffix match email barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondSuffixMatchStrInput",
+				"condition_type":          "ruleListStrCondSuffixMatchStrInput",
 				"field_name":              "email",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchSuffix",
@@ -405,7 +404,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "suffix match an input string against a string condition in email field",
 			condition: `suffix match email foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondSuffixMatchStrInput",
+				"condition_type":          "ruleStrCondSuffixMatchStrInput",
 				"field_name":              "email",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchSuffix",
@@ -420,7 +419,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "regex match an input string against a list of strings in email field",
 			condition: `regex match email barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondRegexMatchStrInput",
+				"condition_type":          "ruleListStrCondRegexMatchStrInput",
 				"field_name":              "email",
 				"regex_enabled":           true,
 				"match_strategy":          "fieldMatchRegex",
@@ -440,7 +439,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "regex match an input string against a string condition in email field",
 			condition: `regex match email foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondRegexMatchStrInput",
+				"condition_type":          "ruleStrCondRegexMatchStrInput",
 				"field_name":              "email",
 				"regex_enabled":           true,
 				"match_strategy":          "fieldMatchRegex",
@@ -460,7 +459,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "always match an input string against a list of strings in email field",
 			condition: `always match email barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondAlwaysMatchStrInput",
+				"condition_type":          "ruleListStrCondAlwaysMatchStrInput",
 				"field_name":              "email",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchAlways",
@@ -475,7 +474,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "always match an input string against a string condition in email field",
 			condition: `always match email foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondAlwaysMatchStrInput",
+				"condition_type":          "ruleStrCondAlwaysMatchStrInput",
 				"field_name":              "email",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchAlways",
@@ -490,7 +489,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "exact match an input string against a list of strings in origin field",
 			condition: `exact match origin barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondExactMatchStrInput",
+				"condition_type":          "ruleListStrCondExactMatchStrInput",
 				"field_name":              "origin",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -505,7 +504,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "default match an input string against a list of strings in origin field",
 			condition: ` match origin barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondExactMatchStrInput",
+				"condition_type":          "ruleListStrCondExactMatchStrInput",
 				"field_name":              "origin",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -520,7 +519,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "exact match an input string against a string condition in origin field",
 			condition: `exact match origin foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondExactMatchStrInput",
+				"condition_type":          "ruleStrCondExactMatchStrInput",
 				"field_name":              "origin",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -535,7 +534,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "default match an input string against a string condition in origin field",
 			condition: ` match origin foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondExactMatchStrInput",
+				"condition_type":          "ruleStrCondExactMatchStrInput",
 				"field_name":              "origin",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -550,7 +549,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "partial match an input string against a list of strings in origin field",
 			condition: `partial match origin barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondPartialMatchStrInput",
+				"condition_type":          "ruleListStrCondPartialMatchStrInput",
 				"field_name":              "origin",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPartial",
@@ -565,7 +564,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "partial match an input string against a string condition in origin field",
 			condition: `partial match origin foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondPartialMatchStrInput",
+				"condition_type":          "ruleStrCondPartialMatchStrInput",
 				"field_name":              "origin",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPartial",
@@ -580,7 +579,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "prefix match an input string against a list of strings in origin field",
 			condition: `prefix match origin barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondPrefixMatchStrInput",
+				"condition_type":          "ruleListStrCondPrefixMatchStrInput",
 				"field_name":              "origin",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPrefix",
@@ -595,7 +594,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "prefix match an input string against a string condition in origin field",
 			condition: `prefix match origin foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondPrefixMatchStrInput",
+				"condition_type":          "ruleStrCondPrefixMatchStrInput",
 				"field_name":              "origin",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPrefix",
@@ -610,7 +609,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "suffix match an input string against a list of strings in origin field",
 			condition: `suffix match origin barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondSuffixMatchStrInput",
+				"condition_type":          "ruleListStrCondSuffixMatchStrInput",
 				"field_name":              "origin",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchSuffix",
@@ -625,7 +624,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "suffix match an input string against a string condition in origin field",
 			condition: `suffix match origin foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondSuffixMatchStrInput",
+				"condition_type":          "ruleStrCondSuffixMatchStrInput",
 				"field_name":              "origin",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchSuffix",
@@ -640,7 +639,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "regex match an input string against a list of strings in origin field",
 			condition: `regex match origin barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondRegexMatchStrInput",
+				"condition_type":          "ruleListStrCondRegexMatchStrInput",
 				"field_name":              "origin",
 				"regex_enabled":           true,
 				"match_strategy":          "fieldMatchRegex",
@@ -660,7 +659,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "regex match an input string against a string condition in origin field",
 			condition: `regex match origin foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondRegexMatchStrInput",
+				"condition_type":          "ruleStrCondRegexMatchStrInput",
 				"field_name":              "origin",
 				"regex_enabled":           true,
 				"match_strategy":          "fieldMatchRegex",
@@ -680,7 +679,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "always match an input string against a list of strings in origin field",
 			condition: `always match origin barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondAlwaysMatchStrInput",
+				"condition_type":          "ruleListStrCondAlwaysMatchStrInput",
 				"field_name":              "origin",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchAlways",
@@ -695,7 +694,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "always match an input string against a string condition in origin field",
 			condition: `always match origin foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondAlwaysMatchStrInput",
+				"condition_type":          "ruleStrCondAlwaysMatchStrInput",
 				"field_name":              "origin",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchAlways",
@@ -710,7 +709,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "exact match an input string against a list of strings in name field",
 			condition: `exact match name barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondExactMatchStrInput",
+				"condition_type":          "ruleListStrCondExactMatchStrInput",
 				"field_name":              "name",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -725,7 +724,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "default match an input string against a list of strings in name field",
 			condition: ` match name barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondExactMatchStrInput",
+				"condition_type":          "ruleListStrCondExactMatchStrInput",
 				"field_name":              "name",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -740,7 +739,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "exact match an input string against a string condition in name field",
 			condition: `exact match name foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondExactMatchStrInput",
+				"condition_type":          "ruleStrCondExactMatchStrInput",
 				"field_name":              "name",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -755,7 +754,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "default match an input string against a string condition in name field",
 			condition: ` match name foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondExactMatchStrInput",
+				"condition_type":          "ruleStrCondExactMatchStrInput",
 				"field_name":              "name",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -770,7 +769,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "partial match an input string against a list of strings in name field",
 			condition: `partial match name barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondPartialMatchStrInput",
+				"condition_type":          "ruleListStrCondPartialMatchStrInput",
 				"field_name":              "name",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPartial",
@@ -785,7 +784,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "partial match an input string against a string condition in name field",
 			condition: `partial match name foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondPartialMatchStrInput",
+				"condition_type":          "ruleStrCondPartialMatchStrInput",
 				"field_name":              "name",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPartial",
@@ -800,7 +799,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "prefix match an input string against a list of strings in name field",
 			condition: `prefix match name barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondPrefixMatchStrInput",
+				"condition_type":          "ruleListStrCondPrefixMatchStrInput",
 				"field_name":              "name",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPrefix",
@@ -815,7 +814,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "prefix match an input string against a string condition in name field",
 			condition: `prefix match name foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondPrefixMatchStrInput",
+				"condition_type":          "ruleStrCondPrefixMatchStrInput",
 				"field_name":              "name",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPrefix",
@@ -830,7 +829,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "suffix match an input string against a list of strings in name field",
 			condition: `suffix match name barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondSuffixMatchStrInput",
+				"condition_type":          "ruleListStrCondSuffixMatchStrInput",
 				"field_name":              "name",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchSuffix",
@@ -845,7 +844,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "suffix match an input string against a string condition in name field",
 			condition: `suffix match name foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondSuffixMatchStrInput",
+				"condition_type":          "ruleStrCondSuffixMatchStrInput",
 				"field_name":              "name",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchSuffix",
@@ -860,7 +859,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "regex match an input string against a list of strings in name field",
 			condition: `regex match name barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondRegexMatchStrInput",
+				"condition_type":          "ruleListStrCondRegexMatchStrInput",
 				"field_name":              "name",
 				"regex_enabled":           true,
 				"match_strategy":          "fieldMatchRegex",
@@ -880,7 +879,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "regex match an input string against a string condition in name field",
 			condition: `regex match name foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondRegexMatchStrInput",
+				"condition_type":          "ruleStrCondRegexMatchStrInput",
 				"field_name":              "name",
 				"regex_enabled":           true,
 				"match_strategy":          "fieldMatchRegex",
@@ -900,7 +899,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "always match an input string against a list of strings in name field",
 			condition: `always match name barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondAlwaysMatchStrInput",
+				"condition_type":          "ruleListStrCondAlwaysMatchStrInput",
 				"field_name":              "name",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchAlways",
@@ -915,7 +914,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "always match an input string against a string condition in name field",
 			condition: `always match name foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondAlwaysMatchStrInput",
+				"condition_type":          "ruleStrCondAlwaysMatchStrInput",
 				"field_name":              "name",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchAlways",
@@ -930,7 +929,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "exact match a list of strings input against a list of strings in aud field",
 			condition: `exact match aud barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondExactMatchListStrInput",
+				"condition_type":          "ruleListStrCondExactMatchListStrInput",
 				"field_name":              "aud",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -945,7 +944,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "default match a list of strings input against a list of strings in aud field",
 			condition: ` match aud barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondExactMatchListStrInput",
+				"condition_type":          "ruleListStrCondExactMatchListStrInput",
 				"field_name":              "aud",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -960,7 +959,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "exact match a list of strings input against a string condition in aud field",
 			condition: `exact match aud foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondExactMatchListStrInput",
+				"condition_type":          "ruleStrCondExactMatchListStrInput",
 				"field_name":              "aud",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -975,7 +974,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "default match a list of strings input against a string condition in aud field",
 			condition: ` match aud foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondExactMatchListStrInput",
+				"condition_type":          "ruleStrCondExactMatchListStrInput",
 				"field_name":              "aud",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -990,7 +989,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "partial match a list of strings input against a list of strings in aud field",
 			condition: `partial match aud barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondPartialMatchListStrInput",
+				"condition_type":          "ruleListStrCondPartialMatchListStrInput",
 				"field_name":              "aud",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPartial",
@@ -1005,7 +1004,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "partial match a list of strings input against a string condition in aud field",
 			condition: `partial match aud foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondPartialMatchListStrInput",
+				"condition_type":          "ruleStrCondPartialMatchListStrInput",
 				"field_name":              "aud",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPartial",
@@ -1020,7 +1019,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "prefix match a list of strings input against a list of strings in aud field",
 			condition: `prefix match aud barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondPrefixMatchListStrInput",
+				"condition_type":          "ruleListStrCondPrefixMatchListStrInput",
 				"field_name":              "aud",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPrefix",
@@ -1035,7 +1034,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "prefix match a list of strings input against a string condition in aud field",
 			condition: `prefix match aud foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondPrefixMatchListStrInput",
+				"condition_type":          "ruleStrCondPrefixMatchListStrInput",
 				"field_name":              "aud",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPrefix",
@@ -1050,7 +1049,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "suffix match a list of strings input against a list of strings in aud field",
 			condition: `suffix match aud barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondSuffixMatchListStrInput",
+				"condition_type":          "ruleListStrCondSuffixMatchListStrInput",
 				"field_name":              "aud",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchSuffix",
@@ -1065,7 +1064,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "suffix match a list of strings input against a string condition in aud field",
 			condition: `suffix match aud foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondSuffixMatchListStrInput",
+				"condition_type":          "ruleStrCondSuffixMatchListStrInput",
 				"field_name":              "aud",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchSuffix",
@@ -1080,7 +1079,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "regex match a list of strings input against a list of strings in aud field",
 			condition: `regex match aud barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondRegexMatchListStrInput",
+				"condition_type":          "ruleListStrCondRegexMatchListStrInput",
 				"field_name":              "aud",
 				"regex_enabled":           true,
 				"match_strategy":          "fieldMatchRegex",
@@ -1100,7 +1099,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "regex match a list of strings input against a string condition in aud field",
 			condition: `regex match aud foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondRegexMatchListStrInput",
+				"condition_type":          "ruleStrCondRegexMatchListStrInput",
 				"field_name":              "aud",
 				"regex_enabled":           true,
 				"match_strategy":          "fieldMatchRegex",
@@ -1120,7 +1119,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "always match a list of strings input against a list of strings in aud field",
 			condition: `always match aud barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondAlwaysMatchListStrInput",
+				"condition_type":          "ruleListStrCondAlwaysMatchListStrInput",
 				"field_name":              "aud",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchAlways",
@@ -1135,7 +1134,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "always match a list of strings input against a string condition in aud field",
 			condition: `always match aud foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondAlwaysMatchListStrInput",
+				"condition_type":          "ruleStrCondAlwaysMatchListStrInput",
 				"field_name":              "aud",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchAlways",
@@ -1150,7 +1149,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "exact match a list of strings input against a list of strings in scopes field",
 			condition: `exact match scopes barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondExactMatchListStrInput",
+				"condition_type":          "ruleListStrCondExactMatchListStrInput",
 				"field_name":              "scopes",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -1165,7 +1164,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "default match a list of strings input against a list of strings in scopes field",
 			condition: ` match scopes barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondExactMatchListStrInput",
+				"condition_type":          "ruleListStrCondExactMatchListStrInput",
 				"field_name":              "scopes",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -1180,7 +1179,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "exact match a list of strings input against a string condition in scopes field",
 			condition: `exact match scopes foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondExactMatchListStrInput",
+				"condition_type":          "ruleStrCondExactMatchListStrInput",
 				"field_name":              "scopes",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -1195,7 +1194,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "default match a list of strings input against a string condition in scopes field",
 			condition: ` match scopes foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondExactMatchListStrInput",
+				"condition_type":          "ruleStrCondExactMatchListStrInput",
 				"field_name":              "scopes",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -1210,7 +1209,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "partial match a list of strings input against a list of strings in scopes field",
 			condition: `partial match scopes barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondPartialMatchListStrInput",
+				"condition_type":          "ruleListStrCondPartialMatchListStrInput",
 				"field_name":              "scopes",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPartial",
@@ -1225,7 +1224,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "partial match a list of strings input against a string condition in scopes field",
 			condition: `partial match scopes foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondPartialMatchListStrInput",
+				"condition_type":          "ruleStrCondPartialMatchListStrInput",
 				"field_name":              "scopes",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPartial",
@@ -1240,7 +1239,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "prefix match a list of strings input against a list of strings in scopes field",
 			condition: `prefix match scopes barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondPrefixMatchListStrInput",
+				"condition_type":          "ruleListStrCondPrefixMatchListStrInput",
 				"field_name":              "scopes",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPrefix",
@@ -1255,7 +1254,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "prefix match a list of strings input against a string condition in scopes field",
 			condition: `prefix match scopes foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondPrefixMatchListStrInput",
+				"condition_type":          "ruleStrCondPrefixMatchListStrInput",
 				"field_name":              "scopes",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPrefix",
@@ -1270,7 +1269,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "suffix match a list of strings input against a list of strings in scopes field",
 			condition: `suffix match scopes barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondSuffixMatchListStrInput",
+				"condition_type":          "ruleListStrCondSuffixMatchListStrInput",
 				"field_name":              "scopes",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchSuffix",
@@ -1285,7 +1284,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "suffix match a list of strings input against a string condition in scopes field",
 			condition: `suffix match scopes foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondSuffixMatchListStrInput",
+				"condition_type":          "ruleStrCondSuffixMatchListStrInput",
 				"field_name":              "scopes",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchSuffix",
@@ -1300,7 +1299,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "regex match a list of strings input against a list of strings in scopes field",
 			condition: `regex match scopes barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondRegexMatchListStrInput",
+				"condition_type":          "ruleListStrCondRegexMatchListStrInput",
 				"field_name":              "scopes",
 				"regex_enabled":           true,
 				"match_strategy":          "fieldMatchRegex",
@@ -1320,7 +1319,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "regex match a list of strings input against a string condition in scopes field",
 			condition: `regex match scopes foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondRegexMatchListStrInput",
+				"condition_type":          "ruleStrCondRegexMatchListStrInput",
 				"field_name":              "scopes",
 				"regex_enabled":           true,
 				"match_strategy":          "fieldMatchRegex",
@@ -1340,7 +1339,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "always match a list of strings input against a list of strings in scopes field",
 			condition: `always match scopes barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondAlwaysMatchListStrInput",
+				"condition_type":          "ruleListStrCondAlwaysMatchListStrInput",
 				"field_name":              "scopes",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchAlways",
@@ -1355,7 +1354,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "always match a list of strings input against a string condition in scopes field",
 			condition: `always match scopes foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondAlwaysMatchListStrInput",
+				"condition_type":          "ruleStrCondAlwaysMatchListStrInput",
 				"field_name":              "scopes",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchAlways",
@@ -1370,7 +1369,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "exact match a list of strings input against a list of strings in org field",
 			condition: `exact match org barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondExactMatchListStrInput",
+				"condition_type":          "ruleListStrCondExactMatchListStrInput",
 				"field_name":              "org",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -1385,7 +1384,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "default match a list of strings input against a list of strings in org field",
 			condition: ` match org barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondExactMatchListStrInput",
+				"condition_type":          "ruleListStrCondExactMatchListStrInput",
 				"field_name":              "org",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -1400,7 +1399,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "exact match a list of strings input against a string condition in org field",
 			condition: `exact match org foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondExactMatchListStrInput",
+				"condition_type":          "ruleStrCondExactMatchListStrInput",
 				"field_name":              "org",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -1415,7 +1414,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "default match a list of strings input against a string condition in org field",
 			condition: ` match org foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondExactMatchListStrInput",
+				"condition_type":          "ruleStrCondExactMatchListStrInput",
 				"field_name":              "org",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -1430,7 +1429,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "partial match a list of strings input against a list of strings in org field",
 			condition: `partial match org barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondPartialMatchListStrInput",
+				"condition_type":          "ruleListStrCondPartialMatchListStrInput",
 				"field_name":              "org",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPartial",
@@ -1445,7 +1444,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "partial match a list of strings input against a string condition in org field",
 			condition: `partial match org foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondPartialMatchListStrInput",
+				"condition_type":          "ruleStrCondPartialMatchListStrInput",
 				"field_name":              "org",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPartial",
@@ -1460,7 +1459,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "prefix match a list of strings input against a list of strings in org field",
 			condition: `prefix match org barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondPrefixMatchListStrInput",
+				"condition_type":          "ruleListStrCondPrefixMatchListStrInput",
 				"field_name":              "org",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPrefix",
@@ -1475,7 +1474,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "prefix match a list of strings input against a string condition in org field",
 			condition: `prefix match org foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondPrefixMatchListStrInput",
+				"condition_type":          "ruleStrCondPrefixMatchListStrInput",
 				"field_name":              "org",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPrefix",
@@ -1490,7 +1489,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "suffix match a list of strings input against a list of strings in org field",
 			condition: `suffix match org barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondSuffixMatchListStrInput",
+				"condition_type":          "ruleListStrCondSuffixMatchListStrInput",
 				"field_name":              "org",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchSuffix",
@@ -1505,7 +1504,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "suffix match a list of strings input against a string condition in org field",
 			condition: `suffix match org foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondSuffixMatchListStrInput",
+				"condition_type":          "ruleStrCondSuffixMatchListStrInput",
 				"field_name":              "org",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchSuffix",
@@ -1520,7 +1519,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "regex match a list of strings input against a list of strings in org field",
 			condition: `regex match org barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondRegexMatchListStrInput",
+				"condition_type":          "ruleListStrCondRegexMatchListStrInput",
 				"field_name":              "org",
 				"regex_enabled":           true,
 				"match_strategy":          "fieldMatchRegex",
@@ -1540,7 +1539,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "regex match a list of strings input against a string condition in org field",
 			condition: `regex match org foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondRegexMatchListStrInput",
+				"condition_type":          "ruleStrCondRegexMatchListStrInput",
 				"field_name":              "org",
 				"regex_enabled":           true,
 				"match_strategy":          "fieldMatchRegex",
@@ -1560,7 +1559,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "always match a list of strings input against a list of strings in org field",
 			condition: `always match org barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondAlwaysMatchListStrInput",
+				"condition_type":          "ruleListStrCondAlwaysMatchListStrInput",
 				"field_name":              "org",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchAlways",
@@ -1575,7 +1574,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "always match a list of strings input against a string condition in org field",
 			condition: `always match org foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondAlwaysMatchListStrInput",
+				"condition_type":          "ruleStrCondAlwaysMatchListStrInput",
 				"field_name":              "org",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchAlways",
@@ -1590,7 +1589,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "exact match an input string against a list of strings in jti field",
 			condition: `exact match jti barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondExactMatchStrInput",
+				"condition_type":          "ruleListStrCondExactMatchStrInput",
 				"field_name":              "jti",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -1605,7 +1604,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "default match an input string against a list of strings in jti field",
 			condition: ` match jti barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondExactMatchStrInput",
+				"condition_type":          "ruleListStrCondExactMatchStrInput",
 				"field_name":              "jti",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -1620,7 +1619,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "exact match an input string against a string condition in jti field",
 			condition: `exact match jti foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondExactMatchStrInput",
+				"condition_type":          "ruleStrCondExactMatchStrInput",
 				"field_name":              "jti",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -1635,7 +1634,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "default match an input string against a string condition in jti field",
 			condition: ` match jti foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondExactMatchStrInput",
+				"condition_type":          "ruleStrCondExactMatchStrInput",
 				"field_name":              "jti",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -1650,7 +1649,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "partial match an input string against a list of strings in jti field",
 			condition: `partial match jti barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondPartialMatchStrInput",
+				"condition_type":          "ruleListStrCondPartialMatchStrInput",
 				"field_name":              "jti",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPartial",
@@ -1665,7 +1664,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "partial match an input string against a string condition in jti field",
 			condition: `partial match jti foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondPartialMatchStrInput",
+				"condition_type":          "ruleStrCondPartialMatchStrInput",
 				"field_name":              "jti",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPartial",
@@ -1680,7 +1679,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "prefix match an input string against a list of strings in jti field",
 			condition: `prefix match jti barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondPrefixMatchStrInput",
+				"condition_type":          "ruleListStrCondPrefixMatchStrInput",
 				"field_name":              "jti",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPrefix",
@@ -1695,7 +1694,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "prefix match an input string against a string condition in jti field",
 			condition: `prefix match jti foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondPrefixMatchStrInput",
+				"condition_type":          "ruleStrCondPrefixMatchStrInput",
 				"field_name":              "jti",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPrefix",
@@ -1710,7 +1709,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "suffix match an input string against a list of strings in jti field",
 			condition: `suffix match jti barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondSuffixMatchStrInput",
+				"condition_type":          "ruleListStrCondSuffixMatchStrInput",
 				"field_name":              "jti",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchSuffix",
@@ -1725,7 +1724,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "suffix match an input string against a string condition in jti field",
 			condition: `suffix match jti foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondSuffixMatchStrInput",
+				"condition_type":          "ruleStrCondSuffixMatchStrInput",
 				"field_name":              "jti",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchSuffix",
@@ -1740,7 +1739,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "regex match an input string against a list of strings in jti field",
 			condition: `regex match jti barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondRegexMatchStrInput",
+				"condition_type":          "ruleListStrCondRegexMatchStrInput",
 				"field_name":              "jti",
 				"regex_enabled":           true,
 				"match_strategy":          "fieldMatchRegex",
@@ -1760,7 +1759,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "regex match an input string against a string condition in jti field",
 			condition: `regex match jti foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondRegexMatchStrInput",
+				"condition_type":          "ruleStrCondRegexMatchStrInput",
 				"field_name":              "jti",
 				"regex_enabled":           true,
 				"match_strategy":          "fieldMatchRegex",
@@ -1780,7 +1779,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "always match an input string against a list of strings in jti field",
 			condition: `always match jti barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondAlwaysMatchStrInput",
+				"condition_type":          "ruleListStrCondAlwaysMatchStrInput",
 				"field_name":              "jti",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchAlways",
@@ -1795,7 +1794,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "always match an input string against a string condition in jti field",
 			condition: `always match jti foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondAlwaysMatchStrInput",
+				"condition_type":          "ruleStrCondAlwaysMatchStrInput",
 				"field_name":              "jti",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchAlways",
@@ -1810,7 +1809,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "exact match an input string against a list of strings in iss field",
 			condition: `exact match iss barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondExactMatchStrInput",
+				"condition_type":          "ruleListStrCondExactMatchStrInput",
 				"field_name":              "iss",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -1825,7 +1824,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "default match an input string against a list of strings in iss field",
 			condition: ` match iss barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondExactMatchStrInput",
+				"condition_type":          "ruleListStrCondExactMatchStrInput",
 				"field_name":              "iss",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -1840,7 +1839,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "exact match an input string against a string condition in iss field",
 			condition: `exact match iss foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondExactMatchStrInput",
+				"condition_type":          "ruleStrCondExactMatchStrInput",
 				"field_name":              "iss",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -1855,7 +1854,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "default match an input string against a string condition in iss field",
 			condition: ` match iss foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondExactMatchStrInput",
+				"condition_type":          "ruleStrCondExactMatchStrInput",
 				"field_name":              "iss",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -1870,7 +1869,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "partial match an input string against a list of strings in iss field",
 			condition: `partial match iss barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondPartialMatchStrInput",
+				"condition_type":          "ruleListStrCondPartialMatchStrInput",
 				"field_name":              "iss",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPartial",
@@ -1885,7 +1884,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "partial match an input string against a string condition in iss field",
 			condition: `partial match iss foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondPartialMatchStrInput",
+				"condition_type":          "ruleStrCondPartialMatchStrInput",
 				"field_name":              "iss",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPartial",
@@ -1900,7 +1899,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "prefix match an input string against a list of strings in iss field",
 			condition: `prefix match iss barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondPrefixMatchStrInput",
+				"condition_type":          "ruleListStrCondPrefixMatchStrInput",
 				"field_name":              "iss",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPrefix",
@@ -1915,7 +1914,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "prefix match an input string against a string condition in iss field",
 			condition: `prefix match iss foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondPrefixMatchStrInput",
+				"condition_type":          "ruleStrCondPrefixMatchStrInput",
 				"field_name":              "iss",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPrefix",
@@ -1930,7 +1929,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "suffix match an input string against a list of strings in iss field",
 			condition: `suffix match iss barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondSuffixMatchStrInput",
+				"condition_type":          "ruleListStrCondSuffixMatchStrInput",
 				"field_name":              "iss",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchSuffix",
@@ -1945,7 +1944,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "suffix match an input string against a string condition in iss field",
 			condition: `suffix match iss foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondSuffixMatchStrInput",
+				"condition_type":          "ruleStrCondSuffixMatchStrInput",
 				"field_name":              "iss",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchSuffix",
@@ -1960,7 +1959,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "regex match an input string against a list of strings in iss field",
 			condition: `regex match iss barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondRegexMatchStrInput",
+				"condition_type":          "ruleListStrCondRegexMatchStrInput",
 				"field_name":              "iss",
 				"regex_enabled":           true,
 				"match_strategy":          "fieldMatchRegex",
@@ -1980,7 +1979,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "regex match an input string against a string condition in iss field",
 			condition: `regex match iss foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondRegexMatchStrInput",
+				"condition_type":          "ruleStrCondRegexMatchStrInput",
 				"field_name":              "iss",
 				"regex_enabled":           true,
 				"match_strategy":          "fieldMatchRegex",
@@ -2000,7 +1999,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "always match an input string against a list of strings in iss field",
 			condition: `always match iss barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondAlwaysMatchStrInput",
+				"condition_type":          "ruleListStrCondAlwaysMatchStrInput",
 				"field_name":              "iss",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchAlways",
@@ -2015,7 +2014,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "always match an input string against a string condition in iss field",
 			condition: `always match iss foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondAlwaysMatchStrInput",
+				"condition_type":          "ruleStrCondAlwaysMatchStrInput",
 				"field_name":              "iss",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchAlways",
@@ -2030,7 +2029,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "exact match an input string against a list of strings in sub field",
 			condition: `exact match sub barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondExactMatchStrInput",
+				"condition_type":          "ruleListStrCondExactMatchStrInput",
 				"field_name":              "sub",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -2045,7 +2044,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "default match an input string against a list of strings in sub field",
 			condition: ` match sub barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondExactMatchStrInput",
+				"condition_type":          "ruleListStrCondExactMatchStrInput",
 				"field_name":              "sub",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -2060,7 +2059,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "exact match an input string against a string condition in sub field",
 			condition: `exact match sub foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondExactMatchStrInput",
+				"condition_type":          "ruleStrCondExactMatchStrInput",
 				"field_name":              "sub",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -2075,7 +2074,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "default match an input string against a string condition in sub field",
 			condition: ` match sub foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondExactMatchStrInput",
+				"condition_type":          "ruleStrCondExactMatchStrInput",
 				"field_name":              "sub",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -2090,7 +2089,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "partial match an input string against a list of strings in sub field",
 			condition: `partial match sub barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondPartialMatchStrInput",
+				"condition_type":          "ruleListStrCondPartialMatchStrInput",
 				"field_name":              "sub",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPartial",
@@ -2105,7 +2104,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "partial match an input string against a string condition in sub field",
 			condition: `partial match sub foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondPartialMatchStrInput",
+				"condition_type":          "ruleStrCondPartialMatchStrInput",
 				"field_name":              "sub",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPartial",
@@ -2120,7 +2119,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "prefix match an input string against a list of strings in sub field",
 			condition: `prefix match sub barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondPrefixMatchStrInput",
+				"condition_type":          "ruleListStrCondPrefixMatchStrInput",
 				"field_name":              "sub",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPrefix",
@@ -2135,7 +2134,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "prefix match an input string against a string condition in sub field",
 			condition: `prefix match sub foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondPrefixMatchStrInput",
+				"condition_type":          "ruleStrCondPrefixMatchStrInput",
 				"field_name":              "sub",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPrefix",
@@ -2150,7 +2149,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "suffix match an input string against a list of strings in sub field",
 			condition: `suffix match sub barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondSuffixMatchStrInput",
+				"condition_type":          "ruleListStrCondSuffixMatchStrInput",
 				"field_name":              "sub",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchSuffix",
@@ -2165,7 +2164,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "suffix match an input string against a string condition in sub field",
 			condition: `suffix match sub foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondSuffixMatchStrInput",
+				"condition_type":          "ruleStrCondSuffixMatchStrInput",
 				"field_name":              "sub",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchSuffix",
@@ -2180,7 +2179,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "regex match an input string against a list of strings in sub field",
 			condition: `regex match sub barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondRegexMatchStrInput",
+				"condition_type":          "ruleListStrCondRegexMatchStrInput",
 				"field_name":              "sub",
 				"regex_enabled":           true,
 				"match_strategy":          "fieldMatchRegex",
@@ -2200,7 +2199,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "regex match an input string against a string condition in sub field",
 			condition: `regex match sub foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondRegexMatchStrInput",
+				"condition_type":          "ruleStrCondRegexMatchStrInput",
 				"field_name":              "sub",
 				"regex_enabled":           true,
 				"match_strategy":          "fieldMatchRegex",
@@ -2220,7 +2219,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "always match an input string against a list of strings in sub field",
 			condition: `always match sub barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondAlwaysMatchStrInput",
+				"condition_type":          "ruleListStrCondAlwaysMatchStrInput",
 				"field_name":              "sub",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchAlways",
@@ -2235,7 +2234,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "always match an input string against a string condition in sub field",
 			condition: `always match sub foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondAlwaysMatchStrInput",
+				"condition_type":          "ruleStrCondAlwaysMatchStrInput",
 				"field_name":              "sub",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchAlways",
@@ -2250,7 +2249,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "exact match an input string against a list of strings in addr field",
 			condition: `exact match addr barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondExactMatchStrInput",
+				"condition_type":          "ruleListStrCondExactMatchStrInput",
 				"field_name":              "addr",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -2265,7 +2264,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "default match an input string against a list of strings in addr field",
 			condition: ` match addr barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondExactMatchStrInput",
+				"condition_type":          "ruleListStrCondExactMatchStrInput",
 				"field_name":              "addr",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -2280,7 +2279,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "exact match an input string against a string condition in addr field",
 			condition: `exact match addr foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondExactMatchStrInput",
+				"condition_type":          "ruleStrCondExactMatchStrInput",
 				"field_name":              "addr",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -2295,7 +2294,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "default match an input string against a string condition in addr field",
 			condition: ` match addr foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondExactMatchStrInput",
+				"condition_type":          "ruleStrCondExactMatchStrInput",
 				"field_name":              "addr",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -2310,7 +2309,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "partial match an input string against a list of strings in addr field",
 			condition: `partial match addr barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondPartialMatchStrInput",
+				"condition_type":          "ruleListStrCondPartialMatchStrInput",
 				"field_name":              "addr",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPartial",
@@ -2325,7 +2324,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "partial match an input string against a string condition in addr field",
 			condition: `partial match addr foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondPartialMatchStrInput",
+				"condition_type":          "ruleStrCondPartialMatchStrInput",
 				"field_name":              "addr",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPartial",
@@ -2340,7 +2339,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "prefix match an input string against a list of strings in addr field",
 			condition: `prefix match addr barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondPrefixMatchStrInput",
+				"condition_type":          "ruleListStrCondPrefixMatchStrInput",
 				"field_name":              "addr",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPrefix",
@@ -2355,7 +2354,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "prefix match an input string against a string condition in addr field",
 			condition: `prefix match addr foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondPrefixMatchStrInput",
+				"condition_type":          "ruleStrCondPrefixMatchStrInput",
 				"field_name":              "addr",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPrefix",
@@ -2370,7 +2369,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "suffix match an input string against a list of strings in addr field",
 			condition: `suffix match addr barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondSuffixMatchStrInput",
+				"condition_type":          "ruleListStrCondSuffixMatchStrInput",
 				"field_name":              "addr",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchSuffix",
@@ -2385,7 +2384,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "suffix match an input string against a string condition in addr field",
 			condition: `suffix match addr foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondSuffixMatchStrInput",
+				"condition_type":          "ruleStrCondSuffixMatchStrInput",
 				"field_name":              "addr",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchSuffix",
@@ -2400,7 +2399,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "regex match an input string against a list of strings in addr field",
 			condition: `regex match addr barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondRegexMatchStrInput",
+				"condition_type":          "ruleListStrCondRegexMatchStrInput",
 				"field_name":              "addr",
 				"regex_enabled":           true,
 				"match_strategy":          "fieldMatchRegex",
@@ -2420,7 +2419,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "regex match an input string against a string condition in addr field",
 			condition: `regex match addr foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondRegexMatchStrInput",
+				"condition_type":          "ruleStrCondRegexMatchStrInput",
 				"field_name":              "addr",
 				"regex_enabled":           true,
 				"match_strategy":          "fieldMatchRegex",
@@ -2440,7 +2439,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "always match an input string against a list of strings in addr field",
 			condition: `always match addr barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondAlwaysMatchStrInput",
+				"condition_type":          "ruleListStrCondAlwaysMatchStrInput",
 				"field_name":              "addr",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchAlways",
@@ -2455,7 +2454,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "always match an input string against a string condition in addr field",
 			condition: `always match addr foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondAlwaysMatchStrInput",
+				"condition_type":          "ruleStrCondAlwaysMatchStrInput",
 				"field_name":              "addr",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchAlways",
@@ -2470,7 +2469,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "exact match an input string against a list of strings in method field",
 			condition: `exact match method barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondExactMatchStrInput",
+				"condition_type":          "ruleListStrCondExactMatchStrInput",
 				"field_name":              "method",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -2485,7 +2484,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "default match an input string against a list of strings in method field",
 			condition: ` match method barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondExactMatchStrInput",
+				"condition_type":          "ruleListStrCondExactMatchStrInput",
 				"field_name":              "method",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -2500,7 +2499,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "exact match an input string against a string condition in method field",
 			condition: `exact match method foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondExactMatchStrInput",
+				"condition_type":          "ruleStrCondExactMatchStrInput",
 				"field_name":              "method",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -2515,7 +2514,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "default match an input string against a string condition in method field",
 			condition: ` match method foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondExactMatchStrInput",
+				"condition_type":          "ruleStrCondExactMatchStrInput",
 				"field_name":              "method",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -2530,7 +2529,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "partial match an input string against a list of strings in method field",
 			condition: `partial match method barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondPartialMatchStrInput",
+				"condition_type":          "ruleListStrCondPartialMatchStrInput",
 				"field_name":              "method",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPartial",
@@ -2545,7 +2544,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "partial match an input string against a string condition in method field",
 			condition: `partial match method foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondPartialMatchStrInput",
+				"condition_type":          "ruleStrCondPartialMatchStrInput",
 				"field_name":              "method",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPartial",
@@ -2560,7 +2559,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "prefix match an input string against a list of strings in method field",
 			condition: `prefix match method barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondPrefixMatchStrInput",
+				"condition_type":          "ruleListStrCondPrefixMatchStrInput",
 				"field_name":              "method",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPrefix",
@@ -2575,7 +2574,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "prefix match an input string against a string condition in method field",
 			condition: `prefix match method foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondPrefixMatchStrInput",
+				"condition_type":          "ruleStrCondPrefixMatchStrInput",
 				"field_name":              "method",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPrefix",
@@ -2590,7 +2589,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "suffix match an input string against a list of strings in method field",
 			condition: `suffix match method barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondSuffixMatchStrInput",
+				"condition_type":          "ruleListStrCondSuffixMatchStrInput",
 				"field_name":              "method",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchSuffix",
@@ -2605,7 +2604,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "suffix match an input string against a string condition in method field",
 			condition: `suffix match method foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondSuffixMatchStrInput",
+				"condition_type":          "ruleStrCondSuffixMatchStrInput",
 				"field_name":              "method",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchSuffix",
@@ -2620,7 +2619,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "regex match an input string against a list of strings in method field",
 			condition: `regex match method barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondRegexMatchStrInput",
+				"condition_type":          "ruleListStrCondRegexMatchStrInput",
 				"field_name":              "method",
 				"regex_enabled":           true,
 				"match_strategy":          "fieldMatchRegex",
@@ -2640,7 +2639,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "regex match an input string against a string condition in method field",
 			condition: `regex match method foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondRegexMatchStrInput",
+				"condition_type":          "ruleStrCondRegexMatchStrInput",
 				"field_name":              "method",
 				"regex_enabled":           true,
 				"match_strategy":          "fieldMatchRegex",
@@ -2660,7 +2659,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "always match an input string against a list of strings in method field",
 			condition: `always match method barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondAlwaysMatchStrInput",
+				"condition_type":          "ruleListStrCondAlwaysMatchStrInput",
 				"field_name":              "method",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchAlways",
@@ -2675,7 +2674,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "always match an input string against a string condition in method field",
 			condition: `always match method foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondAlwaysMatchStrInput",
+				"condition_type":          "ruleStrCondAlwaysMatchStrInput",
 				"field_name":              "method",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchAlways",
@@ -2690,7 +2689,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "exact match an input string against a list of strings in path field",
 			condition: `exact match path barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondExactMatchStrInput",
+				"condition_type":          "ruleListStrCondExactMatchStrInput",
 				"field_name":              "path",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -2705,7 +2704,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "default match an input string against a list of strings in path field",
 			condition: ` match path barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondExactMatchStrInput",
+				"condition_type":          "ruleListStrCondExactMatchStrInput",
 				"field_name":              "path",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -2720,7 +2719,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "exact match an input string against a string condition in path field",
 			condition: `exact match path foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondExactMatchStrInput",
+				"condition_type":          "ruleStrCondExactMatchStrInput",
 				"field_name":              "path",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -2735,7 +2734,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "default match an input string against a string condition in path field",
 			condition: ` match path foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondExactMatchStrInput",
+				"condition_type":          "ruleStrCondExactMatchStrInput",
 				"field_name":              "path",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchExact",
@@ -2750,7 +2749,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "partial match an input string against a list of strings in path field",
 			condition: `partial match path barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondPartialMatchStrInput",
+				"condition_type":          "ruleListStrCondPartialMatchStrInput",
 				"field_name":              "path",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPartial",
@@ -2765,7 +2764,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "partial match an input string against a string condition in path field",
 			condition: `partial match path foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondPartialMatchStrInput",
+				"condition_type":          "ruleStrCondPartialMatchStrInput",
 				"field_name":              "path",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPartial",
@@ -2780,7 +2779,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "prefix match an input string against a list of strings in path field",
 			condition: `prefix match path barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondPrefixMatchStrInput",
+				"condition_type":          "ruleListStrCondPrefixMatchStrInput",
 				"field_name":              "path",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPrefix",
@@ -2795,7 +2794,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "prefix match an input string against a string condition in path field",
 			condition: `prefix match path foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondPrefixMatchStrInput",
+				"condition_type":          "ruleStrCondPrefixMatchStrInput",
 				"field_name":              "path",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchPrefix",
@@ -2810,7 +2809,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "suffix match an input string against a list of strings in path field",
 			condition: `suffix match path barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondSuffixMatchStrInput",
+				"condition_type":          "ruleListStrCondSuffixMatchStrInput",
 				"field_name":              "path",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchSuffix",
@@ -2825,7 +2824,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "suffix match an input string against a string condition in path field",
 			condition: `suffix match path foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondSuffixMatchStrInput",
+				"condition_type":          "ruleStrCondSuffixMatchStrInput",
 				"field_name":              "path",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchSuffix",
@@ -2840,7 +2839,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "regex match an input string against a list of strings in path field",
 			condition: `regex match path barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondRegexMatchStrInput",
+				"condition_type":          "ruleListStrCondRegexMatchStrInput",
 				"field_name":              "path",
 				"regex_enabled":           true,
 				"match_strategy":          "fieldMatchRegex",
@@ -2860,7 +2859,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "regex match an input string against a string condition in path field",
 			condition: `regex match path foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondRegexMatchStrInput",
+				"condition_type":          "ruleStrCondRegexMatchStrInput",
 				"field_name":              "path",
 				"regex_enabled":           true,
 				"match_strategy":          "fieldMatchRegex",
@@ -2880,7 +2879,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "always match an input string against a list of strings in path field",
 			condition: `always match path barfoo foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleListStrCondAlwaysMatchStrInput",
+				"condition_type":          "ruleListStrCondAlwaysMatchStrInput",
 				"field_name":              "path",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchAlways",
@@ -2895,7 +2894,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 		}, {name: "always match an input string against a string condition in path field",
 			condition: `always match path foobar`,
 			want: map[string]interface{}{
-				"condition_type":          "*acl.ruleStrCondAlwaysMatchStrInput",
+				"condition_type":          "ruleStrCondAlwaysMatchStrInput",
 				"field_name":              "path",
 				"regex_enabled":           false,
 				"match_strategy":          "fieldMatchAlways",
@@ -2907,6 +2906,86 @@ func TestNewAclRuleCondition(t *testing.T) {
 				"input_data_type":         "dataTypeStr",
 				"values":                  []string{`foobar`},
 			},
+		}, {name: "exact match an input string against a string condition in host field",
+			condition: `exact match host app.contoso.com`,
+			want: map[string]interface{}{
+				"condition_type":          "ruleStrCondExactMatchStrInput",
+				"field_name":              "host",
+				"regex_enabled":           false,
+				"match_strategy":          "fieldMatchExact",
+				"always_true":             false,
+				"default_match_strategy":  "fieldMatchUnknown",
+				"reserved_match_strategy": "fieldMatchReserved",
+				"default_data_type":       "dataTypeUnknown",
+				"expr_data_type":          "dataTypeStr",
+				"input_data_type":         "dataTypeStr",
+				"values":                  []string{`app.contoso.com`},
+			},
+		}, {name: "exact match a list of strings input against a string condition in request header field",
+			condition: `exact match header.x-tenant contoso`,
+			want: map[string]interface{}{
+				"condition_type":          "ruleStrCondExactMatchListStrInput",
+				"field_name":              "header.X-Tenant",
+				"regex_enabled":           false,
+				"match_strategy":          "fieldMatchExact",
+				"always_true":             false,
+				"default_match_strategy":  "fieldMatchUnknown",
+				"reserved_match_strategy": "fieldMatchReserved",
+				"default_data_type":       "dataTypeUnknown",
+				"expr_data_type":          "dataTypeStr",
+				"input_data_type":         "dataTypeListStr",
+				"values":                  []string{`contoso`},
+			},
+		}, {name: "prefix match a list of strings input against a list of strings in query parameter field",
+			condition: `prefix match query.tenant foo bar`,
+			want: map[string]interface{}{
+				"condition_type":          "ruleListStrCondPrefixMatchListStrInput",
+				"field_name":              "query.tenant",
+				"regex_enabled":           false,
+				"match_strategy":          "fieldMatchPrefix",
+				"always_true":             false,
+				"default_match_strategy":  "fieldMatchUnknown",
+				"reserved_match_strategy": "fieldMatchReserved",
+				"default_data_type":       "dataTypeUnknown",
+				"expr_data_type":          "dataTypeListStr",
+				"input_data_type":         "dataTypeListStr",
+				"values":                  []string{`foo`, `bar`},
+			},
+		}, {name: "exact match a list of strings input against a string condition in claim path field",
+			condition: `exact match claim.metadata.department engineering`,
+			want: map[string]interface{}{
+				"condition_type":          "ruleStrCondExactMatchListStrInput",
+				"field_name":              "claim.metadata.department",
+				"regex_enabled":           false,
+				"match_strategy":          "fieldMatchExact",
+				"always_true":             false,
+				"default_match_strategy":  "fieldMatchUnknown",
+				"reserved_match_strategy": "fieldMatchReserved",
+				"default_data_type":       "dataTypeUnknown",
+				"expr_data_type":          "dataTypeStr",
+				"input_data_type":         "dataTypeListStr",
+				"values":                  []string{`engineering`},
+			},
+		}, {
+			name:      "invalid condition syntax empty claim path",
+			condition: `exact match claim. foo`,
+			shouldErr: true,
+			err:       fmt.Errorf("invalid condition syntax, unresolvable claim path: claim., empty path, condition: exact match claim. foo"),
+		}, {
+			name:      "invalid condition syntax claim path with empty segment",
+			condition: `exact match claim.metadata..department foo`,
+			shouldErr: true,
+			err:       fmt.Errorf("invalid condition syntax, unresolvable claim path: claim.metadata..department, empty path segment, condition: exact match claim.metadata..department foo"),
+		}, {
+			name:      "invalid condition syntax claim path under non-object claim",
+			condition: `exact match claim.email.domain contoso.com`,
+			shouldErr: true,
+			err:       fmt.Errorf("invalid condition syntax, unresolvable claim path: claim.email.domain, claim \"email\" has no nested claims, condition: exact match claim.email.domain contoso.com"),
+		}, {
+			name:      "invalid condition syntax empty request header name",
+			condition: `exact match header. foo`,
+			shouldErr: true,
+			err:       fmt.Errorf("invalid condition syntax, unsupported field: header., condition: exact match header. foo"),
 		}, {
 			name:      "invalid condition syntax match not found",
 			condition: `exact`,
@@ -2953,7 +3032,7 @@ func TestNewAclRuleCondition(t *testing.T) {
 			condConfig := cond.getConfig(context.Background())
 			got := make(map[string]interface{})
 			got["field_name"] = condConfig.field
-			got["condition_type"] = reflect.TypeOf(cond).String()
+			got["condition_type"] = condConfig.conditionType
 			got["match_strategy"] = getMatchStrategyName(condConfig.matchStrategy)
 			got["default_match_strategy"] = getMatchStrategyName(fieldMatchUnknown)
 			got["reserved_match_strategy"] = getMatchStrategyName(fieldMatchReserved)
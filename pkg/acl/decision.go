@@ -0,0 +1,64 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acl
+
+import (
+	"context"
+)
+
+// Decision is the outcome of the evaluation of AccessList along with the
+// tag of the rule deciding it. Unlike DecisionTrace, the recording of the
+// decision does not require the evaluation of the conditions of the rules
+// to be repeated.
+type Decision struct {
+	Allow   bool   `json:"allow" xml:"allow" yaml:"allow"`
+	RuleTag string `json:"rule_tag" xml:"rule_tag" yaml:"rule_tag"`
+}
+
+type decisionContextKey struct{}
+
+// NewDecisionContext returns a context carrying an empty decision. When the
+// recording of decisions is enabled in AccessList, the Allow function
+// records the decision in the context.
+func NewDecisionContext(ctx context.Context) (context.Context, *Decision) {
+	decision := &Decision{}
+	return context.WithValue(ctx, decisionContextKey{}, decision), decision
+}
+
+// EnableDecisions enables the recording of decisions in the contexts created
+// with NewDecisionContext. When the recording is disabled, the Allow
+// function does not look up the decision in the context.
+func (acl *AccessList) EnableDecisions() {
+	acl.decisionsEnabled = true
+}
+
+// recordDecision records the decision and the tag of the rule deciding it,
+// identified by its index, in the decision of the context, if any.
+func (acl *AccessList) recordDecision(ctx context.Context, allow bool, index int) {
+	decision, ok := ctx.Value(decisionContextKey{}).(*Decision)
+	if !ok {
+		return
+	}
+	var tag string
+	if index >= 0 {
+		tag = acl.rules[index].config.tag
+	}
+	decision.record(allow, tag)
+}
+
+func (decision *Decision) record(allow bool, tag string) {
+	decision.Allow = allow
+	decision.RuleTag = tag
+}
@@ -0,0 +1,81 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acl
+
+import (
+	"context"
+	"github.com/greenpau/caddy-authorize/internal/tests"
+	"testing"
+)
+
+func TestAllowWithDecision(t *testing.T) {
+	config := []*RuleConfiguration{
+		{Conditions: []string{"match roles admin"}, Action: `allow stop tag admins`},
+		{Conditions: []string{"match roles guest", "prefix match path /admin"}, Action: `deny tag guests`},
+		{Conditions: []string{"match roles guest"}, Action: `allow`},
+		{Conditions: []string{"match roles viewer"}, Action: `allow tag viewers`},
+	}
+	var testcases = []struct {
+		name  string
+		input map[string]interface{}
+		want  *Decision
+	}{
+		{
+			name:  "allow and stop",
+			input: map[string]interface{}{"roles": []string{"admin"}, "path": "/admin"},
+			want:  &Decision{Allow: true, RuleTag: "admins"},
+		},
+		{
+			name:  "deny",
+			input: map[string]interface{}{"roles": []string{"guest"}, "path": "/admin"},
+			want:  &Decision{RuleTag: "guests"},
+		},
+		{
+			name:  "allow by last allowing rule",
+			input: map[string]interface{}{"roles": []string{"guest", "viewer"}, "path": "/app"},
+			want:  &Decision{Allow: true, RuleTag: "viewers"},
+		},
+		{
+			name:  "allow with default tag",
+			input: map[string]interface{}{"roles": []string{"guest"}, "path": "/app"},
+			want:  &Decision{Allow: true, RuleTag: "rule2"},
+		},
+		{
+			name:  "no rule decided",
+			input: map[string]interface{}{"roles": []string{"anonymous"}, "path": "/app"},
+			want:  &Decision{},
+		},
+	}
+	for _, traceEnabled := range []bool{false, true} {
+		for _, tc := range testcases {
+			t.Run(tc.name, func(t *testing.T) {
+				ctx := context.Background()
+				accessList := NewAccessList()
+				if err := accessList.AddRules(ctx, config); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				accessList.EnableDecisions()
+				if traceEnabled {
+					accessList.EnableTrace()
+					ctx, _ = NewTraceContext(ctx)
+				}
+				ctx, decision := NewDecisionContext(ctx)
+				allow := accessList.Allow(ctx, tc.input)
+				tests.EvalObjects(t, "allow", tc.want.Allow, allow)
+				tests.EvalObjects(t, "decision", tc.want, decision)
+			})
+		}
+	}
+}
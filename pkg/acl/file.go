@@ -0,0 +1,196 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/greenpau/caddy-authorize/pkg/errors"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v2"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// filePolicy holds the rules loaded from a file. The rules are compiled
+// into a separate AccessList, which is swapped when the file changes.
+type filePolicy struct {
+	path     string
+	parent   *AccessList
+	current  atomic.Value
+	modTime  time.Time
+	size     int64
+	content  []byte
+	lastErr  string
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// WatchFile loads the rules of AccessList from the JSON or YAML file at
+// the path. The file contains a list of rule configurations. When the
+// interval is positive, the file is checked for changes at the interval
+// and the rules are replaced when the file changes. If the changed file is
+// invalid, AccessList keeps its last valid rules and logs the error.
+//
+// The logger, the default action, the decision trace, and the shadow
+// AccessList must be set before calling the function.
+func (acl *AccessList) WatchFile(ctx context.Context, path string, interval time.Duration) error {
+	if acl.file != nil {
+		acl.Close()
+	}
+	fp := &filePolicy{
+		path:   path,
+		parent: acl,
+		done:   make(chan struct{}),
+	}
+	if _, err := fp.reload(ctx); err != nil {
+		return err
+	}
+	acl.file = fp
+	if interval > 0 {
+		go fp.watch(ctx, interval)
+	}
+	return nil
+}
+
+// Close stops watching the file with the rules of AccessList.
+func (acl *AccessList) Close() {
+	if acl.file == nil {
+		return
+	}
+	acl.file.stopOnce.Do(func() {
+		close(acl.file.done)
+	})
+}
+
+// load returns the AccessList compiled from the most recent valid file.
+func (fp *filePolicy) load() *AccessList {
+	return fp.current.Load().(*AccessList)
+}
+
+func (fp *filePolicy) watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-fp.done:
+			return
+		case <-ticker.C:
+		}
+		reloaded, err := fp.reload(ctx)
+		if err != nil {
+			if err.Error() != fp.lastErr {
+				fp.lastErr = err.Error()
+				fp.logger().Error(
+					"failed reloading acl file, keeping last valid rules",
+					zap.String("path", fp.path),
+					zap.Error(err),
+				)
+			}
+			continue
+		}
+		fp.lastErr = ""
+		if reloaded {
+			fp.logger().Info(
+				"reloaded acl file",
+				zap.String("path", fp.path),
+				zap.Int("rule_count", len(fp.load().rules)),
+			)
+		}
+	}
+}
+
+func (fp *filePolicy) logger() *zap.Logger {
+	if fp.parent.logger == nil {
+		return zap.NewNop()
+	}
+	return fp.parent.logger
+}
+
+// reload reads the file and, when its content changed, compiles the rules
+// and swaps the current AccessList. The function returns true when the
+// rules were swapped.
+func (fp *filePolicy) reload(ctx context.Context) (bool, error) {
+	fi, err := os.Stat(fp.path)
+	if err != nil {
+		return false, errors.ErrAccessListFileRead.WithArgs(fp.path, err)
+	}
+	if fp.content != nil && fi.ModTime().Equal(fp.modTime) && fi.Size() == fp.size {
+		return false, nil
+	}
+	content, err := ioutil.ReadFile(fp.path)
+	if err != nil {
+		return false, errors.ErrAccessListFileRead.WithArgs(fp.path, err)
+	}
+	fp.modTime = fi.ModTime()
+	fp.size = fi.Size()
+	if fp.content != nil && bytes.Equal(content, fp.content) {
+		return false, nil
+	}
+
+	cfgs, err := parseRuleConfigurations(fp.path, content)
+	if err != nil {
+		return false, err
+	}
+	if len(cfgs) == 0 {
+		return false, errors.ErrAccessListFileRules.WithArgs(fp.path, errors.ErrAccessListNoRules)
+	}
+
+	parent := fp.parent
+	accessList := NewAccessList()
+	accessList.logger = parent.logger
+	accessList.defaultAllow = parent.defaultAllow
+	accessList.traceEnabled = parent.traceEnabled
+	accessList.decisionsEnabled = parent.decisionsEnabled
+	accessList.shadow = parent.shadow
+	if err := accessList.AddRules(ctx, cfgs); err != nil {
+		return false, errors.ErrAccessListFileRules.WithArgs(fp.path, err)
+	}
+	fp.current.Store(accessList)
+	fp.content = content
+	return true, nil
+}
+
+// parseRuleConfigurations parses the rule configurations in JSON or YAML
+// format, depending on the extension of the file.
+func parseRuleConfigurations(path string, content []byte) ([]*RuleConfiguration, error) {
+	var cfgs []*RuleConfiguration
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		if err := json.Unmarshal(content, &cfgs); err != nil {
+			return nil, errors.ErrAccessListFileParse.WithArgs(path, err)
+		}
+	case ".yaml", ".yml":
+		if err := yaml.UnmarshalStrict(content, &cfgs); err != nil {
+			return nil, errors.ErrAccessListFileParse.WithArgs(path, err)
+		}
+	default:
+		return nil, errors.ErrAccessListFileUnsupported.WithArgs(path)
+	}
+	for i, cfg := range cfgs {
+		if cfg == nil {
+			return nil, errors.ErrAccessListFileParse.WithArgs(path, errors.ErrAccessListRuleConfig.WithArgs(i, "empty rule"))
+		}
+	}
+	return cfgs, nil
+}
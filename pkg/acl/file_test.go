@@ -0,0 +1,202 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acl
+
+import (
+	"context"
+	"fmt"
+	"github.com/greenpau/caddy-authorize/internal/tests"
+	"github.com/greenpau/caddy-authorize/pkg/errors"
+	"github.com/greenpau/caddy-authorize/pkg/utils"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+type fileUpdate struct {
+	content     string
+	reloaded    bool
+	shouldErr   bool
+	err         error
+	want        []bool
+	inputFields []string
+}
+
+func TestAccessListFile(t *testing.T) {
+	inputs := []map[string]interface{}{
+		{"roles": []string{"admin"}, "host": "admin.local"},
+		{"roles": []string{"viewer"}, "host": "app.local"},
+		{"roles": []string{"guest"}, "host": "app.local"},
+	}
+	var testcases = []struct {
+		name      string
+		fileName  string
+		updates   []fileUpdate
+		shouldErr bool
+		err       error
+	}{
+		{
+			name:     "load rules from json file and reload",
+			fileName: "rules.json",
+			updates: []fileUpdate{
+				{
+					content:     `[{"conditions": ["match roles admin"], "action": "allow"}]`,
+					reloaded:    true,
+					want:        []bool{true, false, false},
+					inputFields: []string{},
+				},
+				{
+					content:     `[{"conditions": ["match roles admin"], "action": "allow"}]`,
+					want:        []bool{true, false, false},
+					inputFields: []string{},
+				},
+				{
+					content: `[
+						{"conditions": ["match roles admin viewer"], "action": "allow"},
+						{"conditions": ["exact match host admin.local"], "action": "deny"}
+					]`,
+					reloaded:    true,
+					want:        []bool{false, true, false},
+					inputFields: []string{"host"},
+				},
+				{
+					content:   `[{"conditions": ["match roles admin"], "action": "allow"`,
+					shouldErr: true,
+					err: errors.ErrAccessListFileParse.WithArgs("rules.json",
+						fmt.Errorf("unexpected end of JSON input"),
+					),
+					// Keeps the last valid rules.
+					want:        []bool{false, true, false},
+					inputFields: []string{"host"},
+				},
+				{
+					content:   `[{"conditions": ["foo roles admin"], "action": "allow"}]`,
+					shouldErr: true,
+					err: errors.ErrAccessListFileRules.WithArgs("rules.json",
+						fmt.Errorf("invalid rule syntax, invalid condition syntax, match not found: foo roles admin"),
+					),
+					want:        []bool{false, true, false},
+					inputFields: []string{"host"},
+				},
+				{
+					content:     `[{"conditions": ["match roles guest"], "action": "allow"}]`,
+					reloaded:    true,
+					want:        []bool{false, false, true},
+					inputFields: []string{},
+				},
+			},
+		},
+		{
+			name:     "load rules from yaml file",
+			fileName: "rules.yaml",
+			updates: []fileUpdate{
+				{
+					content: "- comment: viewers\n" +
+						"  conditions:\n" +
+						"  - match roles viewer\n" +
+						"  action: allow\n",
+					reloaded:    true,
+					want:        []bool{false, true, false},
+					inputFields: []string{},
+				},
+				{
+					content:   "- conditions: [match roles viewer]\n  action: allow\n  foo: bar\n",
+					shouldErr: true,
+					err: errors.ErrAccessListFileParse.WithArgs("rules.yaml",
+						fmt.Errorf("yaml: unmarshal errors:\n  line 3: field foo not found in type acl.RuleConfiguration"),
+					),
+					want:        []bool{false, true, false},
+					inputFields: []string{},
+				},
+			},
+		},
+		{
+			name:     "fail loading empty rules",
+			fileName: "rules.yml",
+			updates: []fileUpdate{
+				{content: "[]"},
+			},
+			shouldErr: true,
+			err:       errors.ErrAccessListFileRules.WithArgs("rules.yml", errors.ErrAccessListNoRules),
+		},
+		{
+			name:     "fail loading file with unsupported extension",
+			fileName: "rules.txt",
+			updates: []fileUpdate{
+				{content: "[]"},
+			},
+			shouldErr: true,
+			err:       errors.ErrAccessListFileUnsupported.WithArgs("rules.txt"),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			tmpDir, err := tests.TempDir("TestAccessListFile")
+			if err != nil {
+				t.Fatalf("failed creating temp dir: %v", err)
+			}
+			defer os.RemoveAll(tmpDir)
+			filePath := filepath.Join(tmpDir, tc.fileName)
+			modTime := time.Now().Add(-time.Hour)
+
+			accessList := NewAccessList()
+			accessList.SetLogger(utils.NewLogger())
+			defer accessList.Close()
+			for i, update := range tc.updates {
+				if err := ioutil.WriteFile(filePath, []byte(update.content), 0600); err != nil {
+					t.Fatalf("failed writing %s: %v", filePath, err)
+				}
+				modTime = modTime.Add(time.Second)
+				if err := os.Chtimes(filePath, modTime, modTime); err != nil {
+					t.Fatalf("failed updating %s: %v", filePath, err)
+				}
+				var reloaded bool
+				if i == 0 {
+					err = accessList.WatchFile(ctx, filePath, 0)
+					if tests.EvalErr(t, trimDir(err, tmpDir), tc.fileName, tc.shouldErr, tc.err) {
+						return
+					}
+					reloaded = true
+				} else {
+					reloaded, err = accessList.file.reload(ctx)
+					tests.EvalErr(t, trimDir(err, tmpDir), update.content, update.shouldErr, update.err)
+				}
+				tests.EvalObjects(t, fmt.Sprintf("update %d reloaded", i), update.reloaded, reloaded)
+				var got []bool
+				for _, input := range inputs {
+					got = append(got, accessList.Allow(ctx, input))
+				}
+				tests.EvalObjects(t, fmt.Sprintf("update %d decisions", i), update.want, got)
+				inputFields := accessList.GetInputFields()
+				if inputFields == nil {
+					inputFields = []string{}
+				}
+				tests.EvalObjects(t, fmt.Sprintf("update %d input fields", i), update.inputFields, inputFields)
+			}
+		})
+	}
+}
+
+// trimDir removes the directory of the file from the error message.
+func trimDir(err error, dir string) error {
+	if err == nil {
+		return nil
+	}
+	return fmt.Errorf("%s", strings.ReplaceAll(err.Error(), dir+string(filepath.Separator), ""))
+}
@@ -0,0 +1,162 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acl
+
+import (
+	"context"
+	"fmt"
+	"github.com/greenpau/caddy-authorize/pkg/utils/cfgutils"
+)
+
+// ruleCondExpr is a node in the tree of negated conditions and condition
+// groups. Unlike aclRuleCondition, it evaluates the input data as a whole.
+type ruleCondExpr interface {
+	eval(context.Context, map[string]interface{}) bool
+}
+
+// ruleCondLeaf evaluates a single condition. When the field of the condition
+// is not present in the input data, the condition does not match.
+type ruleCondLeaf struct {
+	field     string
+	condition aclRuleCondition
+	negate    bool
+}
+
+// ruleCondGroup evaluates a group of conditions. The group matches when any
+// or all of its members match.
+type ruleCondGroup struct {
+	members  []ruleCondExpr
+	matchAny bool
+	negate   bool
+}
+
+func (c *ruleCondLeaf) eval(ctx context.Context, data map[string]interface{}) bool {
+	v, found := data[c.field]
+	if !found {
+		return c.negate
+	}
+	return c.condition.match(ctx, v) != c.negate
+}
+
+func (c *ruleCondGroup) eval(ctx context.Context, data map[string]interface{}) bool {
+	for _, member := range c.members {
+		if member.eval(ctx, data) {
+			if c.matchAny {
+				return !c.negate
+			}
+			continue
+		}
+		if !c.matchAny {
+			return c.negate
+		}
+	}
+	return c.matchAny == c.negate
+}
+
+// hasRuleCondGroups returns true when the conditions have negated
+// conditions, condition groups, or placeholder values.
+func hasRuleCondGroups(conditions []string) bool {
+	for _, c := range conditions {
+		tokens, err := cfgutils.DecodeArgs(c)
+		if err != nil {
+			continue
+		}
+		switch tokens[0] {
+		case "not", "any", "all", "}":
+			return true
+		}
+		if hasPlaceholderValues(tokens) {
+			return true
+		}
+	}
+	return false
+}
+
+// newRuleCondGroup returns the root condition group for the conditions
+// having negated conditions, condition groups, or placeholder values, along
+// with the parsed conditions and the placeholders found in their values.
+// A group starts with "any {" or "all {", optionally preceded by "not",
+// and ends with "}".
+func newRuleCondGroup(ctx context.Context, conditions []string) (*ruleCondGroup, []aclRuleCondition, []string, error) {
+	var parsedConditions []aclRuleCondition
+	var placeholders []string
+
+	root := &ruleCondGroup{}
+	groups := []*ruleCondGroup{root}
+	for _, c := range conditions {
+		tokens, err := cfgutils.DecodeArgs(c)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("invalid rule syntax, failed to extract condition tokens: %s", err)
+		}
+		group := groups[len(groups)-1]
+		if len(tokens) == 1 && tokens[0] == "}" {
+			if len(groups) == 1 {
+				return nil, nil, nil, fmt.Errorf("invalid rule syntax, unexpected end of condition group")
+			}
+			if len(group.members) == 0 {
+				return nil, nil, nil, fmt.Errorf("invalid rule syntax, empty condition group")
+			}
+			groups = groups[:len(groups)-1]
+			continue
+		}
+		var negate bool
+		if tokens[0] == "not" {
+			negate = true
+			tokens = tokens[1:]
+			if len(tokens) == 0 {
+				return nil, nil, nil, fmt.Errorf("invalid rule syntax, not must be followed by condition: %s", c)
+			}
+		}
+		if len(tokens) == 2 && tokens[1] == "{" {
+			switch tokens[0] {
+			case "any", "all":
+			default:
+				return nil, nil, nil, fmt.Errorf("invalid rule syntax, unsupported condition group: %s", c)
+			}
+			nested := &ruleCondGroup{negate: negate, matchAny: tokens[0] == "any"}
+			group.members = append(group.members, nested)
+			groups = append(groups, nested)
+			continue
+		}
+		condition, err := newACLRuleCondition(ctx, tokens)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("invalid rule syntax, %v", err)
+		}
+		leaf, err := newRuleCondPlaceholderLeaf(ctx, condition, negate)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("invalid rule syntax, %v", err)
+		}
+		if leaf != nil {
+			group.members = append(group.members, leaf)
+			for _, value := range leaf.values {
+				if IsPlaceholder(value) {
+					placeholders = append(placeholders, value)
+				}
+			}
+		} else {
+			group.members = append(group.members, &ruleCondLeaf{
+				field:     condition.getConfig(ctx).field,
+				condition: condition,
+				negate:    negate,
+			})
+		}
+		parsedConditions = append(parsedConditions, condition)
+	}
+
+	if len(groups) > 1 {
+		return nil, nil, nil, fmt.Errorf("invalid rule syntax, condition group is not closed")
+	}
+	return root, parsedConditions, placeholders, nil
+}
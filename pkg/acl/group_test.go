@@ -0,0 +1,269 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acl
+
+import (
+	"context"
+	"fmt"
+	"github.com/greenpau/caddy-authorize/internal/tests"
+	"github.com/greenpau/caddy-authorize/pkg/utils"
+	"testing"
+)
+
+func TestEvalAclRuleWithCondGroups(t *testing.T) {
+	var testcases = []struct {
+		name      string
+		config    *RuleConfiguration
+		input     map[string]interface{}
+		want      map[string]interface{}
+		shouldErr bool
+		err       error
+	}{
+		{
+			name: "allow admin not going to billing",
+			config: &RuleConfiguration{
+				Conditions: []string{
+					"match roles admin",
+					"not prefix match path /billing",
+				},
+				Action: `allow stop`,
+			},
+			input: map[string]interface{}{
+				"roles": []string{"admin"},
+				"path":  "/app/dashboard",
+			},
+			want: map[string]interface{}{
+				"verdict": "ruleVerdictAllowStop",
+				"fields":  []string{"roles", "path"},
+			},
+		},
+		{
+			name: "continue for admin going to billing",
+			config: &RuleConfiguration{
+				Conditions: []string{
+					"match roles admin",
+					"not prefix match path /billing",
+				},
+				Action: `allow stop`,
+			},
+			input: map[string]interface{}{
+				"roles": []string{"admin"},
+				"path":  "/billing/invoices",
+			},
+			want: map[string]interface{}{
+				"verdict": "ruleVerdictContinue",
+				"fields":  []string{"roles", "path"},
+			},
+		},
+		{
+			name: "negated condition matches when field is not present",
+			config: &RuleConfiguration{
+				Conditions: []string{
+					"match roles admin",
+					"not match org nyc",
+				},
+				Action: `deny log debug`,
+			},
+			input: map[string]interface{}{
+				"roles": []string{"admin"},
+			},
+			want: map[string]interface{}{
+				"verdict": "ruleVerdictDeny",
+				"fields":  []string{"roles", "org"},
+			},
+		},
+		{
+			name: "nested any group matches",
+			config: &RuleConfiguration{
+				Conditions: []string{
+					"match roles editor",
+					"any {",
+					"match org nyc",
+					"all {",
+					"match org sfo",
+					"exact match method GET",
+					"}",
+					"}",
+				},
+				Action: `allow counter`,
+			},
+			input: map[string]interface{}{
+				"roles":  []string{"editor"},
+				"org":    []string{"sfo"},
+				"method": "GET",
+			},
+			want: map[string]interface{}{
+				"verdict": "ruleVerdictAllow",
+				"fields":  []string{"roles", "org", "org", "method"},
+			},
+		},
+		{
+			name: "nested any group does not match",
+			config: &RuleConfiguration{
+				Conditions: []string{
+					"match roles editor",
+					"any {",
+					"match org nyc",
+					"all {",
+					"match org sfo",
+					"exact match method GET",
+					"}",
+					"}",
+				},
+				Action: `allow`,
+			},
+			input: map[string]interface{}{
+				"roles":  []string{"editor"},
+				"org":    []string{"sfo"},
+				"method": "POST",
+			},
+			want: map[string]interface{}{
+				"verdict": "ruleVerdictContinue",
+				"fields":  []string{"roles", "org", "org", "method"},
+			},
+		},
+		{
+			name: "negated all group with match any action",
+			config: &RuleConfiguration{
+				Conditions: []string{
+					"match roles admin",
+					"not all {",
+					"match org nyc",
+					"match email jsmith@contoso.com",
+					"}",
+				},
+				Action: `deny any stop`,
+			},
+			input: map[string]interface{}{
+				"roles": []string{"viewer"},
+				"org":   []string{"nyc"},
+				"email": "jsmith@contoso.com",
+			},
+			want: map[string]interface{}{
+				"verdict": "ruleVerdictContinue",
+				"fields":  []string{"roles", "org", "email"},
+			},
+		},
+		{
+			name: "negated all group with match any action matches",
+			config: &RuleConfiguration{
+				Conditions: []string{
+					"match roles admin",
+					"not all {",
+					"match org nyc",
+					"match email jsmith@contoso.com",
+					"}",
+				},
+				Action: `deny any stop`,
+			},
+			input: map[string]interface{}{
+				"roles": []string{"viewer"},
+				"org":   []string{"sfo"},
+				"email": "jsmith@contoso.com",
+			},
+			want: map[string]interface{}{
+				"verdict": "ruleVerdictDenyStop",
+				"fields":  []string{"roles", "org", "email"},
+			},
+		},
+		{
+			name: "condition group is not closed",
+			config: &RuleConfiguration{
+				Conditions: []string{
+					"match roles admin",
+					"any {",
+					"match org nyc",
+				},
+				Action: `allow`,
+			},
+			shouldErr: true,
+			err:       fmt.Errorf("invalid rule syntax, condition group is not closed"),
+		},
+		{
+			name: "unexpected end of condition group",
+			config: &RuleConfiguration{
+				Conditions: []string{
+					"match roles admin",
+					"}",
+				},
+				Action: `allow`,
+			},
+			shouldErr: true,
+			err:       fmt.Errorf("invalid rule syntax, unexpected end of condition group"),
+		},
+		{
+			name: "empty condition group",
+			config: &RuleConfiguration{
+				Conditions: []string{
+					"match roles admin",
+					"all {",
+					"}",
+				},
+				Action: `allow`,
+			},
+			shouldErr: true,
+			err:       fmt.Errorf("invalid rule syntax, empty condition group"),
+		},
+		{
+			name: "unsupported condition group",
+			config: &RuleConfiguration{
+				Conditions: []string{
+					"none {",
+					"match roles admin",
+					"}",
+				},
+				Action: `allow`,
+			},
+			shouldErr: true,
+			err:       fmt.Errorf("invalid rule syntax, unsupported condition group: none {"),
+		},
+		{
+			name: "not without condition",
+			config: &RuleConfiguration{
+				Conditions: []string{
+					"not",
+				},
+				Action: `allow`,
+			},
+			shouldErr: true,
+			err:       fmt.Errorf("invalid rule syntax, not must be followed by condition: not"),
+		},
+		{
+			name: "invalid negated condition",
+			config: &RuleConfiguration{
+				Conditions: []string{
+					"not match foo bar",
+				},
+				Action: `allow`,
+			},
+			shouldErr: true,
+			err:       fmt.Errorf("invalid rule syntax, invalid condition syntax, unsupported field: foo, condition: match foo bar"),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			logger := utils.NewLogger()
+			rule, err := newACLRule(ctx, 0, tc.config, logger)
+			if tests.EvalErr(t, err, tc.config, tc.shouldErr, tc.err) {
+				return
+			}
+			got := make(map[string]interface{})
+			got["verdict"] = getRuleVerdictName(rule.eval(ctx, tc.input))
+			got["fields"] = rule.getConfig(ctx).fields
+			tests.EvalObjects(t, "output", tc.want, got)
+		})
+	}
+}
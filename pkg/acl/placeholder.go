@@ -0,0 +1,148 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ruleCondPlaceholderLeaf evaluates a condition having values that refer to
+// other inputs, e.g. {http.request.uri.path.1} or {claim.sub}. The values
+// of the placeholders are resolved per request and passed in the input data
+// under the names of the placeholders, e.g. "{claim.sub}".
+type ruleCondPlaceholderLeaf struct {
+	field         string
+	matchStrategy fieldMatchStrategy
+	values        []string
+	negate        bool
+	input         string
+}
+
+func (c *ruleCondPlaceholderLeaf) eval(ctx context.Context, data map[string]interface{}) bool {
+	v, found := data[c.field]
+	if !found {
+		return c.negate
+	}
+	inputs := getStringValues(v)
+	for _, value := range c.values {
+		exprs := []string{value}
+		if IsPlaceholder(value) {
+			exprs = getStringValues(data[value])
+		}
+		for _, expr := range exprs {
+			for _, input := range inputs {
+				if matchStringValue(c.matchStrategy, input, expr) {
+					return !c.negate
+				}
+			}
+		}
+	}
+	return c.negate
+}
+
+// IsPlaceholder returns true when the value is a placeholder, e.g.
+// {http.request.uri.path.1}.
+func IsPlaceholder(s string) bool {
+	return len(s) > 2 && strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}") && !strings.ContainsAny(s[1:len(s)-1], "{}")
+}
+
+// validatePlaceholder returns an error when the placeholder is malformed.
+// The placeholders other than the claim ones are resolved by the Caddy
+// replacer of the request and are not being validated.
+func validatePlaceholder(s string) error {
+	name := s[1 : len(s)-1]
+	if strings.HasPrefix(name, claimDataPrefix) {
+		return validateClaimPath(strings.TrimPrefix(name, claimDataPrefix))
+	}
+	return nil
+}
+
+// newRuleCondPlaceholderLeaf returns the condition with placeholder values.
+// If the condition has no placeholder values, the function returns nil.
+func newRuleCondPlaceholderLeaf(ctx context.Context, condition aclRuleCondition, negate bool) (*ruleCondPlaceholderLeaf, error) {
+	cfg := condition.getConfig(ctx)
+	var placeholderFound bool
+	for _, value := range cfg.values {
+		if !IsPlaceholder(value) {
+			continue
+		}
+		if err := validatePlaceholder(value); err != nil {
+			return nil, fmt.Errorf("invalid condition syntax, placeholder %s: %v, field: %s", value, err, cfg.field)
+		}
+		placeholderFound = true
+	}
+	if !placeholderFound {
+		return nil, nil
+	}
+	switch cfg.matchStrategy {
+	case fieldMatchExact, fieldMatchPartial, fieldMatchPrefix, fieldMatchSuffix:
+	default:
+		return nil, fmt.Errorf("invalid condition syntax, placeholders are unsupported with %s match, field: %s",
+			strings.ToLower(strings.TrimPrefix(getMatchStrategyName(cfg.matchStrategy), "fieldMatch")), cfg.field,
+		)
+	}
+	return &ruleCondPlaceholderLeaf{
+		field:         cfg.field,
+		matchStrategy: cfg.matchStrategy,
+		values:        cfg.values,
+		negate:        negate,
+		input:         cfg.input,
+	}, nil
+}
+
+// hasPlaceholderValues returns true when any of the condition tokens
+// is a placeholder.
+func hasPlaceholderValues(tokens []string) bool {
+	for _, token := range tokens {
+		if IsPlaceholder(token) {
+			return true
+		}
+	}
+	return false
+}
+
+func getStringValues(v interface{}) []string {
+	switch data := v.(type) {
+	case string:
+		return []string{data}
+	case []string:
+		return data
+	case []interface{}:
+		var values []string
+		for _, entry := range data {
+			if s, ok := entry.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+func matchStringValue(matchStrategy fieldMatchStrategy, input, expr string) bool {
+	switch matchStrategy {
+	case fieldMatchExact:
+		return input == expr
+	case fieldMatchPartial:
+		return expr != "" && strings.Contains(input, expr)
+	case fieldMatchPrefix:
+		return expr != "" && strings.HasPrefix(input, expr)
+	case fieldMatchSuffix:
+		return expr != "" && strings.HasSuffix(input, expr)
+	}
+	return false
+}
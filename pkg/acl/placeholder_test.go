@@ -0,0 +1,176 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acl
+
+import (
+	"context"
+	"fmt"
+	"github.com/greenpau/caddy-authorize/internal/tests"
+	"github.com/greenpau/caddy-authorize/pkg/utils"
+	"testing"
+)
+
+func TestEvalAclRuleWithPlaceholders(t *testing.T) {
+	var testcases = []struct {
+		name      string
+		config    *RuleConfiguration
+		input     map[string]interface{}
+		want      map[string]interface{}
+		shouldErr bool
+		err       error
+	}{
+		{
+			name: "allow when path segment matches subject",
+			config: &RuleConfiguration{
+				Conditions: []string{
+					"prefix match path /users/",
+					"exact match sub {http.request.uri.path.1}",
+				},
+				Action: `allow stop`,
+			},
+			input: map[string]interface{}{
+				"sub":                       "jsmith",
+				"path":                      "/users/jsmith/profile",
+				"{http.request.uri.path.1}": []string{"jsmith"},
+			},
+			want: map[string]interface{}{
+				"verdict":      "ruleVerdictAllowStop",
+				"placeholders": []string{"{http.request.uri.path.1}"},
+			},
+		},
+		{
+			name: "continue when path segment does not match subject",
+			config: &RuleConfiguration{
+				Conditions: []string{
+					"prefix match path /users/",
+					"exact match sub {http.request.uri.path.1}",
+				},
+				Action: `allow stop`,
+			},
+			input: map[string]interface{}{
+				"sub":                       "jsmith",
+				"path":                      "/users/jdoe/profile",
+				"{http.request.uri.path.1}": []string{"jdoe"},
+			},
+			want: map[string]interface{}{
+				"verdict":      "ruleVerdictContinue",
+				"placeholders": []string{"{http.request.uri.path.1}"},
+			},
+		},
+		{
+			name: "continue when placeholder is not resolved",
+			config: &RuleConfiguration{
+				Conditions: []string{
+					"exact match org {http.request.header.X-Tenant}",
+				},
+				Action: `allow`,
+			},
+			input: map[string]interface{}{
+				"org": []string{"contoso"},
+			},
+			want: map[string]interface{}{
+				"verdict":      "ruleVerdictContinue",
+				"placeholders": []string{"{http.request.header.X-Tenant}"},
+			},
+		},
+		{
+			name: "allow when one of organizations matches header or static value",
+			config: &RuleConfiguration{
+				Conditions: []string{
+					"exact match org {http.request.header.X-Tenant} fabrikam",
+				},
+				Action: `allow`,
+			},
+			input: map[string]interface{}{
+				"org":                            []string{"nyc", "contoso"},
+				"{http.request.header.X-Tenant}": []string{"contoso"},
+			},
+			want: map[string]interface{}{
+				"verdict":      "ruleVerdictAllow",
+				"placeholders": []string{"{http.request.header.X-Tenant}"},
+			},
+		},
+		{
+			name: "deny when host suffix does not match claim",
+			config: &RuleConfiguration{
+				Conditions: []string{
+					"not suffix match host {claim.metadata.domain}",
+				},
+				Action: `deny`,
+			},
+			input: map[string]interface{}{
+				"host":                    "app.fabrikam.com",
+				"{claim.metadata.domain}": []string{"contoso.com"},
+			},
+			want: map[string]interface{}{
+				"verdict":      "ruleVerdictDeny",
+				"placeholders": []string{"{claim.metadata.domain}"},
+			},
+		},
+		{
+			name: "allow when matcher variable matches subject",
+			config: &RuleConfiguration{
+				Conditions: []string{
+					"exact match sub {http.vars.owner}",
+				},
+				Action: `allow`,
+			},
+			input: map[string]interface{}{
+				"sub":               "jsmith",
+				"{http.vars.owner}": []string{"jsmith"},
+			},
+			want: map[string]interface{}{
+				"verdict":      "ruleVerdictAllow",
+				"placeholders": []string{"{http.vars.owner}"},
+			},
+		},
+		{
+			name: "claim placeholder with empty path segment",
+			config: &RuleConfiguration{
+				Conditions: []string{
+					"exact match sub {claim.metadata..owner}",
+				},
+				Action: `allow`,
+			},
+			shouldErr: true,
+			err:       fmt.Errorf("invalid rule syntax, invalid condition syntax, placeholder {claim.metadata..owner}: empty path segment, field: sub"),
+		},
+		{
+			name: "placeholder with regex match",
+			config: &RuleConfiguration{
+				Conditions: []string{
+					"regex match sub {claim.email}",
+				},
+				Action: `allow`,
+			},
+			shouldErr: true,
+			err:       fmt.Errorf("invalid rule syntax, invalid condition syntax, placeholders are unsupported with regex match, field: sub"),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			logger := utils.NewLogger()
+			rule, err := newACLRule(ctx, 0, tc.config, logger)
+			if tests.EvalErr(t, err, tc.config, tc.shouldErr, tc.err) {
+				return
+			}
+			got := make(map[string]interface{})
+			got["verdict"] = getRuleVerdictName(rule.eval(ctx, tc.input))
+			got["placeholders"] = rule.getConfig(ctx).placeholders
+			tests.EvalObjects(t, "output", tc.want, got)
+		})
+	}
+}
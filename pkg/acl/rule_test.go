@@ -19,7 +19,6 @@ import (
 	"fmt"
 	"github.com/greenpau/caddy-authorize/internal/tests"
 	"github.com/greenpau/caddy-authorize/pkg/utils"
-	"reflect"
 	"strings"
 	"testing"
 )
@@ -43,7 +42,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow any stop`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowMatchAnyStop",
 				"config_rule_type":      "aclRuleAllowMatchAnyStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -73,7 +71,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow stop`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowMatchAllStop",
 				"config_rule_type":      "aclRuleAllowMatchAllStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -100,7 +97,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `allow stop`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowStop",
 				"config_rule_type":      "aclRuleAllowStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -127,7 +123,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow any`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowMatchAny",
 				"config_rule_type":      "aclRuleAllowMatchAny",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -157,7 +152,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowMatchAll",
 				"config_rule_type":      "aclRuleAllowMatchAll",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -184,7 +178,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `allow`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllow",
 				"config_rule_type":      "aclRuleAllow",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -211,7 +204,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow any stop log debug`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithDebugLoggerMatchAnyStop",
 				"config_rule_type":      "aclRuleAllowWithDebugLoggerMatchAnyStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -242,7 +234,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow any stop log info`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithInfoLoggerMatchAnyStop",
 				"config_rule_type":      "aclRuleAllowWithInfoLoggerMatchAnyStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -273,7 +264,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow any stop log warn`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithWarnLoggerMatchAnyStop",
 				"config_rule_type":      "aclRuleAllowWithWarnLoggerMatchAnyStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -304,7 +294,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow any stop log error`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithErrorLoggerMatchAnyStop",
 				"config_rule_type":      "aclRuleAllowWithErrorLoggerMatchAnyStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -335,7 +324,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow stop log debug`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithDebugLoggerMatchAllStop",
 				"config_rule_type":      "aclRuleAllowWithDebugLoggerMatchAllStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -366,7 +354,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow stop log info`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithInfoLoggerMatchAllStop",
 				"config_rule_type":      "aclRuleAllowWithInfoLoggerMatchAllStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -397,7 +384,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow stop log warn`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithWarnLoggerMatchAllStop",
 				"config_rule_type":      "aclRuleAllowWithWarnLoggerMatchAllStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -428,7 +414,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow stop log error`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithErrorLoggerMatchAllStop",
 				"config_rule_type":      "aclRuleAllowWithErrorLoggerMatchAllStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -456,7 +441,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `allow stop log debug`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithDebugLoggerStop",
 				"config_rule_type":      "aclRuleAllowWithDebugLoggerStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -481,7 +465,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `allow stop log info`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithInfoLoggerStop",
 				"config_rule_type":      "aclRuleAllowWithInfoLoggerStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -506,7 +489,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `allow stop log warn`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithWarnLoggerStop",
 				"config_rule_type":      "aclRuleAllowWithWarnLoggerStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -531,7 +513,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `allow stop log error`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithErrorLoggerStop",
 				"config_rule_type":      "aclRuleAllowWithErrorLoggerStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -559,7 +540,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow any log debug`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithDebugLoggerMatchAny",
 				"config_rule_type":      "aclRuleAllowWithDebugLoggerMatchAny",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -590,7 +570,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow any log info`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithInfoLoggerMatchAny",
 				"config_rule_type":      "aclRuleAllowWithInfoLoggerMatchAny",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -621,7 +600,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow any log warn`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithWarnLoggerMatchAny",
 				"config_rule_type":      "aclRuleAllowWithWarnLoggerMatchAny",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -652,7 +630,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow any log error`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithErrorLoggerMatchAny",
 				"config_rule_type":      "aclRuleAllowWithErrorLoggerMatchAny",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -683,7 +660,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow log debug`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithDebugLoggerMatchAll",
 				"config_rule_type":      "aclRuleAllowWithDebugLoggerMatchAll",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -714,7 +690,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow log info`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithInfoLoggerMatchAll",
 				"config_rule_type":      "aclRuleAllowWithInfoLoggerMatchAll",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -745,7 +720,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow log warn`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithWarnLoggerMatchAll",
 				"config_rule_type":      "aclRuleAllowWithWarnLoggerMatchAll",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -776,7 +750,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow log error`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithErrorLoggerMatchAll",
 				"config_rule_type":      "aclRuleAllowWithErrorLoggerMatchAll",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -804,7 +777,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `allow log debug`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithDebugLogger",
 				"config_rule_type":      "aclRuleAllowWithDebugLogger",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -829,7 +801,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `allow log info`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithInfoLogger",
 				"config_rule_type":      "aclRuleAllowWithInfoLogger",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -854,7 +825,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `allow log warn`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithWarnLogger",
 				"config_rule_type":      "aclRuleAllowWithWarnLogger",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -879,7 +849,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `allow log error`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithErrorLogger",
 				"config_rule_type":      "aclRuleAllowWithErrorLogger",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -907,7 +876,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow any stop counter`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithCounterMatchAnyStop",
 				"config_rule_type":      "aclRuleAllowWithCounterMatchAnyStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -937,7 +905,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow stop counter`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithCounterMatchAllStop",
 				"config_rule_type":      "aclRuleAllowWithCounterMatchAllStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -964,7 +931,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `allow stop counter`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithCounterStop",
 				"config_rule_type":      "aclRuleAllowWithCounterStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -991,7 +957,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow any counter`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithCounterMatchAny",
 				"config_rule_type":      "aclRuleAllowWithCounterMatchAny",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -1021,7 +986,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow counter`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithCounterMatchAll",
 				"config_rule_type":      "aclRuleAllowWithCounterMatchAll",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -1048,7 +1012,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `allow counter`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithCounter",
 				"config_rule_type":      "aclRuleAllowWithCounter",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -1075,7 +1038,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow any stop counter log debug`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithDebugLoggerCounterMatchAnyStop",
 				"config_rule_type":      "aclRuleAllowWithDebugLoggerCounterMatchAnyStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -1106,7 +1068,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow any stop counter log info`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithInfoLoggerCounterMatchAnyStop",
 				"config_rule_type":      "aclRuleAllowWithInfoLoggerCounterMatchAnyStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -1137,7 +1098,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow any stop counter log warn`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithWarnLoggerCounterMatchAnyStop",
 				"config_rule_type":      "aclRuleAllowWithWarnLoggerCounterMatchAnyStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -1168,7 +1128,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow any stop counter log error`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithErrorLoggerCounterMatchAnyStop",
 				"config_rule_type":      "aclRuleAllowWithErrorLoggerCounterMatchAnyStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -1199,7 +1158,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow stop counter log debug`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithDebugLoggerCounterMatchAllStop",
 				"config_rule_type":      "aclRuleAllowWithDebugLoggerCounterMatchAllStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -1230,7 +1188,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow stop counter log info`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithInfoLoggerCounterMatchAllStop",
 				"config_rule_type":      "aclRuleAllowWithInfoLoggerCounterMatchAllStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -1261,7 +1218,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow stop counter log warn`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithWarnLoggerCounterMatchAllStop",
 				"config_rule_type":      "aclRuleAllowWithWarnLoggerCounterMatchAllStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -1292,7 +1248,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow stop counter log error`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithErrorLoggerCounterMatchAllStop",
 				"config_rule_type":      "aclRuleAllowWithErrorLoggerCounterMatchAllStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -1320,7 +1275,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `allow stop counter log debug`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithDebugLoggerCounterStop",
 				"config_rule_type":      "aclRuleAllowWithDebugLoggerCounterStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -1345,7 +1299,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `allow stop counter log info`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithInfoLoggerCounterStop",
 				"config_rule_type":      "aclRuleAllowWithInfoLoggerCounterStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -1370,7 +1323,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `allow stop counter log warn`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithWarnLoggerCounterStop",
 				"config_rule_type":      "aclRuleAllowWithWarnLoggerCounterStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -1395,7 +1347,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `allow stop counter log error`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithErrorLoggerCounterStop",
 				"config_rule_type":      "aclRuleAllowWithErrorLoggerCounterStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -1423,7 +1374,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow any counter log debug`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithDebugLoggerCounterMatchAny",
 				"config_rule_type":      "aclRuleAllowWithDebugLoggerCounterMatchAny",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -1454,7 +1404,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow any counter log info`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithInfoLoggerCounterMatchAny",
 				"config_rule_type":      "aclRuleAllowWithInfoLoggerCounterMatchAny",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -1485,7 +1434,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow any counter log warn`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithWarnLoggerCounterMatchAny",
 				"config_rule_type":      "aclRuleAllowWithWarnLoggerCounterMatchAny",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -1516,7 +1464,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow any counter log error`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithErrorLoggerCounterMatchAny",
 				"config_rule_type":      "aclRuleAllowWithErrorLoggerCounterMatchAny",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -1547,7 +1494,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow counter log debug`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithDebugLoggerCounterMatchAll",
 				"config_rule_type":      "aclRuleAllowWithDebugLoggerCounterMatchAll",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -1578,7 +1524,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow counter log info`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithInfoLoggerCounterMatchAll",
 				"config_rule_type":      "aclRuleAllowWithInfoLoggerCounterMatchAll",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -1609,7 +1554,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow counter log warn`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithWarnLoggerCounterMatchAll",
 				"config_rule_type":      "aclRuleAllowWithWarnLoggerCounterMatchAll",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -1640,7 +1584,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `allow counter log error`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithErrorLoggerCounterMatchAll",
 				"config_rule_type":      "aclRuleAllowWithErrorLoggerCounterMatchAll",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -1668,7 +1611,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `allow counter log debug`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithDebugLoggerCounter",
 				"config_rule_type":      "aclRuleAllowWithDebugLoggerCounter",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -1693,7 +1635,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `allow counter log info`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithInfoLoggerCounter",
 				"config_rule_type":      "aclRuleAllowWithInfoLoggerCounter",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -1718,7 +1659,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `allow counter log warn`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithWarnLoggerCounter",
 				"config_rule_type":      "aclRuleAllowWithWarnLoggerCounter",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -1743,7 +1683,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `allow counter log error`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleAllowWithErrorLoggerCounter",
 				"config_rule_type":      "aclRuleAllowWithErrorLoggerCounter",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionAllow",
@@ -1771,7 +1710,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny any stop`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyMatchAnyStop",
 				"config_rule_type":      "aclRuleDenyMatchAnyStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -1801,7 +1739,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny stop`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyMatchAllStop",
 				"config_rule_type":      "aclRuleDenyMatchAllStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -1828,7 +1765,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `deny stop`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyStop",
 				"config_rule_type":      "aclRuleDenyStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -1855,7 +1791,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny any`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyMatchAny",
 				"config_rule_type":      "aclRuleDenyMatchAny",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -1885,7 +1820,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyMatchAll",
 				"config_rule_type":      "aclRuleDenyMatchAll",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -1912,7 +1846,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `deny`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDeny",
 				"config_rule_type":      "aclRuleDeny",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -1939,7 +1872,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny any stop log debug`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithDebugLoggerMatchAnyStop",
 				"config_rule_type":      "aclRuleDenyWithDebugLoggerMatchAnyStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -1970,7 +1902,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny any stop log info`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithInfoLoggerMatchAnyStop",
 				"config_rule_type":      "aclRuleDenyWithInfoLoggerMatchAnyStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2001,7 +1932,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny any stop log warn`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithWarnLoggerMatchAnyStop",
 				"config_rule_type":      "aclRuleDenyWithWarnLoggerMatchAnyStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2032,7 +1962,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny any stop log error`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithErrorLoggerMatchAnyStop",
 				"config_rule_type":      "aclRuleDenyWithErrorLoggerMatchAnyStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2063,7 +1992,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny stop log debug`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithDebugLoggerMatchAllStop",
 				"config_rule_type":      "aclRuleDenyWithDebugLoggerMatchAllStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2094,7 +2022,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny stop log info`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithInfoLoggerMatchAllStop",
 				"config_rule_type":      "aclRuleDenyWithInfoLoggerMatchAllStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2125,7 +2052,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny stop log warn`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithWarnLoggerMatchAllStop",
 				"config_rule_type":      "aclRuleDenyWithWarnLoggerMatchAllStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2156,7 +2082,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny stop log error`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithErrorLoggerMatchAllStop",
 				"config_rule_type":      "aclRuleDenyWithErrorLoggerMatchAllStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2184,7 +2109,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `deny stop log debug`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithDebugLoggerStop",
 				"config_rule_type":      "aclRuleDenyWithDebugLoggerStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2209,7 +2133,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `deny stop log tag foobar`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithInfoLoggerStop",
 				"config_rule_type":      "aclRuleDenyWithInfoLoggerStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2235,7 +2158,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `deny stop log warn`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithWarnLoggerStop",
 				"config_rule_type":      "aclRuleDenyWithWarnLoggerStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2260,7 +2182,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `deny stop log error`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithErrorLoggerStop",
 				"config_rule_type":      "aclRuleDenyWithErrorLoggerStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2288,7 +2209,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny any log debug`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithDebugLoggerMatchAny",
 				"config_rule_type":      "aclRuleDenyWithDebugLoggerMatchAny",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2319,7 +2239,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny any log info`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithInfoLoggerMatchAny",
 				"config_rule_type":      "aclRuleDenyWithInfoLoggerMatchAny",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2350,7 +2269,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny any log warn`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithWarnLoggerMatchAny",
 				"config_rule_type":      "aclRuleDenyWithWarnLoggerMatchAny",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2381,7 +2299,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny any log error`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithErrorLoggerMatchAny",
 				"config_rule_type":      "aclRuleDenyWithErrorLoggerMatchAny",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2412,7 +2329,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny log debug`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithDebugLoggerMatchAll",
 				"config_rule_type":      "aclRuleDenyWithDebugLoggerMatchAll",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2443,7 +2359,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny log info`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithInfoLoggerMatchAll",
 				"config_rule_type":      "aclRuleDenyWithInfoLoggerMatchAll",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2474,7 +2389,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny log warn`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithWarnLoggerMatchAll",
 				"config_rule_type":      "aclRuleDenyWithWarnLoggerMatchAll",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2505,7 +2419,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny log error`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithErrorLoggerMatchAll",
 				"config_rule_type":      "aclRuleDenyWithErrorLoggerMatchAll",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2533,7 +2446,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `deny log debug`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithDebugLogger",
 				"config_rule_type":      "aclRuleDenyWithDebugLogger",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2558,7 +2470,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `deny log info`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithInfoLogger",
 				"config_rule_type":      "aclRuleDenyWithInfoLogger",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2583,7 +2494,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `deny log warn`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithWarnLogger",
 				"config_rule_type":      "aclRuleDenyWithWarnLogger",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2608,7 +2518,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `deny log error`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithErrorLogger",
 				"config_rule_type":      "aclRuleDenyWithErrorLogger",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2636,7 +2545,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny any stop counter`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithCounterMatchAnyStop",
 				"config_rule_type":      "aclRuleDenyWithCounterMatchAnyStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2666,7 +2574,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny stop counter`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithCounterMatchAllStop",
 				"config_rule_type":      "aclRuleDenyWithCounterMatchAllStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2693,7 +2600,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `deny stop counter`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithCounterStop",
 				"config_rule_type":      "aclRuleDenyWithCounterStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2720,7 +2626,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny any counter`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithCounterMatchAny",
 				"config_rule_type":      "aclRuleDenyWithCounterMatchAny",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2750,7 +2655,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny counter`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithCounterMatchAll",
 				"config_rule_type":      "aclRuleDenyWithCounterMatchAll",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2777,7 +2681,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `deny counter`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithCounter",
 				"config_rule_type":      "aclRuleDenyWithCounter",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2804,7 +2707,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny any stop counter log debug`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithDebugLoggerCounterMatchAnyStop",
 				"config_rule_type":      "aclRuleDenyWithDebugLoggerCounterMatchAnyStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2835,7 +2737,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny any stop counter log info`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithInfoLoggerCounterMatchAnyStop",
 				"config_rule_type":      "aclRuleDenyWithInfoLoggerCounterMatchAnyStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2866,7 +2767,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny any stop counter log warn`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithWarnLoggerCounterMatchAnyStop",
 				"config_rule_type":      "aclRuleDenyWithWarnLoggerCounterMatchAnyStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2897,7 +2797,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny any stop counter log error`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithErrorLoggerCounterMatchAnyStop",
 				"config_rule_type":      "aclRuleDenyWithErrorLoggerCounterMatchAnyStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2928,7 +2827,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny stop counter log debug`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithDebugLoggerCounterMatchAllStop",
 				"config_rule_type":      "aclRuleDenyWithDebugLoggerCounterMatchAllStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2959,7 +2857,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny stop counter log info`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithInfoLoggerCounterMatchAllStop",
 				"config_rule_type":      "aclRuleDenyWithInfoLoggerCounterMatchAllStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -2990,7 +2887,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny stop counter log warn`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithWarnLoggerCounterMatchAllStop",
 				"config_rule_type":      "aclRuleDenyWithWarnLoggerCounterMatchAllStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -3021,7 +2917,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny stop counter log error`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithErrorLoggerCounterMatchAllStop",
 				"config_rule_type":      "aclRuleDenyWithErrorLoggerCounterMatchAllStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -3049,7 +2944,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `deny stop counter log debug`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithDebugLoggerCounterStop",
 				"config_rule_type":      "aclRuleDenyWithDebugLoggerCounterStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -3074,7 +2968,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `deny stop counter log info`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithInfoLoggerCounterStop",
 				"config_rule_type":      "aclRuleDenyWithInfoLoggerCounterStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -3099,7 +2992,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `deny stop counter log warn`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithWarnLoggerCounterStop",
 				"config_rule_type":      "aclRuleDenyWithWarnLoggerCounterStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -3124,7 +3016,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `deny stop counter log error`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithErrorLoggerCounterStop",
 				"config_rule_type":      "aclRuleDenyWithErrorLoggerCounterStop",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -3152,7 +3043,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny any counter log debug`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithDebugLoggerCounterMatchAny",
 				"config_rule_type":      "aclRuleDenyWithDebugLoggerCounterMatchAny",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -3183,7 +3073,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny any counter log info`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithInfoLoggerCounterMatchAny",
 				"config_rule_type":      "aclRuleDenyWithInfoLoggerCounterMatchAny",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -3214,7 +3103,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny any counter log warn`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithWarnLoggerCounterMatchAny",
 				"config_rule_type":      "aclRuleDenyWithWarnLoggerCounterMatchAny",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -3245,7 +3133,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny any counter log error`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithErrorLoggerCounterMatchAny",
 				"config_rule_type":      "aclRuleDenyWithErrorLoggerCounterMatchAny",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -3276,7 +3163,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny counter log debug`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithDebugLoggerCounterMatchAll",
 				"config_rule_type":      "aclRuleDenyWithDebugLoggerCounterMatchAll",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -3307,7 +3193,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny counter log info`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithInfoLoggerCounterMatchAll",
 				"config_rule_type":      "aclRuleDenyWithInfoLoggerCounterMatchAll",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -3338,7 +3223,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny counter log warn`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithWarnLoggerCounterMatchAll",
 				"config_rule_type":      "aclRuleDenyWithWarnLoggerCounterMatchAll",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -3369,7 +3253,6 @@ func TestNewAclRule(t *testing.T) {
 				Action: `deny counter log error`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithErrorLoggerCounterMatchAll",
 				"config_rule_type":      "aclRuleDenyWithErrorLoggerCounterMatchAll",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -3397,7 +3280,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `deny counter log debug`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithDebugLoggerCounter",
 				"config_rule_type":      "aclRuleDenyWithDebugLoggerCounter",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -3422,7 +3304,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `deny counter log info`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithInfoLoggerCounter",
 				"config_rule_type":      "aclRuleDenyWithInfoLoggerCounter",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -3447,7 +3328,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `deny counter log warn`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithWarnLoggerCounter",
 				"config_rule_type":      "aclRuleDenyWithWarnLoggerCounter",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -3472,7 +3352,6 @@ func TestNewAclRule(t *testing.T) {
 				Action:     `deny counter log error`,
 			},
 			want: map[string]interface{}{
-				"rule_type":             "*acl.aclRuleDenyWithErrorLoggerCounter",
 				"config_rule_type":      "aclRuleDenyWithErrorLoggerCounter",
 				"comment":               "foobar barfoo",
 				"action_name":           "ruleActionDeny",
@@ -3583,7 +3462,7 @@ func TestNewAclRule(t *testing.T) {
 	}
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
-			var rule aclRule
+			var rule *aclRule
 			ctx := context.Background()
 			// t.Logf(tc.name)
 			logger := utils.NewLogger()
@@ -3597,7 +3476,6 @@ func TestNewAclRule(t *testing.T) {
 			rule = parsedACLRule
 			ruleConfig := rule.getConfig(ctx)
 			got := make(map[string]interface{})
-			got["rule_type"] = reflect.TypeOf(rule).String()
 			got["config_rule_type"] = ruleConfig.ruleType
 			got["comment"] = ruleConfig.comment
 			if ruleConfig.logLevel != "" {
@@ -3640,7 +3518,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowMatchAnyStop",
+				"rule_type": "aclRuleAllowMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing without counter and logging with continue verdict 1",
 			config: &RuleConfiguration{
@@ -3655,7 +3533,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowMatchAnyStop",
+				"rule_type": "aclRuleAllowMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing without counter and logging with continue verdict 2",
 			config: &RuleConfiguration{
@@ -3673,7 +3551,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowMatchAnyStop",
+				"rule_type":    "aclRuleAllowMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing without counter and logging with continue verdict 3",
 			config: &RuleConfiguration{
@@ -3690,7 +3568,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowMatchAnyStop",
+				"rule_type":    "aclRuleAllowMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing without counter and logging with allow stop verdict",
 			config: &RuleConfiguration{
@@ -3706,7 +3584,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowMatchAnyStop",
+				"rule_type": "aclRuleAllowMatchAnyStop",
 			},
 		}, {name: "allow all and stop processing without counter and logging with continue verdict",
 			config: &RuleConfiguration{
@@ -3722,7 +3600,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowMatchAllStop",
+				"rule_type": "aclRuleAllowMatchAllStop",
 			},
 		}, {name: "allow all and stop processing without counter and logging with continue verdict 1",
 			config: &RuleConfiguration{
@@ -3737,7 +3615,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowMatchAllStop",
+				"rule_type": "aclRuleAllowMatchAllStop",
 			},
 		}, {name: "allow all and stop processing without counter and logging with continue verdict 2",
 			config: &RuleConfiguration{
@@ -3755,7 +3633,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowMatchAllStop",
+				"rule_type":    "aclRuleAllowMatchAllStop",
 			},
 		}, {name: "allow all and stop processing without counter and logging with continue verdict 3",
 			config: &RuleConfiguration{
@@ -3772,7 +3650,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowMatchAllStop",
+				"rule_type":    "aclRuleAllowMatchAllStop",
 			},
 		}, {name: "allow all and stop processing without counter and logging with allow stop verdict",
 			config: &RuleConfiguration{
@@ -3788,7 +3666,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowMatchAllStop",
+				"rule_type": "aclRuleAllowMatchAllStop",
 			},
 		}, {name: "allow and stop processing without counter and logging with continue verdict",
 			config: &RuleConfiguration{
@@ -3801,7 +3679,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowStop",
+				"rule_type": "aclRuleAllowStop",
 			},
 		}, {name: "allow and stop processing without counter and logging with continue verdict 1",
 			config: &RuleConfiguration{
@@ -3813,7 +3691,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowStop",
+				"rule_type": "aclRuleAllowStop",
 			},
 		}, {name: "allow and stop processing without counter and logging with continue verdict 2",
 			config: &RuleConfiguration{
@@ -3828,7 +3706,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowStop",
+				"rule_type":    "aclRuleAllowStop",
 			},
 		}, {name: "allow and stop processing without counter and logging with continue verdict 3",
 			config: &RuleConfiguration{
@@ -3842,7 +3720,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowStop",
+				"rule_type":    "aclRuleAllowStop",
 			},
 		}, {name: "allow and stop processing without counter and logging with allow stop verdict",
 			config: &RuleConfiguration{
@@ -3855,7 +3733,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowStop",
+				"rule_type": "aclRuleAllowStop",
 			},
 		}, {name: "allow any without counter and logging with continue verdict",
 			config: &RuleConfiguration{
@@ -3871,7 +3749,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowMatchAny",
+				"rule_type": "aclRuleAllowMatchAny",
 			},
 		}, {name: "allow any without counter and logging with continue verdict 1",
 			config: &RuleConfiguration{
@@ -3886,7 +3764,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowMatchAny",
+				"rule_type": "aclRuleAllowMatchAny",
 			},
 		}, {name: "allow any without counter and logging with continue verdict 2",
 			config: &RuleConfiguration{
@@ -3904,7 +3782,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowMatchAny",
+				"rule_type":    "aclRuleAllowMatchAny",
 			},
 		}, {name: "allow any without counter and logging with continue verdict 3",
 			config: &RuleConfiguration{
@@ -3921,7 +3799,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowMatchAny",
+				"rule_type":    "aclRuleAllowMatchAny",
 			},
 		}, {name: "allow any without counter and logging with allow verdict",
 			config: &RuleConfiguration{
@@ -3937,7 +3815,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowMatchAny",
+				"rule_type": "aclRuleAllowMatchAny",
 			},
 		}, {name: "allow all without counter and logging with continue verdict",
 			config: &RuleConfiguration{
@@ -3953,7 +3831,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowMatchAll",
+				"rule_type": "aclRuleAllowMatchAll",
 			},
 		}, {name: "allow all without counter and logging with continue verdict 1",
 			config: &RuleConfiguration{
@@ -3968,7 +3846,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowMatchAll",
+				"rule_type": "aclRuleAllowMatchAll",
 			},
 		}, {name: "allow all without counter and logging with continue verdict 2",
 			config: &RuleConfiguration{
@@ -3986,7 +3864,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowMatchAll",
+				"rule_type":    "aclRuleAllowMatchAll",
 			},
 		}, {name: "allow all without counter and logging with continue verdict 3",
 			config: &RuleConfiguration{
@@ -4003,7 +3881,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowMatchAll",
+				"rule_type":    "aclRuleAllowMatchAll",
 			},
 		}, {name: "allow all without counter and logging with allow verdict",
 			config: &RuleConfiguration{
@@ -4019,7 +3897,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowMatchAll",
+				"rule_type": "aclRuleAllowMatchAll",
 			},
 		}, {name: "allow without counter and logging with continue verdict",
 			config: &RuleConfiguration{
@@ -4032,7 +3910,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllow",
+				"rule_type": "aclRuleAllow",
 			},
 		}, {name: "allow without counter and logging with continue verdict 1",
 			config: &RuleConfiguration{
@@ -4044,7 +3922,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllow",
+				"rule_type": "aclRuleAllow",
 			},
 		}, {name: "allow without counter and logging with continue verdict 2",
 			config: &RuleConfiguration{
@@ -4059,7 +3937,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllow",
+				"rule_type":    "aclRuleAllow",
 			},
 		}, {name: "allow without counter and logging with continue verdict 3",
 			config: &RuleConfiguration{
@@ -4073,7 +3951,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllow",
+				"rule_type":    "aclRuleAllow",
 			},
 		}, {name: "allow without counter and logging with allow verdict",
 			config: &RuleConfiguration{
@@ -4086,7 +3964,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllow",
+				"rule_type": "aclRuleAllow",
 			},
 		}, {name: "allow any and stop processing with debug logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -4102,7 +3980,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerMatchAnyStop",
+				"rule_type": "aclRuleAllowWithDebugLoggerMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with debug logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -4117,7 +3995,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerMatchAnyStop",
+				"rule_type": "aclRuleAllowWithDebugLoggerMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with debug logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -4135,7 +4013,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithDebugLoggerMatchAnyStop",
+				"rule_type":    "aclRuleAllowWithDebugLoggerMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with debug logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -4152,7 +4030,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithDebugLoggerMatchAnyStop",
+				"rule_type":    "aclRuleAllowWithDebugLoggerMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with debug logging and without counter with allow stop verdict",
 			config: &RuleConfiguration{
@@ -4168,7 +4046,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerMatchAnyStop",
+				"rule_type": "aclRuleAllowWithDebugLoggerMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with info logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -4184,7 +4062,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerMatchAnyStop",
+				"rule_type": "aclRuleAllowWithInfoLoggerMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with info logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -4199,7 +4077,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerMatchAnyStop",
+				"rule_type": "aclRuleAllowWithInfoLoggerMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with info logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -4217,7 +4095,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithInfoLoggerMatchAnyStop",
+				"rule_type":    "aclRuleAllowWithInfoLoggerMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with info logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -4234,7 +4112,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithInfoLoggerMatchAnyStop",
+				"rule_type":    "aclRuleAllowWithInfoLoggerMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with info logging and without counter with allow stop verdict",
 			config: &RuleConfiguration{
@@ -4250,7 +4128,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerMatchAnyStop",
+				"rule_type": "aclRuleAllowWithInfoLoggerMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with warn logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -4266,7 +4144,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerMatchAnyStop",
+				"rule_type": "aclRuleAllowWithWarnLoggerMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with warn logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -4281,7 +4159,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerMatchAnyStop",
+				"rule_type": "aclRuleAllowWithWarnLoggerMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with warn logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -4299,7 +4177,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithWarnLoggerMatchAnyStop",
+				"rule_type":    "aclRuleAllowWithWarnLoggerMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with warn logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -4316,7 +4194,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithWarnLoggerMatchAnyStop",
+				"rule_type":    "aclRuleAllowWithWarnLoggerMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with warn logging and without counter with allow stop verdict",
 			config: &RuleConfiguration{
@@ -4332,7 +4210,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerMatchAnyStop",
+				"rule_type": "aclRuleAllowWithWarnLoggerMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with error logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -4348,7 +4226,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerMatchAnyStop",
+				"rule_type": "aclRuleAllowWithErrorLoggerMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with error logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -4363,7 +4241,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerMatchAnyStop",
+				"rule_type": "aclRuleAllowWithErrorLoggerMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with error logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -4381,7 +4259,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithErrorLoggerMatchAnyStop",
+				"rule_type":    "aclRuleAllowWithErrorLoggerMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with error logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -4398,7 +4276,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithErrorLoggerMatchAnyStop",
+				"rule_type":    "aclRuleAllowWithErrorLoggerMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with error logging and without counter with allow stop verdict",
 			config: &RuleConfiguration{
@@ -4414,7 +4292,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerMatchAnyStop",
+				"rule_type": "aclRuleAllowWithErrorLoggerMatchAnyStop",
 			},
 		}, {name: "allow all and stop processing with debug logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -4430,7 +4308,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerMatchAllStop",
+				"rule_type": "aclRuleAllowWithDebugLoggerMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with debug logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -4445,7 +4323,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerMatchAllStop",
+				"rule_type": "aclRuleAllowWithDebugLoggerMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with debug logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -4463,7 +4341,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithDebugLoggerMatchAllStop",
+				"rule_type":    "aclRuleAllowWithDebugLoggerMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with debug logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -4480,7 +4358,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithDebugLoggerMatchAllStop",
+				"rule_type":    "aclRuleAllowWithDebugLoggerMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with debug logging and without counter with allow stop verdict",
 			config: &RuleConfiguration{
@@ -4496,7 +4374,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerMatchAllStop",
+				"rule_type": "aclRuleAllowWithDebugLoggerMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with info logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -4512,7 +4390,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerMatchAllStop",
+				"rule_type": "aclRuleAllowWithInfoLoggerMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with info logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -4527,7 +4405,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerMatchAllStop",
+				"rule_type": "aclRuleAllowWithInfoLoggerMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with info logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -4545,7 +4423,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithInfoLoggerMatchAllStop",
+				"rule_type":    "aclRuleAllowWithInfoLoggerMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with info logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -4562,7 +4440,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithInfoLoggerMatchAllStop",
+				"rule_type":    "aclRuleAllowWithInfoLoggerMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with info logging and without counter with allow stop verdict",
 			config: &RuleConfiguration{
@@ -4578,7 +4456,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerMatchAllStop",
+				"rule_type": "aclRuleAllowWithInfoLoggerMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with warn logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -4594,7 +4472,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerMatchAllStop",
+				"rule_type": "aclRuleAllowWithWarnLoggerMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with warn logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -4609,7 +4487,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerMatchAllStop",
+				"rule_type": "aclRuleAllowWithWarnLoggerMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with warn logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -4627,7 +4505,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithWarnLoggerMatchAllStop",
+				"rule_type":    "aclRuleAllowWithWarnLoggerMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with warn logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -4644,7 +4522,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithWarnLoggerMatchAllStop",
+				"rule_type":    "aclRuleAllowWithWarnLoggerMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with warn logging and without counter with allow stop verdict",
 			config: &RuleConfiguration{
@@ -4660,7 +4538,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerMatchAllStop",
+				"rule_type": "aclRuleAllowWithWarnLoggerMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with error logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -4676,7 +4554,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerMatchAllStop",
+				"rule_type": "aclRuleAllowWithErrorLoggerMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with error logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -4691,7 +4569,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerMatchAllStop",
+				"rule_type": "aclRuleAllowWithErrorLoggerMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with error logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -4709,7 +4587,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithErrorLoggerMatchAllStop",
+				"rule_type":    "aclRuleAllowWithErrorLoggerMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with error logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -4726,7 +4604,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithErrorLoggerMatchAllStop",
+				"rule_type":    "aclRuleAllowWithErrorLoggerMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with error logging and without counter with allow stop verdict",
 			config: &RuleConfiguration{
@@ -4742,7 +4620,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerMatchAllStop",
+				"rule_type": "aclRuleAllowWithErrorLoggerMatchAllStop",
 			},
 		}, {name: "allow and stop processing with debug logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -4755,7 +4633,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerStop",
+				"rule_type": "aclRuleAllowWithDebugLoggerStop",
 			},
 		}, {name: "allow and stop processing with debug logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -4767,7 +4645,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerStop",
+				"rule_type": "aclRuleAllowWithDebugLoggerStop",
 			},
 		}, {name: "allow and stop processing with debug logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -4782,7 +4660,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithDebugLoggerStop",
+				"rule_type":    "aclRuleAllowWithDebugLoggerStop",
 			},
 		}, {name: "allow and stop processing with debug logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -4796,7 +4674,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithDebugLoggerStop",
+				"rule_type":    "aclRuleAllowWithDebugLoggerStop",
 			},
 		}, {name: "allow and stop processing with debug logging and without counter with allow stop verdict",
 			config: &RuleConfiguration{
@@ -4809,7 +4687,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerStop",
+				"rule_type": "aclRuleAllowWithDebugLoggerStop",
 			},
 		}, {name: "allow and stop processing with info logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -4822,7 +4700,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerStop",
+				"rule_type": "aclRuleAllowWithInfoLoggerStop",
 			},
 		}, {name: "allow and stop processing with info logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -4834,7 +4712,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerStop",
+				"rule_type": "aclRuleAllowWithInfoLoggerStop",
 			},
 		}, {name: "allow and stop processing with info logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -4849,7 +4727,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithInfoLoggerStop",
+				"rule_type":    "aclRuleAllowWithInfoLoggerStop",
 			},
 		}, {name: "allow and stop processing with info logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -4863,7 +4741,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithInfoLoggerStop",
+				"rule_type":    "aclRuleAllowWithInfoLoggerStop",
 			},
 		}, {name: "allow and stop processing with info logging and without counter with allow stop verdict",
 			config: &RuleConfiguration{
@@ -4876,7 +4754,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerStop",
+				"rule_type": "aclRuleAllowWithInfoLoggerStop",
 			},
 		}, {name: "allow and stop processing with warn logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -4889,7 +4767,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerStop",
+				"rule_type": "aclRuleAllowWithWarnLoggerStop",
 			},
 		}, {name: "allow and stop processing with warn logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -4901,7 +4779,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerStop",
+				"rule_type": "aclRuleAllowWithWarnLoggerStop",
 			},
 		}, {name: "allow and stop processing with warn logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -4916,7 +4794,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithWarnLoggerStop",
+				"rule_type":    "aclRuleAllowWithWarnLoggerStop",
 			},
 		}, {name: "allow and stop processing with warn logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -4930,7 +4808,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithWarnLoggerStop",
+				"rule_type":    "aclRuleAllowWithWarnLoggerStop",
 			},
 		}, {name: "allow and stop processing with warn logging and without counter with allow stop verdict",
 			config: &RuleConfiguration{
@@ -4943,7 +4821,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerStop",
+				"rule_type": "aclRuleAllowWithWarnLoggerStop",
 			},
 		}, {name: "allow and stop processing with error logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -4956,7 +4834,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerStop",
+				"rule_type": "aclRuleAllowWithErrorLoggerStop",
 			},
 		}, {name: "allow and stop processing with error logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -4968,7 +4846,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerStop",
+				"rule_type": "aclRuleAllowWithErrorLoggerStop",
 			},
 		}, {name: "allow and stop processing with error logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -4983,7 +4861,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithErrorLoggerStop",
+				"rule_type":    "aclRuleAllowWithErrorLoggerStop",
 			},
 		}, {name: "allow and stop processing with error logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -4997,7 +4875,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithErrorLoggerStop",
+				"rule_type":    "aclRuleAllowWithErrorLoggerStop",
 			},
 		}, {name: "allow and stop processing with error logging and without counter with allow stop verdict",
 			config: &RuleConfiguration{
@@ -5010,7 +4888,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerStop",
+				"rule_type": "aclRuleAllowWithErrorLoggerStop",
 			},
 		}, {name: "allow any with debug logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -5026,7 +4904,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerMatchAny",
+				"rule_type": "aclRuleAllowWithDebugLoggerMatchAny",
 			},
 		}, {name: "allow any with debug logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -5041,7 +4919,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerMatchAny",
+				"rule_type": "aclRuleAllowWithDebugLoggerMatchAny",
 			},
 		}, {name: "allow any with debug logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -5059,7 +4937,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithDebugLoggerMatchAny",
+				"rule_type":    "aclRuleAllowWithDebugLoggerMatchAny",
 			},
 		}, {name: "allow any with debug logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -5076,7 +4954,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithDebugLoggerMatchAny",
+				"rule_type":    "aclRuleAllowWithDebugLoggerMatchAny",
 			},
 		}, {name: "allow any with debug logging and without counter with allow verdict",
 			config: &RuleConfiguration{
@@ -5092,7 +4970,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerMatchAny",
+				"rule_type": "aclRuleAllowWithDebugLoggerMatchAny",
 			},
 		}, {name: "allow any with info logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -5108,7 +4986,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerMatchAny",
+				"rule_type": "aclRuleAllowWithInfoLoggerMatchAny",
 			},
 		}, {name: "allow any with info logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -5123,7 +5001,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerMatchAny",
+				"rule_type": "aclRuleAllowWithInfoLoggerMatchAny",
 			},
 		}, {name: "allow any with info logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -5141,7 +5019,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithInfoLoggerMatchAny",
+				"rule_type":    "aclRuleAllowWithInfoLoggerMatchAny",
 			},
 		}, {name: "allow any with info logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -5158,7 +5036,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithInfoLoggerMatchAny",
+				"rule_type":    "aclRuleAllowWithInfoLoggerMatchAny",
 			},
 		}, {name: "allow any with info logging and without counter with allow verdict",
 			config: &RuleConfiguration{
@@ -5174,7 +5052,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerMatchAny",
+				"rule_type": "aclRuleAllowWithInfoLoggerMatchAny",
 			},
 		}, {name: "allow any with warn logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -5190,7 +5068,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerMatchAny",
+				"rule_type": "aclRuleAllowWithWarnLoggerMatchAny",
 			},
 		}, {name: "allow any with warn logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -5205,7 +5083,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerMatchAny",
+				"rule_type": "aclRuleAllowWithWarnLoggerMatchAny",
 			},
 		}, {name: "allow any with warn logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -5223,7 +5101,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithWarnLoggerMatchAny",
+				"rule_type":    "aclRuleAllowWithWarnLoggerMatchAny",
 			},
 		}, {name: "allow any with warn logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -5240,7 +5118,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithWarnLoggerMatchAny",
+				"rule_type":    "aclRuleAllowWithWarnLoggerMatchAny",
 			},
 		}, {name: "allow any with warn logging and without counter with allow verdict",
 			config: &RuleConfiguration{
@@ -5256,7 +5134,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerMatchAny",
+				"rule_type": "aclRuleAllowWithWarnLoggerMatchAny",
 			},
 		}, {name: "allow any with error logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -5272,7 +5150,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerMatchAny",
+				"rule_type": "aclRuleAllowWithErrorLoggerMatchAny",
 			},
 		}, {name: "allow any with error logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -5287,7 +5165,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerMatchAny",
+				"rule_type": "aclRuleAllowWithErrorLoggerMatchAny",
 			},
 		}, {name: "allow any with error logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -5305,7 +5183,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithErrorLoggerMatchAny",
+				"rule_type":    "aclRuleAllowWithErrorLoggerMatchAny",
 			},
 		}, {name: "allow any with error logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -5322,7 +5200,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithErrorLoggerMatchAny",
+				"rule_type":    "aclRuleAllowWithErrorLoggerMatchAny",
 			},
 		}, {name: "allow any with error logging and without counter with allow verdict",
 			config: &RuleConfiguration{
@@ -5338,7 +5216,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerMatchAny",
+				"rule_type": "aclRuleAllowWithErrorLoggerMatchAny",
 			},
 		}, {name: "allow all with debug logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -5354,7 +5232,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerMatchAll",
+				"rule_type": "aclRuleAllowWithDebugLoggerMatchAll",
 			},
 		}, {name: "allow all with debug logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -5369,7 +5247,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerMatchAll",
+				"rule_type": "aclRuleAllowWithDebugLoggerMatchAll",
 			},
 		}, {name: "allow all with debug logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -5387,7 +5265,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithDebugLoggerMatchAll",
+				"rule_type":    "aclRuleAllowWithDebugLoggerMatchAll",
 			},
 		}, {name: "allow all with debug logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -5404,7 +5282,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithDebugLoggerMatchAll",
+				"rule_type":    "aclRuleAllowWithDebugLoggerMatchAll",
 			},
 		}, {name: "allow all with debug logging and without counter with allow verdict",
 			config: &RuleConfiguration{
@@ -5420,7 +5298,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerMatchAll",
+				"rule_type": "aclRuleAllowWithDebugLoggerMatchAll",
 			},
 		}, {name: "allow all with info logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -5436,7 +5314,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerMatchAll",
+				"rule_type": "aclRuleAllowWithInfoLoggerMatchAll",
 			},
 		}, {name: "allow all with info logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -5451,7 +5329,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerMatchAll",
+				"rule_type": "aclRuleAllowWithInfoLoggerMatchAll",
 			},
 		}, {name: "allow all with info logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -5469,7 +5347,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithInfoLoggerMatchAll",
+				"rule_type":    "aclRuleAllowWithInfoLoggerMatchAll",
 			},
 		}, {name: "allow all with info logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -5486,7 +5364,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithInfoLoggerMatchAll",
+				"rule_type":    "aclRuleAllowWithInfoLoggerMatchAll",
 			},
 		}, {name: "allow all with info logging and without counter with allow verdict",
 			config: &RuleConfiguration{
@@ -5502,7 +5380,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerMatchAll",
+				"rule_type": "aclRuleAllowWithInfoLoggerMatchAll",
 			},
 		}, {name: "allow all with warn logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -5518,7 +5396,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerMatchAll",
+				"rule_type": "aclRuleAllowWithWarnLoggerMatchAll",
 			},
 		}, {name: "allow all with warn logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -5533,7 +5411,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerMatchAll",
+				"rule_type": "aclRuleAllowWithWarnLoggerMatchAll",
 			},
 		}, {name: "allow all with warn logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -5551,7 +5429,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithWarnLoggerMatchAll",
+				"rule_type":    "aclRuleAllowWithWarnLoggerMatchAll",
 			},
 		}, {name: "allow all with warn logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -5568,7 +5446,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithWarnLoggerMatchAll",
+				"rule_type":    "aclRuleAllowWithWarnLoggerMatchAll",
 			},
 		}, {name: "allow all with warn logging and without counter with allow verdict",
 			config: &RuleConfiguration{
@@ -5584,7 +5462,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerMatchAll",
+				"rule_type": "aclRuleAllowWithWarnLoggerMatchAll",
 			},
 		}, {name: "allow all with error logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -5600,7 +5478,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerMatchAll",
+				"rule_type": "aclRuleAllowWithErrorLoggerMatchAll",
 			},
 		}, {name: "allow all with error logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -5615,7 +5493,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerMatchAll",
+				"rule_type": "aclRuleAllowWithErrorLoggerMatchAll",
 			},
 		}, {name: "allow all with error logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -5633,7 +5511,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithErrorLoggerMatchAll",
+				"rule_type":    "aclRuleAllowWithErrorLoggerMatchAll",
 			},
 		}, {name: "allow all with error logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -5650,7 +5528,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithErrorLoggerMatchAll",
+				"rule_type":    "aclRuleAllowWithErrorLoggerMatchAll",
 			},
 		}, {name: "allow all with error logging and without counter with allow verdict",
 			config: &RuleConfiguration{
@@ -5666,7 +5544,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerMatchAll",
+				"rule_type": "aclRuleAllowWithErrorLoggerMatchAll",
 			},
 		}, {name: "allow with debug logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -5679,7 +5557,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithDebugLogger",
+				"rule_type": "aclRuleAllowWithDebugLogger",
 			},
 		}, {name: "allow with debug logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -5691,7 +5569,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithDebugLogger",
+				"rule_type": "aclRuleAllowWithDebugLogger",
 			},
 		}, {name: "allow with debug logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -5706,7 +5584,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithDebugLogger",
+				"rule_type":    "aclRuleAllowWithDebugLogger",
 			},
 		}, {name: "allow with debug logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -5720,7 +5598,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithDebugLogger",
+				"rule_type":    "aclRuleAllowWithDebugLogger",
 			},
 		}, {name: "allow with debug logging and without counter with allow verdict",
 			config: &RuleConfiguration{
@@ -5733,7 +5611,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowWithDebugLogger",
+				"rule_type": "aclRuleAllowWithDebugLogger",
 			},
 		}, {name: "allow with info logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -5746,7 +5624,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithInfoLogger",
+				"rule_type": "aclRuleAllowWithInfoLogger",
 			},
 		}, {name: "allow with info logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -5758,7 +5636,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithInfoLogger",
+				"rule_type": "aclRuleAllowWithInfoLogger",
 			},
 		}, {name: "allow with info logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -5773,7 +5651,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithInfoLogger",
+				"rule_type":    "aclRuleAllowWithInfoLogger",
 			},
 		}, {name: "allow with info logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -5787,7 +5665,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithInfoLogger",
+				"rule_type":    "aclRuleAllowWithInfoLogger",
 			},
 		}, {name: "allow with info logging and without counter with allow verdict",
 			config: &RuleConfiguration{
@@ -5800,7 +5678,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowWithInfoLogger",
+				"rule_type": "aclRuleAllowWithInfoLogger",
 			},
 		}, {name: "allow with warn logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -5813,7 +5691,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithWarnLogger",
+				"rule_type": "aclRuleAllowWithWarnLogger",
 			},
 		}, {name: "allow with warn logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -5825,7 +5703,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithWarnLogger",
+				"rule_type": "aclRuleAllowWithWarnLogger",
 			},
 		}, {name: "allow with warn logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -5840,7 +5718,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithWarnLogger",
+				"rule_type":    "aclRuleAllowWithWarnLogger",
 			},
 		}, {name: "allow with warn logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -5854,7 +5732,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithWarnLogger",
+				"rule_type":    "aclRuleAllowWithWarnLogger",
 			},
 		}, {name: "allow with warn logging and without counter with allow verdict",
 			config: &RuleConfiguration{
@@ -5867,7 +5745,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowWithWarnLogger",
+				"rule_type": "aclRuleAllowWithWarnLogger",
 			},
 		}, {name: "allow with error logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -5880,7 +5758,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithErrorLogger",
+				"rule_type": "aclRuleAllowWithErrorLogger",
 			},
 		}, {name: "allow with error logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -5892,7 +5770,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithErrorLogger",
+				"rule_type": "aclRuleAllowWithErrorLogger",
 			},
 		}, {name: "allow with error logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -5907,7 +5785,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithErrorLogger",
+				"rule_type":    "aclRuleAllowWithErrorLogger",
 			},
 		}, {name: "allow with error logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -5921,7 +5799,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithErrorLogger",
+				"rule_type":    "aclRuleAllowWithErrorLogger",
 			},
 		}, {name: "allow with error logging and without counter with allow verdict",
 			config: &RuleConfiguration{
@@ -5934,7 +5812,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowWithErrorLogger",
+				"rule_type": "aclRuleAllowWithErrorLogger",
 			},
 		}, {name: "allow any and stop processing with counter and without logging with continue verdict",
 			config: &RuleConfiguration{
@@ -5950,7 +5828,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithCounterMatchAnyStop",
+				"rule_type": "aclRuleAllowWithCounterMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with counter and without logging with continue verdict 1",
 			config: &RuleConfiguration{
@@ -5965,7 +5843,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithCounterMatchAnyStop",
+				"rule_type": "aclRuleAllowWithCounterMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with counter and without logging with continue verdict 2",
 			config: &RuleConfiguration{
@@ -5983,7 +5861,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithCounterMatchAnyStop",
+				"rule_type":    "aclRuleAllowWithCounterMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with counter and without logging with continue verdict 3",
 			config: &RuleConfiguration{
@@ -6000,7 +5878,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithCounterMatchAnyStop",
+				"rule_type":    "aclRuleAllowWithCounterMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with counter and without logging with allow stop verdict",
 			config: &RuleConfiguration{
@@ -6016,7 +5894,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowWithCounterMatchAnyStop",
+				"rule_type": "aclRuleAllowWithCounterMatchAnyStop",
 			},
 		}, {name: "allow all and stop processing with counter and without logging with continue verdict",
 			config: &RuleConfiguration{
@@ -6032,7 +5910,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithCounterMatchAllStop",
+				"rule_type": "aclRuleAllowWithCounterMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with counter and without logging with continue verdict 1",
 			config: &RuleConfiguration{
@@ -6047,7 +5925,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithCounterMatchAllStop",
+				"rule_type": "aclRuleAllowWithCounterMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with counter and without logging with continue verdict 2",
 			config: &RuleConfiguration{
@@ -6065,7 +5943,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithCounterMatchAllStop",
+				"rule_type":    "aclRuleAllowWithCounterMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with counter and without logging with continue verdict 3",
 			config: &RuleConfiguration{
@@ -6082,7 +5960,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithCounterMatchAllStop",
+				"rule_type":    "aclRuleAllowWithCounterMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with counter and without logging with allow stop verdict",
 			config: &RuleConfiguration{
@@ -6098,7 +5976,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowWithCounterMatchAllStop",
+				"rule_type": "aclRuleAllowWithCounterMatchAllStop",
 			},
 		}, {name: "allow and stop processing with counter and without logging with continue verdict",
 			config: &RuleConfiguration{
@@ -6111,7 +5989,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithCounterStop",
+				"rule_type": "aclRuleAllowWithCounterStop",
 			},
 		}, {name: "allow and stop processing with counter and without logging with continue verdict 1",
 			config: &RuleConfiguration{
@@ -6123,7 +6001,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithCounterStop",
+				"rule_type": "aclRuleAllowWithCounterStop",
 			},
 		}, {name: "allow and stop processing with counter and without logging with continue verdict 2",
 			config: &RuleConfiguration{
@@ -6138,7 +6016,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithCounterStop",
+				"rule_type":    "aclRuleAllowWithCounterStop",
 			},
 		}, {name: "allow and stop processing with counter and without logging with continue verdict 3",
 			config: &RuleConfiguration{
@@ -6152,7 +6030,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithCounterStop",
+				"rule_type":    "aclRuleAllowWithCounterStop",
 			},
 		}, {name: "allow and stop processing with counter and without logging with allow stop verdict",
 			config: &RuleConfiguration{
@@ -6165,7 +6043,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowWithCounterStop",
+				"rule_type": "aclRuleAllowWithCounterStop",
 			},
 		}, {name: "allow any with counter and without logging with continue verdict",
 			config: &RuleConfiguration{
@@ -6181,7 +6059,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithCounterMatchAny",
+				"rule_type": "aclRuleAllowWithCounterMatchAny",
 			},
 		}, {name: "allow any with counter and without logging with continue verdict 1",
 			config: &RuleConfiguration{
@@ -6196,7 +6074,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithCounterMatchAny",
+				"rule_type": "aclRuleAllowWithCounterMatchAny",
 			},
 		}, {name: "allow any with counter and without logging with continue verdict 2",
 			config: &RuleConfiguration{
@@ -6214,7 +6092,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithCounterMatchAny",
+				"rule_type":    "aclRuleAllowWithCounterMatchAny",
 			},
 		}, {name: "allow any with counter and without logging with continue verdict 3",
 			config: &RuleConfiguration{
@@ -6231,7 +6109,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithCounterMatchAny",
+				"rule_type":    "aclRuleAllowWithCounterMatchAny",
 			},
 		}, {name: "allow any with counter and without logging with allow verdict",
 			config: &RuleConfiguration{
@@ -6247,7 +6125,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowWithCounterMatchAny",
+				"rule_type": "aclRuleAllowWithCounterMatchAny",
 			},
 		}, {name: "allow all with counter and without logging with continue verdict",
 			config: &RuleConfiguration{
@@ -6263,7 +6141,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithCounterMatchAll",
+				"rule_type": "aclRuleAllowWithCounterMatchAll",
 			},
 		}, {name: "allow all with counter and without logging with continue verdict 1",
 			config: &RuleConfiguration{
@@ -6278,7 +6156,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithCounterMatchAll",
+				"rule_type": "aclRuleAllowWithCounterMatchAll",
 			},
 		}, {name: "allow all with counter and without logging with continue verdict 2",
 			config: &RuleConfiguration{
@@ -6296,7 +6174,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithCounterMatchAll",
+				"rule_type":    "aclRuleAllowWithCounterMatchAll",
 			},
 		}, {name: "allow all with counter and without logging with continue verdict 3",
 			config: &RuleConfiguration{
@@ -6313,7 +6191,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithCounterMatchAll",
+				"rule_type":    "aclRuleAllowWithCounterMatchAll",
 			},
 		}, {name: "allow all with counter and without logging with allow verdict",
 			config: &RuleConfiguration{
@@ -6329,7 +6207,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowWithCounterMatchAll",
+				"rule_type": "aclRuleAllowWithCounterMatchAll",
 			},
 		}, {name: "allow with counter and without logging with continue verdict",
 			config: &RuleConfiguration{
@@ -6342,7 +6220,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithCounter",
+				"rule_type": "aclRuleAllowWithCounter",
 			},
 		}, {name: "allow with counter and without logging with continue verdict 1",
 			config: &RuleConfiguration{
@@ -6354,7 +6232,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithCounter",
+				"rule_type": "aclRuleAllowWithCounter",
 			},
 		}, {name: "allow with counter and without logging with continue verdict 2",
 			config: &RuleConfiguration{
@@ -6369,7 +6247,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithCounter",
+				"rule_type":    "aclRuleAllowWithCounter",
 			},
 		}, {name: "allow with counter and without logging with continue verdict 3",
 			config: &RuleConfiguration{
@@ -6383,7 +6261,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithCounter",
+				"rule_type":    "aclRuleAllowWithCounter",
 			},
 		}, {name: "allow with counter and without logging with allow verdict",
 			config: &RuleConfiguration{
@@ -6396,7 +6274,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowWithCounter",
+				"rule_type": "aclRuleAllowWithCounter",
 			},
 		}, {name: "allow any and stop processing with debug logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -6412,7 +6290,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerCounterMatchAnyStop",
+				"rule_type": "aclRuleAllowWithDebugLoggerCounterMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with debug logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -6427,7 +6305,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerCounterMatchAnyStop",
+				"rule_type": "aclRuleAllowWithDebugLoggerCounterMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with debug logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -6445,7 +6323,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithDebugLoggerCounterMatchAnyStop",
+				"rule_type":    "aclRuleAllowWithDebugLoggerCounterMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with debug logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -6462,7 +6340,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithDebugLoggerCounterMatchAnyStop",
+				"rule_type":    "aclRuleAllowWithDebugLoggerCounterMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with debug logging and with counter with allow stop verdict",
 			config: &RuleConfiguration{
@@ -6478,7 +6356,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerCounterMatchAnyStop",
+				"rule_type": "aclRuleAllowWithDebugLoggerCounterMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with info logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -6494,7 +6372,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerCounterMatchAnyStop",
+				"rule_type": "aclRuleAllowWithInfoLoggerCounterMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with info logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -6509,7 +6387,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerCounterMatchAnyStop",
+				"rule_type": "aclRuleAllowWithInfoLoggerCounterMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with info logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -6527,7 +6405,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithInfoLoggerCounterMatchAnyStop",
+				"rule_type":    "aclRuleAllowWithInfoLoggerCounterMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with info logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -6544,7 +6422,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithInfoLoggerCounterMatchAnyStop",
+				"rule_type":    "aclRuleAllowWithInfoLoggerCounterMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with info logging and with counter with allow stop verdict",
 			config: &RuleConfiguration{
@@ -6560,7 +6438,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerCounterMatchAnyStop",
+				"rule_type": "aclRuleAllowWithInfoLoggerCounterMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with warn logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -6576,7 +6454,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerCounterMatchAnyStop",
+				"rule_type": "aclRuleAllowWithWarnLoggerCounterMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with warn logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -6591,7 +6469,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerCounterMatchAnyStop",
+				"rule_type": "aclRuleAllowWithWarnLoggerCounterMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with warn logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -6609,7 +6487,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithWarnLoggerCounterMatchAnyStop",
+				"rule_type":    "aclRuleAllowWithWarnLoggerCounterMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with warn logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -6626,7 +6504,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithWarnLoggerCounterMatchAnyStop",
+				"rule_type":    "aclRuleAllowWithWarnLoggerCounterMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with warn logging and with counter with allow stop verdict",
 			config: &RuleConfiguration{
@@ -6642,7 +6520,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerCounterMatchAnyStop",
+				"rule_type": "aclRuleAllowWithWarnLoggerCounterMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with error logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -6658,7 +6536,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerCounterMatchAnyStop",
+				"rule_type": "aclRuleAllowWithErrorLoggerCounterMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with error logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -6673,7 +6551,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerCounterMatchAnyStop",
+				"rule_type": "aclRuleAllowWithErrorLoggerCounterMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with error logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -6691,7 +6569,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithErrorLoggerCounterMatchAnyStop",
+				"rule_type":    "aclRuleAllowWithErrorLoggerCounterMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with error logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -6708,7 +6586,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithErrorLoggerCounterMatchAnyStop",
+				"rule_type":    "aclRuleAllowWithErrorLoggerCounterMatchAnyStop",
 			},
 		}, {name: "allow any and stop processing with error logging and with counter with allow stop verdict",
 			config: &RuleConfiguration{
@@ -6724,7 +6602,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerCounterMatchAnyStop",
+				"rule_type": "aclRuleAllowWithErrorLoggerCounterMatchAnyStop",
 			},
 		}, {name: "allow all and stop processing with debug logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -6740,7 +6618,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerCounterMatchAllStop",
+				"rule_type": "aclRuleAllowWithDebugLoggerCounterMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with debug logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -6755,7 +6633,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerCounterMatchAllStop",
+				"rule_type": "aclRuleAllowWithDebugLoggerCounterMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with debug logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -6773,7 +6651,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithDebugLoggerCounterMatchAllStop",
+				"rule_type":    "aclRuleAllowWithDebugLoggerCounterMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with debug logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -6790,7 +6668,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithDebugLoggerCounterMatchAllStop",
+				"rule_type":    "aclRuleAllowWithDebugLoggerCounterMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with debug logging and with counter with allow stop verdict",
 			config: &RuleConfiguration{
@@ -6806,7 +6684,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerCounterMatchAllStop",
+				"rule_type": "aclRuleAllowWithDebugLoggerCounterMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with info logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -6822,7 +6700,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerCounterMatchAllStop",
+				"rule_type": "aclRuleAllowWithInfoLoggerCounterMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with info logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -6837,7 +6715,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerCounterMatchAllStop",
+				"rule_type": "aclRuleAllowWithInfoLoggerCounterMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with info logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -6855,7 +6733,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithInfoLoggerCounterMatchAllStop",
+				"rule_type":    "aclRuleAllowWithInfoLoggerCounterMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with info logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -6872,7 +6750,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithInfoLoggerCounterMatchAllStop",
+				"rule_type":    "aclRuleAllowWithInfoLoggerCounterMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with info logging and with counter with allow stop verdict",
 			config: &RuleConfiguration{
@@ -6888,7 +6766,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerCounterMatchAllStop",
+				"rule_type": "aclRuleAllowWithInfoLoggerCounterMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with warn logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -6904,7 +6782,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerCounterMatchAllStop",
+				"rule_type": "aclRuleAllowWithWarnLoggerCounterMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with warn logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -6919,7 +6797,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerCounterMatchAllStop",
+				"rule_type": "aclRuleAllowWithWarnLoggerCounterMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with warn logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -6937,7 +6815,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithWarnLoggerCounterMatchAllStop",
+				"rule_type":    "aclRuleAllowWithWarnLoggerCounterMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with warn logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -6954,7 +6832,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithWarnLoggerCounterMatchAllStop",
+				"rule_type":    "aclRuleAllowWithWarnLoggerCounterMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with warn logging and with counter with allow stop verdict",
 			config: &RuleConfiguration{
@@ -6970,7 +6848,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerCounterMatchAllStop",
+				"rule_type": "aclRuleAllowWithWarnLoggerCounterMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with error logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -6986,7 +6864,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerCounterMatchAllStop",
+				"rule_type": "aclRuleAllowWithErrorLoggerCounterMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with error logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -7001,7 +6879,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerCounterMatchAllStop",
+				"rule_type": "aclRuleAllowWithErrorLoggerCounterMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with error logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -7019,7 +6897,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithErrorLoggerCounterMatchAllStop",
+				"rule_type":    "aclRuleAllowWithErrorLoggerCounterMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with error logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -7036,7 +6914,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithErrorLoggerCounterMatchAllStop",
+				"rule_type":    "aclRuleAllowWithErrorLoggerCounterMatchAllStop",
 			},
 		}, {name: "allow all and stop processing with error logging and with counter with allow stop verdict",
 			config: &RuleConfiguration{
@@ -7052,7 +6930,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerCounterMatchAllStop",
+				"rule_type": "aclRuleAllowWithErrorLoggerCounterMatchAllStop",
 			},
 		}, {name: "allow and stop processing with debug logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -7065,7 +6943,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerCounterStop",
+				"rule_type": "aclRuleAllowWithDebugLoggerCounterStop",
 			},
 		}, {name: "allow and stop processing with debug logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -7077,7 +6955,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerCounterStop",
+				"rule_type": "aclRuleAllowWithDebugLoggerCounterStop",
 			},
 		}, {name: "allow and stop processing with debug logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -7092,7 +6970,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithDebugLoggerCounterStop",
+				"rule_type":    "aclRuleAllowWithDebugLoggerCounterStop",
 			},
 		}, {name: "allow and stop processing with debug logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -7106,7 +6984,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithDebugLoggerCounterStop",
+				"rule_type":    "aclRuleAllowWithDebugLoggerCounterStop",
 			},
 		}, {name: "allow and stop processing with debug logging and with counter with allow stop verdict",
 			config: &RuleConfiguration{
@@ -7119,7 +6997,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerCounterStop",
+				"rule_type": "aclRuleAllowWithDebugLoggerCounterStop",
 			},
 		}, {name: "allow and stop processing with info logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -7132,7 +7010,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerCounterStop",
+				"rule_type": "aclRuleAllowWithInfoLoggerCounterStop",
 			},
 		}, {name: "allow and stop processing with info logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -7144,7 +7022,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerCounterStop",
+				"rule_type": "aclRuleAllowWithInfoLoggerCounterStop",
 			},
 		}, {name: "allow and stop processing with info logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -7159,7 +7037,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithInfoLoggerCounterStop",
+				"rule_type":    "aclRuleAllowWithInfoLoggerCounterStop",
 			},
 		}, {name: "allow and stop processing with info logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -7173,7 +7051,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithInfoLoggerCounterStop",
+				"rule_type":    "aclRuleAllowWithInfoLoggerCounterStop",
 			},
 		}, {name: "allow and stop processing with info logging and with counter with allow stop verdict",
 			config: &RuleConfiguration{
@@ -7186,7 +7064,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerCounterStop",
+				"rule_type": "aclRuleAllowWithInfoLoggerCounterStop",
 			},
 		}, {name: "allow and stop processing with warn logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -7199,7 +7077,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerCounterStop",
+				"rule_type": "aclRuleAllowWithWarnLoggerCounterStop",
 			},
 		}, {name: "allow and stop processing with warn logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -7211,7 +7089,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerCounterStop",
+				"rule_type": "aclRuleAllowWithWarnLoggerCounterStop",
 			},
 		}, {name: "allow and stop processing with warn logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -7226,7 +7104,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithWarnLoggerCounterStop",
+				"rule_type":    "aclRuleAllowWithWarnLoggerCounterStop",
 			},
 		}, {name: "allow and stop processing with warn logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -7240,7 +7118,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithWarnLoggerCounterStop",
+				"rule_type":    "aclRuleAllowWithWarnLoggerCounterStop",
 			},
 		}, {name: "allow and stop processing with warn logging and with counter with allow stop verdict",
 			config: &RuleConfiguration{
@@ -7253,7 +7131,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerCounterStop",
+				"rule_type": "aclRuleAllowWithWarnLoggerCounterStop",
 			},
 		}, {name: "allow and stop processing with error logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -7266,7 +7144,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerCounterStop",
+				"rule_type": "aclRuleAllowWithErrorLoggerCounterStop",
 			},
 		}, {name: "allow and stop processing with error logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -7278,7 +7156,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerCounterStop",
+				"rule_type": "aclRuleAllowWithErrorLoggerCounterStop",
 			},
 		}, {name: "allow and stop processing with error logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -7293,7 +7171,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithErrorLoggerCounterStop",
+				"rule_type":    "aclRuleAllowWithErrorLoggerCounterStop",
 			},
 		}, {name: "allow and stop processing with error logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -7307,7 +7185,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithErrorLoggerCounterStop",
+				"rule_type":    "aclRuleAllowWithErrorLoggerCounterStop",
 			},
 		}, {name: "allow and stop processing with error logging and with counter with allow stop verdict",
 			config: &RuleConfiguration{
@@ -7320,7 +7198,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllowStop),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerCounterStop",
+				"rule_type": "aclRuleAllowWithErrorLoggerCounterStop",
 			},
 		}, {name: "allow any with debug logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -7336,7 +7214,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerCounterMatchAny",
+				"rule_type": "aclRuleAllowWithDebugLoggerCounterMatchAny",
 			},
 		}, {name: "allow any with debug logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -7351,7 +7229,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerCounterMatchAny",
+				"rule_type": "aclRuleAllowWithDebugLoggerCounterMatchAny",
 			},
 		}, {name: "allow any with debug logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -7369,7 +7247,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithDebugLoggerCounterMatchAny",
+				"rule_type":    "aclRuleAllowWithDebugLoggerCounterMatchAny",
 			},
 		}, {name: "allow any with debug logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -7386,7 +7264,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithDebugLoggerCounterMatchAny",
+				"rule_type":    "aclRuleAllowWithDebugLoggerCounterMatchAny",
 			},
 		}, {name: "allow any with debug logging and with counter with allow verdict",
 			config: &RuleConfiguration{
@@ -7402,7 +7280,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerCounterMatchAny",
+				"rule_type": "aclRuleAllowWithDebugLoggerCounterMatchAny",
 			},
 		}, {name: "allow any with info logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -7418,7 +7296,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerCounterMatchAny",
+				"rule_type": "aclRuleAllowWithInfoLoggerCounterMatchAny",
 			},
 		}, {name: "allow any with info logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -7433,7 +7311,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerCounterMatchAny",
+				"rule_type": "aclRuleAllowWithInfoLoggerCounterMatchAny",
 			},
 		}, {name: "allow any with info logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -7451,7 +7329,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithInfoLoggerCounterMatchAny",
+				"rule_type":    "aclRuleAllowWithInfoLoggerCounterMatchAny",
 			},
 		}, {name: "allow any with info logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -7468,7 +7346,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithInfoLoggerCounterMatchAny",
+				"rule_type":    "aclRuleAllowWithInfoLoggerCounterMatchAny",
 			},
 		}, {name: "allow any with info logging and with counter with allow verdict",
 			config: &RuleConfiguration{
@@ -7484,7 +7362,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerCounterMatchAny",
+				"rule_type": "aclRuleAllowWithInfoLoggerCounterMatchAny",
 			},
 		}, {name: "allow any with warn logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -7500,7 +7378,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerCounterMatchAny",
+				"rule_type": "aclRuleAllowWithWarnLoggerCounterMatchAny",
 			},
 		}, {name: "allow any with warn logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -7515,7 +7393,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerCounterMatchAny",
+				"rule_type": "aclRuleAllowWithWarnLoggerCounterMatchAny",
 			},
 		}, {name: "allow any with warn logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -7533,7 +7411,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithWarnLoggerCounterMatchAny",
+				"rule_type":    "aclRuleAllowWithWarnLoggerCounterMatchAny",
 			},
 		}, {name: "allow any with warn logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -7550,7 +7428,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithWarnLoggerCounterMatchAny",
+				"rule_type":    "aclRuleAllowWithWarnLoggerCounterMatchAny",
 			},
 		}, {name: "allow any with warn logging and with counter with allow verdict",
 			config: &RuleConfiguration{
@@ -7566,7 +7444,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerCounterMatchAny",
+				"rule_type": "aclRuleAllowWithWarnLoggerCounterMatchAny",
 			},
 		}, {name: "allow any with error logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -7582,7 +7460,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerCounterMatchAny",
+				"rule_type": "aclRuleAllowWithErrorLoggerCounterMatchAny",
 			},
 		}, {name: "allow any with error logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -7597,7 +7475,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerCounterMatchAny",
+				"rule_type": "aclRuleAllowWithErrorLoggerCounterMatchAny",
 			},
 		}, {name: "allow any with error logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -7615,7 +7493,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithErrorLoggerCounterMatchAny",
+				"rule_type":    "aclRuleAllowWithErrorLoggerCounterMatchAny",
 			},
 		}, {name: "allow any with error logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -7632,7 +7510,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithErrorLoggerCounterMatchAny",
+				"rule_type":    "aclRuleAllowWithErrorLoggerCounterMatchAny",
 			},
 		}, {name: "allow any with error logging and with counter with allow verdict",
 			config: &RuleConfiguration{
@@ -7648,7 +7526,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerCounterMatchAny",
+				"rule_type": "aclRuleAllowWithErrorLoggerCounterMatchAny",
 			},
 		}, {name: "allow all with debug logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -7664,7 +7542,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerCounterMatchAll",
+				"rule_type": "aclRuleAllowWithDebugLoggerCounterMatchAll",
 			},
 		}, {name: "allow all with debug logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -7679,7 +7557,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerCounterMatchAll",
+				"rule_type": "aclRuleAllowWithDebugLoggerCounterMatchAll",
 			},
 		}, {name: "allow all with debug logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -7697,7 +7575,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithDebugLoggerCounterMatchAll",
+				"rule_type":    "aclRuleAllowWithDebugLoggerCounterMatchAll",
 			},
 		}, {name: "allow all with debug logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -7714,7 +7592,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithDebugLoggerCounterMatchAll",
+				"rule_type":    "aclRuleAllowWithDebugLoggerCounterMatchAll",
 			},
 		}, {name: "allow all with debug logging and with counter with allow verdict",
 			config: &RuleConfiguration{
@@ -7730,7 +7608,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerCounterMatchAll",
+				"rule_type": "aclRuleAllowWithDebugLoggerCounterMatchAll",
 			},
 		}, {name: "allow all with info logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -7746,7 +7624,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerCounterMatchAll",
+				"rule_type": "aclRuleAllowWithInfoLoggerCounterMatchAll",
 			},
 		}, {name: "allow all with info logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -7761,7 +7639,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerCounterMatchAll",
+				"rule_type": "aclRuleAllowWithInfoLoggerCounterMatchAll",
 			},
 		}, {name: "allow all with info logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -7779,7 +7657,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithInfoLoggerCounterMatchAll",
+				"rule_type":    "aclRuleAllowWithInfoLoggerCounterMatchAll",
 			},
 		}, {name: "allow all with info logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -7796,7 +7674,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithInfoLoggerCounterMatchAll",
+				"rule_type":    "aclRuleAllowWithInfoLoggerCounterMatchAll",
 			},
 		}, {name: "allow all with info logging and with counter with allow verdict",
 			config: &RuleConfiguration{
@@ -7812,7 +7690,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerCounterMatchAll",
+				"rule_type": "aclRuleAllowWithInfoLoggerCounterMatchAll",
 			},
 		}, {name: "allow all with warn logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -7828,7 +7706,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerCounterMatchAll",
+				"rule_type": "aclRuleAllowWithWarnLoggerCounterMatchAll",
 			},
 		}, {name: "allow all with warn logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -7843,7 +7721,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerCounterMatchAll",
+				"rule_type": "aclRuleAllowWithWarnLoggerCounterMatchAll",
 			},
 		}, {name: "allow all with warn logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -7861,7 +7739,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithWarnLoggerCounterMatchAll",
+				"rule_type":    "aclRuleAllowWithWarnLoggerCounterMatchAll",
 			},
 		}, {name: "allow all with warn logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -7878,7 +7756,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithWarnLoggerCounterMatchAll",
+				"rule_type":    "aclRuleAllowWithWarnLoggerCounterMatchAll",
 			},
 		}, {name: "allow all with warn logging and with counter with allow verdict",
 			config: &RuleConfiguration{
@@ -7894,7 +7772,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerCounterMatchAll",
+				"rule_type": "aclRuleAllowWithWarnLoggerCounterMatchAll",
 			},
 		}, {name: "allow all with error logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -7910,7 +7788,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerCounterMatchAll",
+				"rule_type": "aclRuleAllowWithErrorLoggerCounterMatchAll",
 			},
 		}, {name: "allow all with error logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -7925,7 +7803,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerCounterMatchAll",
+				"rule_type": "aclRuleAllowWithErrorLoggerCounterMatchAll",
 			},
 		}, {name: "allow all with error logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -7943,7 +7821,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithErrorLoggerCounterMatchAll",
+				"rule_type":    "aclRuleAllowWithErrorLoggerCounterMatchAll",
 			},
 		}, {name: "allow all with error logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -7960,7 +7838,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithErrorLoggerCounterMatchAll",
+				"rule_type":    "aclRuleAllowWithErrorLoggerCounterMatchAll",
 			},
 		}, {name: "allow all with error logging and with counter with allow verdict",
 			config: &RuleConfiguration{
@@ -7976,7 +7854,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerCounterMatchAll",
+				"rule_type": "aclRuleAllowWithErrorLoggerCounterMatchAll",
 			},
 		}, {name: "allow with debug logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -7989,7 +7867,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerCounter",
+				"rule_type": "aclRuleAllowWithDebugLoggerCounter",
 			},
 		}, {name: "allow with debug logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -8001,7 +7879,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerCounter",
+				"rule_type": "aclRuleAllowWithDebugLoggerCounter",
 			},
 		}, {name: "allow with debug logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -8016,7 +7894,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithDebugLoggerCounter",
+				"rule_type":    "aclRuleAllowWithDebugLoggerCounter",
 			},
 		}, {name: "allow with debug logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -8030,7 +7908,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithDebugLoggerCounter",
+				"rule_type":    "aclRuleAllowWithDebugLoggerCounter",
 			},
 		}, {name: "allow with debug logging and with counter with allow verdict",
 			config: &RuleConfiguration{
@@ -8043,7 +7921,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowWithDebugLoggerCounter",
+				"rule_type": "aclRuleAllowWithDebugLoggerCounter",
 			},
 		}, {name: "allow with info logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -8056,7 +7934,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerCounter",
+				"rule_type": "aclRuleAllowWithInfoLoggerCounter",
 			},
 		}, {name: "allow with info logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -8068,7 +7946,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerCounter",
+				"rule_type": "aclRuleAllowWithInfoLoggerCounter",
 			},
 		}, {name: "allow with info logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -8083,7 +7961,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithInfoLoggerCounter",
+				"rule_type":    "aclRuleAllowWithInfoLoggerCounter",
 			},
 		}, {name: "allow with info logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -8097,7 +7975,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithInfoLoggerCounter",
+				"rule_type":    "aclRuleAllowWithInfoLoggerCounter",
 			},
 		}, {name: "allow with info logging and with counter with allow verdict",
 			config: &RuleConfiguration{
@@ -8110,7 +7988,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowWithInfoLoggerCounter",
+				"rule_type": "aclRuleAllowWithInfoLoggerCounter",
 			},
 		}, {name: "allow with warn logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -8123,7 +8001,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerCounter",
+				"rule_type": "aclRuleAllowWithWarnLoggerCounter",
 			},
 		}, {name: "allow with warn logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -8135,7 +8013,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerCounter",
+				"rule_type": "aclRuleAllowWithWarnLoggerCounter",
 			},
 		}, {name: "allow with warn logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -8150,7 +8028,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithWarnLoggerCounter",
+				"rule_type":    "aclRuleAllowWithWarnLoggerCounter",
 			},
 		}, {name: "allow with warn logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -8164,7 +8042,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithWarnLoggerCounter",
+				"rule_type":    "aclRuleAllowWithWarnLoggerCounter",
 			},
 		}, {name: "allow with warn logging and with counter with allow verdict",
 			config: &RuleConfiguration{
@@ -8177,7 +8055,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowWithWarnLoggerCounter",
+				"rule_type": "aclRuleAllowWithWarnLoggerCounter",
 			},
 		}, {name: "allow with error logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -8190,7 +8068,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerCounter",
+				"rule_type": "aclRuleAllowWithErrorLoggerCounter",
 			},
 		}, {name: "allow with error logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -8202,7 +8080,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerCounter",
+				"rule_type": "aclRuleAllowWithErrorLoggerCounter",
 			},
 		}, {name: "allow with error logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -8217,7 +8095,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithErrorLoggerCounter",
+				"rule_type":    "aclRuleAllowWithErrorLoggerCounter",
 			},
 		}, {name: "allow with error logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -8231,7 +8109,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleAllowWithErrorLoggerCounter",
+				"rule_type":    "aclRuleAllowWithErrorLoggerCounter",
 			},
 		}, {name: "allow with error logging and with counter with allow verdict",
 			config: &RuleConfiguration{
@@ -8244,7 +8122,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictAllow),
-				"rule_type": "*acl.aclRuleAllowWithErrorLoggerCounter",
+				"rule_type": "aclRuleAllowWithErrorLoggerCounter",
 			},
 		}, {name: "deny any and stop processing without counter and logging with deny stop verdict",
 			config: &RuleConfiguration{
@@ -8259,7 +8137,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyMatchAnyStop",
+				"rule_type": "aclRuleDenyMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing without counter and logging with continue verdict",
 			config: &RuleConfiguration{
@@ -8275,7 +8153,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyMatchAnyStop",
+				"rule_type": "aclRuleDenyMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing without counter and logging with continue verdict 1",
 			config: &RuleConfiguration{
@@ -8290,7 +8168,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyMatchAnyStop",
+				"rule_type": "aclRuleDenyMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing without counter and logging with continue verdict 2",
 			config: &RuleConfiguration{
@@ -8308,7 +8186,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyMatchAnyStop",
+				"rule_type":    "aclRuleDenyMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing without counter and logging with continue verdict 3",
 			config: &RuleConfiguration{
@@ -8325,7 +8203,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyMatchAnyStop",
+				"rule_type":    "aclRuleDenyMatchAnyStop",
 			},
 		}, {name: "deny all and stop processing without counter and logging with deny stop verdict",
 			config: &RuleConfiguration{
@@ -8341,7 +8219,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyMatchAllStop",
+				"rule_type": "aclRuleDenyMatchAllStop",
 			},
 		}, {name: "deny all and stop processing without counter and logging with continue verdict",
 			config: &RuleConfiguration{
@@ -8357,7 +8235,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyMatchAllStop",
+				"rule_type": "aclRuleDenyMatchAllStop",
 			},
 		}, {name: "deny all and stop processing without counter and logging with continue verdict 1",
 			config: &RuleConfiguration{
@@ -8372,7 +8250,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyMatchAllStop",
+				"rule_type": "aclRuleDenyMatchAllStop",
 			},
 		}, {name: "deny all and stop processing without counter and logging with continue verdict 2",
 			config: &RuleConfiguration{
@@ -8390,7 +8268,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyMatchAllStop",
+				"rule_type":    "aclRuleDenyMatchAllStop",
 			},
 		}, {name: "deny all and stop processing without counter and logging with continue verdict 3",
 			config: &RuleConfiguration{
@@ -8407,7 +8285,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyMatchAllStop",
+				"rule_type":    "aclRuleDenyMatchAllStop",
 			},
 		}, {name: "deny and stop processing without counter and logging with deny stop verdict",
 			config: &RuleConfiguration{
@@ -8419,7 +8297,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyStop",
+				"rule_type": "aclRuleDenyStop",
 			},
 		}, {name: "deny and stop processing without counter and logging with continue verdict",
 			config: &RuleConfiguration{
@@ -8432,7 +8310,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyStop",
+				"rule_type": "aclRuleDenyStop",
 			},
 		}, {name: "deny and stop processing without counter and logging with continue verdict 1",
 			config: &RuleConfiguration{
@@ -8444,7 +8322,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyStop",
+				"rule_type": "aclRuleDenyStop",
 			},
 		}, {name: "deny and stop processing without counter and logging with continue verdict 2",
 			config: &RuleConfiguration{
@@ -8459,7 +8337,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyStop",
+				"rule_type":    "aclRuleDenyStop",
 			},
 		}, {name: "deny and stop processing without counter and logging with continue verdict 3",
 			config: &RuleConfiguration{
@@ -8473,7 +8351,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyStop",
+				"rule_type":    "aclRuleDenyStop",
 			},
 		}, {name: "deny any without counter and logging with deny verdict",
 			config: &RuleConfiguration{
@@ -8488,7 +8366,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyMatchAny",
+				"rule_type": "aclRuleDenyMatchAny",
 			},
 		}, {name: "deny any without counter and logging with continue verdict",
 			config: &RuleConfiguration{
@@ -8504,7 +8382,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyMatchAny",
+				"rule_type": "aclRuleDenyMatchAny",
 			},
 		}, {name: "deny any without counter and logging with continue verdict 1",
 			config: &RuleConfiguration{
@@ -8519,7 +8397,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyMatchAny",
+				"rule_type": "aclRuleDenyMatchAny",
 			},
 		}, {name: "deny any without counter and logging with continue verdict 2",
 			config: &RuleConfiguration{
@@ -8537,7 +8415,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyMatchAny",
+				"rule_type":    "aclRuleDenyMatchAny",
 			},
 		}, {name: "deny any without counter and logging with continue verdict 3",
 			config: &RuleConfiguration{
@@ -8554,7 +8432,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyMatchAny",
+				"rule_type":    "aclRuleDenyMatchAny",
 			},
 		}, {name: "deny all without counter and logging with deny verdict",
 			config: &RuleConfiguration{
@@ -8570,7 +8448,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyMatchAll",
+				"rule_type": "aclRuleDenyMatchAll",
 			},
 		}, {name: "deny all without counter and logging with continue verdict",
 			config: &RuleConfiguration{
@@ -8586,7 +8464,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyMatchAll",
+				"rule_type": "aclRuleDenyMatchAll",
 			},
 		}, {name: "deny all without counter and logging with continue verdict 1",
 			config: &RuleConfiguration{
@@ -8601,7 +8479,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyMatchAll",
+				"rule_type": "aclRuleDenyMatchAll",
 			},
 		}, {name: "deny all without counter and logging with continue verdict 2",
 			config: &RuleConfiguration{
@@ -8619,7 +8497,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyMatchAll",
+				"rule_type":    "aclRuleDenyMatchAll",
 			},
 		}, {name: "deny all without counter and logging with continue verdict 3",
 			config: &RuleConfiguration{
@@ -8636,7 +8514,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyMatchAll",
+				"rule_type":    "aclRuleDenyMatchAll",
 			},
 		}, {name: "deny without counter and logging with deny verdict",
 			config: &RuleConfiguration{
@@ -8648,7 +8526,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDeny",
+				"rule_type": "aclRuleDeny",
 			},
 		}, {name: "deny without counter and logging with continue verdict",
 			config: &RuleConfiguration{
@@ -8661,7 +8539,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDeny",
+				"rule_type": "aclRuleDeny",
 			},
 		}, {name: "deny without counter and logging with continue verdict 1",
 			config: &RuleConfiguration{
@@ -8673,7 +8551,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDeny",
+				"rule_type": "aclRuleDeny",
 			},
 		}, {name: "deny without counter and logging with continue verdict 2",
 			config: &RuleConfiguration{
@@ -8688,7 +8566,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDeny",
+				"rule_type":    "aclRuleDeny",
 			},
 		}, {name: "deny without counter and logging with continue verdict 3",
 			config: &RuleConfiguration{
@@ -8702,7 +8580,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDeny",
+				"rule_type":    "aclRuleDeny",
 			},
 		}, {name: "deny any and stop processing with debug logging and without counter with deny stop verdict",
 			config: &RuleConfiguration{
@@ -8717,7 +8595,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerMatchAnyStop",
+				"rule_type": "aclRuleDenyWithDebugLoggerMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with debug logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -8733,7 +8611,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerMatchAnyStop",
+				"rule_type": "aclRuleDenyWithDebugLoggerMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with debug logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -8748,7 +8626,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerMatchAnyStop",
+				"rule_type": "aclRuleDenyWithDebugLoggerMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with debug logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -8766,7 +8644,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithDebugLoggerMatchAnyStop",
+				"rule_type":    "aclRuleDenyWithDebugLoggerMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with debug logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -8783,7 +8661,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithDebugLoggerMatchAnyStop",
+				"rule_type":    "aclRuleDenyWithDebugLoggerMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with info logging and without counter with deny stop verdict",
 			config: &RuleConfiguration{
@@ -8798,7 +8676,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerMatchAnyStop",
+				"rule_type": "aclRuleDenyWithInfoLoggerMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with info logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -8814,7 +8692,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerMatchAnyStop",
+				"rule_type": "aclRuleDenyWithInfoLoggerMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with info logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -8829,7 +8707,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerMatchAnyStop",
+				"rule_type": "aclRuleDenyWithInfoLoggerMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with info logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -8847,7 +8725,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithInfoLoggerMatchAnyStop",
+				"rule_type":    "aclRuleDenyWithInfoLoggerMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with info logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -8864,7 +8742,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithInfoLoggerMatchAnyStop",
+				"rule_type":    "aclRuleDenyWithInfoLoggerMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with warn logging and without counter with deny stop verdict",
 			config: &RuleConfiguration{
@@ -8879,7 +8757,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerMatchAnyStop",
+				"rule_type": "aclRuleDenyWithWarnLoggerMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with warn logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -8895,7 +8773,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerMatchAnyStop",
+				"rule_type": "aclRuleDenyWithWarnLoggerMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with warn logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -8910,7 +8788,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerMatchAnyStop",
+				"rule_type": "aclRuleDenyWithWarnLoggerMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with warn logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -8928,7 +8806,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithWarnLoggerMatchAnyStop",
+				"rule_type":    "aclRuleDenyWithWarnLoggerMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with warn logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -8945,7 +8823,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithWarnLoggerMatchAnyStop",
+				"rule_type":    "aclRuleDenyWithWarnLoggerMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with error logging and without counter with deny stop verdict",
 			config: &RuleConfiguration{
@@ -8960,7 +8838,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerMatchAnyStop",
+				"rule_type": "aclRuleDenyWithErrorLoggerMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with error logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -8976,7 +8854,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerMatchAnyStop",
+				"rule_type": "aclRuleDenyWithErrorLoggerMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with error logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -8991,7 +8869,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerMatchAnyStop",
+				"rule_type": "aclRuleDenyWithErrorLoggerMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with error logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -9009,7 +8887,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithErrorLoggerMatchAnyStop",
+				"rule_type":    "aclRuleDenyWithErrorLoggerMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with error logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -9026,7 +8904,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithErrorLoggerMatchAnyStop",
+				"rule_type":    "aclRuleDenyWithErrorLoggerMatchAnyStop",
 			},
 		}, {name: "deny all and stop processing with debug logging and without counter with deny stop verdict",
 			config: &RuleConfiguration{
@@ -9042,7 +8920,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerMatchAllStop",
+				"rule_type": "aclRuleDenyWithDebugLoggerMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with debug logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -9058,7 +8936,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerMatchAllStop",
+				"rule_type": "aclRuleDenyWithDebugLoggerMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with debug logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -9073,7 +8951,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerMatchAllStop",
+				"rule_type": "aclRuleDenyWithDebugLoggerMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with debug logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -9091,7 +8969,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithDebugLoggerMatchAllStop",
+				"rule_type":    "aclRuleDenyWithDebugLoggerMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with debug logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -9108,7 +8986,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithDebugLoggerMatchAllStop",
+				"rule_type":    "aclRuleDenyWithDebugLoggerMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with info logging and without counter with deny stop verdict",
 			config: &RuleConfiguration{
@@ -9124,7 +9002,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerMatchAllStop",
+				"rule_type": "aclRuleDenyWithInfoLoggerMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with info logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -9140,7 +9018,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerMatchAllStop",
+				"rule_type": "aclRuleDenyWithInfoLoggerMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with info logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -9155,7 +9033,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerMatchAllStop",
+				"rule_type": "aclRuleDenyWithInfoLoggerMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with info logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -9173,7 +9051,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithInfoLoggerMatchAllStop",
+				"rule_type":    "aclRuleDenyWithInfoLoggerMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with info logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -9190,7 +9068,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithInfoLoggerMatchAllStop",
+				"rule_type":    "aclRuleDenyWithInfoLoggerMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with warn logging and without counter with deny stop verdict",
 			config: &RuleConfiguration{
@@ -9206,7 +9084,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerMatchAllStop",
+				"rule_type": "aclRuleDenyWithWarnLoggerMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with warn logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -9222,7 +9100,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerMatchAllStop",
+				"rule_type": "aclRuleDenyWithWarnLoggerMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with warn logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -9237,7 +9115,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerMatchAllStop",
+				"rule_type": "aclRuleDenyWithWarnLoggerMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with warn logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -9255,7 +9133,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithWarnLoggerMatchAllStop",
+				"rule_type":    "aclRuleDenyWithWarnLoggerMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with warn logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -9272,7 +9150,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithWarnLoggerMatchAllStop",
+				"rule_type":    "aclRuleDenyWithWarnLoggerMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with error logging and without counter with deny stop verdict",
 			config: &RuleConfiguration{
@@ -9288,7 +9166,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerMatchAllStop",
+				"rule_type": "aclRuleDenyWithErrorLoggerMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with error logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -9304,7 +9182,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerMatchAllStop",
+				"rule_type": "aclRuleDenyWithErrorLoggerMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with error logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -9319,7 +9197,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerMatchAllStop",
+				"rule_type": "aclRuleDenyWithErrorLoggerMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with error logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -9337,7 +9215,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithErrorLoggerMatchAllStop",
+				"rule_type":    "aclRuleDenyWithErrorLoggerMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with error logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -9354,7 +9232,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithErrorLoggerMatchAllStop",
+				"rule_type":    "aclRuleDenyWithErrorLoggerMatchAllStop",
 			},
 		}, {name: "deny and stop processing with debug logging and without counter with deny stop verdict",
 			config: &RuleConfiguration{
@@ -9366,7 +9244,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerStop",
+				"rule_type": "aclRuleDenyWithDebugLoggerStop",
 			},
 		}, {name: "deny and stop processing with debug logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -9379,7 +9257,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerStop",
+				"rule_type": "aclRuleDenyWithDebugLoggerStop",
 			},
 		}, {name: "deny and stop processing with debug logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -9391,7 +9269,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerStop",
+				"rule_type": "aclRuleDenyWithDebugLoggerStop",
 			},
 		}, {name: "deny and stop processing with debug logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -9406,7 +9284,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithDebugLoggerStop",
+				"rule_type":    "aclRuleDenyWithDebugLoggerStop",
 			},
 		}, {name: "deny and stop processing with debug logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -9420,7 +9298,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithDebugLoggerStop",
+				"rule_type":    "aclRuleDenyWithDebugLoggerStop",
 			},
 		}, {name: "deny and stop processing with info logging and without counter with deny stop verdict",
 			config: &RuleConfiguration{
@@ -9432,7 +9310,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerStop",
+				"rule_type": "aclRuleDenyWithInfoLoggerStop",
 			},
 		}, {name: "deny and stop processing with info logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -9445,7 +9323,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerStop",
+				"rule_type": "aclRuleDenyWithInfoLoggerStop",
 			},
 		}, {name: "deny and stop processing with info logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -9457,7 +9335,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerStop",
+				"rule_type": "aclRuleDenyWithInfoLoggerStop",
 			},
 		}, {name: "deny and stop processing with info logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -9472,7 +9350,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithInfoLoggerStop",
+				"rule_type":    "aclRuleDenyWithInfoLoggerStop",
 			},
 		}, {name: "deny and stop processing with info logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -9486,7 +9364,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithInfoLoggerStop",
+				"rule_type":    "aclRuleDenyWithInfoLoggerStop",
 			},
 		}, {name: "deny and stop processing with warn logging and without counter with deny stop verdict",
 			config: &RuleConfiguration{
@@ -9498,7 +9376,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerStop",
+				"rule_type": "aclRuleDenyWithWarnLoggerStop",
 			},
 		}, {name: "deny and stop processing with warn logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -9511,7 +9389,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerStop",
+				"rule_type": "aclRuleDenyWithWarnLoggerStop",
 			},
 		}, {name: "deny and stop processing with warn logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -9523,7 +9401,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerStop",
+				"rule_type": "aclRuleDenyWithWarnLoggerStop",
 			},
 		}, {name: "deny and stop processing with warn logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -9538,7 +9416,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithWarnLoggerStop",
+				"rule_type":    "aclRuleDenyWithWarnLoggerStop",
 			},
 		}, {name: "deny and stop processing with warn logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -9552,7 +9430,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithWarnLoggerStop",
+				"rule_type":    "aclRuleDenyWithWarnLoggerStop",
 			},
 		}, {name: "deny and stop processing with error logging and without counter with deny stop verdict",
 			config: &RuleConfiguration{
@@ -9564,7 +9442,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerStop",
+				"rule_type": "aclRuleDenyWithErrorLoggerStop",
 			},
 		}, {name: "deny and stop processing with error logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -9577,7 +9455,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerStop",
+				"rule_type": "aclRuleDenyWithErrorLoggerStop",
 			},
 		}, {name: "deny and stop processing with error logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -9589,7 +9467,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerStop",
+				"rule_type": "aclRuleDenyWithErrorLoggerStop",
 			},
 		}, {name: "deny and stop processing with error logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -9604,7 +9482,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithErrorLoggerStop",
+				"rule_type":    "aclRuleDenyWithErrorLoggerStop",
 			},
 		}, {name: "deny and stop processing with error logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -9618,7 +9496,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithErrorLoggerStop",
+				"rule_type":    "aclRuleDenyWithErrorLoggerStop",
 			},
 		}, {name: "deny any with debug logging and without counter with deny verdict",
 			config: &RuleConfiguration{
@@ -9633,7 +9511,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerMatchAny",
+				"rule_type": "aclRuleDenyWithDebugLoggerMatchAny",
 			},
 		}, {name: "deny any with debug logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -9649,7 +9527,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerMatchAny",
+				"rule_type": "aclRuleDenyWithDebugLoggerMatchAny",
 			},
 		}, {name: "deny any with debug logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -9664,7 +9542,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerMatchAny",
+				"rule_type": "aclRuleDenyWithDebugLoggerMatchAny",
 			},
 		}, {name: "deny any with debug logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -9682,7 +9560,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithDebugLoggerMatchAny",
+				"rule_type":    "aclRuleDenyWithDebugLoggerMatchAny",
 			},
 		}, {name: "deny any with debug logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -9699,7 +9577,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithDebugLoggerMatchAny",
+				"rule_type":    "aclRuleDenyWithDebugLoggerMatchAny",
 			},
 		}, {name: "deny any with info logging and without counter with deny verdict",
 			config: &RuleConfiguration{
@@ -9714,7 +9592,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerMatchAny",
+				"rule_type": "aclRuleDenyWithInfoLoggerMatchAny",
 			},
 		}, {name: "deny any with info logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -9730,7 +9608,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerMatchAny",
+				"rule_type": "aclRuleDenyWithInfoLoggerMatchAny",
 			},
 		}, {name: "deny any with info logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -9745,7 +9623,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerMatchAny",
+				"rule_type": "aclRuleDenyWithInfoLoggerMatchAny",
 			},
 		}, {name: "deny any with info logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -9763,7 +9641,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithInfoLoggerMatchAny",
+				"rule_type":    "aclRuleDenyWithInfoLoggerMatchAny",
 			},
 		}, {name: "deny any with info logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -9780,7 +9658,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithInfoLoggerMatchAny",
+				"rule_type":    "aclRuleDenyWithInfoLoggerMatchAny",
 			},
 		}, {name: "deny any with warn logging and without counter with deny verdict",
 			config: &RuleConfiguration{
@@ -9795,7 +9673,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerMatchAny",
+				"rule_type": "aclRuleDenyWithWarnLoggerMatchAny",
 			},
 		}, {name: "deny any with warn logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -9811,7 +9689,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerMatchAny",
+				"rule_type": "aclRuleDenyWithWarnLoggerMatchAny",
 			},
 		}, {name: "deny any with warn logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -9826,7 +9704,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerMatchAny",
+				"rule_type": "aclRuleDenyWithWarnLoggerMatchAny",
 			},
 		}, {name: "deny any with warn logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -9844,7 +9722,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithWarnLoggerMatchAny",
+				"rule_type":    "aclRuleDenyWithWarnLoggerMatchAny",
 			},
 		}, {name: "deny any with warn logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -9861,7 +9739,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithWarnLoggerMatchAny",
+				"rule_type":    "aclRuleDenyWithWarnLoggerMatchAny",
 			},
 		}, {name: "deny any with error logging and without counter with deny verdict",
 			config: &RuleConfiguration{
@@ -9876,7 +9754,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerMatchAny",
+				"rule_type": "aclRuleDenyWithErrorLoggerMatchAny",
 			},
 		}, {name: "deny any with error logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -9892,7 +9770,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerMatchAny",
+				"rule_type": "aclRuleDenyWithErrorLoggerMatchAny",
 			},
 		}, {name: "deny any with error logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -9907,7 +9785,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerMatchAny",
+				"rule_type": "aclRuleDenyWithErrorLoggerMatchAny",
 			},
 		}, {name: "deny any with error logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -9925,7 +9803,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithErrorLoggerMatchAny",
+				"rule_type":    "aclRuleDenyWithErrorLoggerMatchAny",
 			},
 		}, {name: "deny any with error logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -9942,7 +9820,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithErrorLoggerMatchAny",
+				"rule_type":    "aclRuleDenyWithErrorLoggerMatchAny",
 			},
 		}, {name: "deny all with debug logging and without counter with deny verdict",
 			config: &RuleConfiguration{
@@ -9958,7 +9836,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerMatchAll",
+				"rule_type": "aclRuleDenyWithDebugLoggerMatchAll",
 			},
 		}, {name: "deny all with debug logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -9974,7 +9852,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerMatchAll",
+				"rule_type": "aclRuleDenyWithDebugLoggerMatchAll",
 			},
 		}, {name: "deny all with debug logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -9989,7 +9867,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerMatchAll",
+				"rule_type": "aclRuleDenyWithDebugLoggerMatchAll",
 			},
 		}, {name: "deny all with debug logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -10007,7 +9885,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithDebugLoggerMatchAll",
+				"rule_type":    "aclRuleDenyWithDebugLoggerMatchAll",
 			},
 		}, {name: "deny all with debug logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -10024,7 +9902,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithDebugLoggerMatchAll",
+				"rule_type":    "aclRuleDenyWithDebugLoggerMatchAll",
 			},
 		}, {name: "deny all with info logging and without counter with deny verdict",
 			config: &RuleConfiguration{
@@ -10040,7 +9918,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerMatchAll",
+				"rule_type": "aclRuleDenyWithInfoLoggerMatchAll",
 			},
 		}, {name: "deny all with info logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -10056,7 +9934,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerMatchAll",
+				"rule_type": "aclRuleDenyWithInfoLoggerMatchAll",
 			},
 		}, {name: "deny all with info logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -10071,7 +9949,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerMatchAll",
+				"rule_type": "aclRuleDenyWithInfoLoggerMatchAll",
 			},
 		}, {name: "deny all with info logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -10089,7 +9967,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithInfoLoggerMatchAll",
+				"rule_type":    "aclRuleDenyWithInfoLoggerMatchAll",
 			},
 		}, {name: "deny all with info logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -10106,7 +9984,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithInfoLoggerMatchAll",
+				"rule_type":    "aclRuleDenyWithInfoLoggerMatchAll",
 			},
 		}, {name: "deny all with warn logging and without counter with deny verdict",
 			config: &RuleConfiguration{
@@ -10122,7 +10000,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerMatchAll",
+				"rule_type": "aclRuleDenyWithWarnLoggerMatchAll",
 			},
 		}, {name: "deny all with warn logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -10138,7 +10016,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerMatchAll",
+				"rule_type": "aclRuleDenyWithWarnLoggerMatchAll",
 			},
 		}, {name: "deny all with warn logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -10153,7 +10031,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerMatchAll",
+				"rule_type": "aclRuleDenyWithWarnLoggerMatchAll",
 			},
 		}, {name: "deny all with warn logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -10171,7 +10049,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithWarnLoggerMatchAll",
+				"rule_type":    "aclRuleDenyWithWarnLoggerMatchAll",
 			},
 		}, {name: "deny all with warn logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -10188,7 +10066,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithWarnLoggerMatchAll",
+				"rule_type":    "aclRuleDenyWithWarnLoggerMatchAll",
 			},
 		}, {name: "deny all with error logging and without counter with deny verdict",
 			config: &RuleConfiguration{
@@ -10204,7 +10082,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerMatchAll",
+				"rule_type": "aclRuleDenyWithErrorLoggerMatchAll",
 			},
 		}, {name: "deny all with error logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -10220,7 +10098,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerMatchAll",
+				"rule_type": "aclRuleDenyWithErrorLoggerMatchAll",
 			},
 		}, {name: "deny all with error logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -10235,7 +10113,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerMatchAll",
+				"rule_type": "aclRuleDenyWithErrorLoggerMatchAll",
 			},
 		}, {name: "deny all with error logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -10253,7 +10131,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithErrorLoggerMatchAll",
+				"rule_type":    "aclRuleDenyWithErrorLoggerMatchAll",
 			},
 		}, {name: "deny all with error logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -10270,7 +10148,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithErrorLoggerMatchAll",
+				"rule_type":    "aclRuleDenyWithErrorLoggerMatchAll",
 			},
 		}, {name: "deny with debug logging and without counter with deny verdict",
 			config: &RuleConfiguration{
@@ -10282,7 +10160,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyWithDebugLogger",
+				"rule_type": "aclRuleDenyWithDebugLogger",
 			},
 		}, {name: "deny with debug logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -10295,7 +10173,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithDebugLogger",
+				"rule_type": "aclRuleDenyWithDebugLogger",
 			},
 		}, {name: "deny with debug logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -10307,7 +10185,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithDebugLogger",
+				"rule_type": "aclRuleDenyWithDebugLogger",
 			},
 		}, {name: "deny with debug logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -10322,7 +10200,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithDebugLogger",
+				"rule_type":    "aclRuleDenyWithDebugLogger",
 			},
 		}, {name: "deny with debug logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -10336,7 +10214,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithDebugLogger",
+				"rule_type":    "aclRuleDenyWithDebugLogger",
 			},
 		}, {name: "deny with info logging and without counter with deny verdict",
 			config: &RuleConfiguration{
@@ -10348,7 +10226,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyWithInfoLogger",
+				"rule_type": "aclRuleDenyWithInfoLogger",
 			},
 		}, {name: "deny with info logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -10361,7 +10239,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithInfoLogger",
+				"rule_type": "aclRuleDenyWithInfoLogger",
 			},
 		}, {name: "deny with info logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -10373,7 +10251,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithInfoLogger",
+				"rule_type": "aclRuleDenyWithInfoLogger",
 			},
 		}, {name: "deny with info logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -10388,7 +10266,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithInfoLogger",
+				"rule_type":    "aclRuleDenyWithInfoLogger",
 			},
 		}, {name: "deny with info logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -10402,7 +10280,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithInfoLogger",
+				"rule_type":    "aclRuleDenyWithInfoLogger",
 			},
 		}, {name: "deny with warn logging and without counter with deny verdict",
 			config: &RuleConfiguration{
@@ -10414,7 +10292,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyWithWarnLogger",
+				"rule_type": "aclRuleDenyWithWarnLogger",
 			},
 		}, {name: "deny with warn logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -10427,7 +10305,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithWarnLogger",
+				"rule_type": "aclRuleDenyWithWarnLogger",
 			},
 		}, {name: "deny with warn logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -10439,7 +10317,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithWarnLogger",
+				"rule_type": "aclRuleDenyWithWarnLogger",
 			},
 		}, {name: "deny with warn logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -10454,7 +10332,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithWarnLogger",
+				"rule_type":    "aclRuleDenyWithWarnLogger",
 			},
 		}, {name: "deny with warn logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -10468,7 +10346,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithWarnLogger",
+				"rule_type":    "aclRuleDenyWithWarnLogger",
 			},
 		}, {name: "deny with error logging and without counter with deny verdict",
 			config: &RuleConfiguration{
@@ -10480,7 +10358,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyWithErrorLogger",
+				"rule_type": "aclRuleDenyWithErrorLogger",
 			},
 		}, {name: "deny with error logging and without counter with continue verdict",
 			config: &RuleConfiguration{
@@ -10493,7 +10371,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithErrorLogger",
+				"rule_type": "aclRuleDenyWithErrorLogger",
 			},
 		}, {name: "deny with error logging and without counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -10505,7 +10383,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithErrorLogger",
+				"rule_type": "aclRuleDenyWithErrorLogger",
 			},
 		}, {name: "deny with error logging and without counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -10520,7 +10398,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithErrorLogger",
+				"rule_type":    "aclRuleDenyWithErrorLogger",
 			},
 		}, {name: "deny with error logging and without counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -10534,7 +10412,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithErrorLogger",
+				"rule_type":    "aclRuleDenyWithErrorLogger",
 			},
 		}, {name: "deny any and stop processing with counter and without logging with deny stop verdict",
 			config: &RuleConfiguration{
@@ -10549,7 +10427,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyWithCounterMatchAnyStop",
+				"rule_type": "aclRuleDenyWithCounterMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with counter and without logging with continue verdict",
 			config: &RuleConfiguration{
@@ -10565,7 +10443,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithCounterMatchAnyStop",
+				"rule_type": "aclRuleDenyWithCounterMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with counter and without logging with continue verdict 1",
 			config: &RuleConfiguration{
@@ -10580,7 +10458,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithCounterMatchAnyStop",
+				"rule_type": "aclRuleDenyWithCounterMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with counter and without logging with continue verdict 2",
 			config: &RuleConfiguration{
@@ -10598,7 +10476,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithCounterMatchAnyStop",
+				"rule_type":    "aclRuleDenyWithCounterMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with counter and without logging with continue verdict 3",
 			config: &RuleConfiguration{
@@ -10615,7 +10493,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithCounterMatchAnyStop",
+				"rule_type":    "aclRuleDenyWithCounterMatchAnyStop",
 			},
 		}, {name: "deny all and stop processing with counter and without logging with deny stop verdict",
 			config: &RuleConfiguration{
@@ -10631,7 +10509,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyWithCounterMatchAllStop",
+				"rule_type": "aclRuleDenyWithCounterMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with counter and without logging with continue verdict",
 			config: &RuleConfiguration{
@@ -10647,7 +10525,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithCounterMatchAllStop",
+				"rule_type": "aclRuleDenyWithCounterMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with counter and without logging with continue verdict 1",
 			config: &RuleConfiguration{
@@ -10662,7 +10540,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithCounterMatchAllStop",
+				"rule_type": "aclRuleDenyWithCounterMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with counter and without logging with continue verdict 2",
 			config: &RuleConfiguration{
@@ -10680,7 +10558,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithCounterMatchAllStop",
+				"rule_type":    "aclRuleDenyWithCounterMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with counter and without logging with continue verdict 3",
 			config: &RuleConfiguration{
@@ -10697,7 +10575,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithCounterMatchAllStop",
+				"rule_type":    "aclRuleDenyWithCounterMatchAllStop",
 			},
 		}, {name: "deny and stop processing with counter and without logging with deny stop verdict",
 			config: &RuleConfiguration{
@@ -10709,7 +10587,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyWithCounterStop",
+				"rule_type": "aclRuleDenyWithCounterStop",
 			},
 		}, {name: "deny and stop processing with counter and without logging with continue verdict",
 			config: &RuleConfiguration{
@@ -10722,7 +10600,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithCounterStop",
+				"rule_type": "aclRuleDenyWithCounterStop",
 			},
 		}, {name: "deny and stop processing with counter and without logging with continue verdict 1",
 			config: &RuleConfiguration{
@@ -10734,7 +10612,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithCounterStop",
+				"rule_type": "aclRuleDenyWithCounterStop",
 			},
 		}, {name: "deny and stop processing with counter and without logging with continue verdict 2",
 			config: &RuleConfiguration{
@@ -10749,7 +10627,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithCounterStop",
+				"rule_type":    "aclRuleDenyWithCounterStop",
 			},
 		}, {name: "deny and stop processing with counter and without logging with continue verdict 3",
 			config: &RuleConfiguration{
@@ -10763,7 +10641,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithCounterStop",
+				"rule_type":    "aclRuleDenyWithCounterStop",
 			},
 		}, {name: "deny any with counter and without logging with deny verdict",
 			config: &RuleConfiguration{
@@ -10778,7 +10656,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyWithCounterMatchAny",
+				"rule_type": "aclRuleDenyWithCounterMatchAny",
 			},
 		}, {name: "deny any with counter and without logging with continue verdict",
 			config: &RuleConfiguration{
@@ -10794,7 +10672,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithCounterMatchAny",
+				"rule_type": "aclRuleDenyWithCounterMatchAny",
 			},
 		}, {name: "deny any with counter and without logging with continue verdict 1",
 			config: &RuleConfiguration{
@@ -10809,7 +10687,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithCounterMatchAny",
+				"rule_type": "aclRuleDenyWithCounterMatchAny",
 			},
 		}, {name: "deny any with counter and without logging with continue verdict 2",
 			config: &RuleConfiguration{
@@ -10827,7 +10705,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithCounterMatchAny",
+				"rule_type":    "aclRuleDenyWithCounterMatchAny",
 			},
 		}, {name: "deny any with counter and without logging with continue verdict 3",
 			config: &RuleConfiguration{
@@ -10844,7 +10722,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithCounterMatchAny",
+				"rule_type":    "aclRuleDenyWithCounterMatchAny",
 			},
 		}, {name: "deny all with counter and without logging with deny verdict",
 			config: &RuleConfiguration{
@@ -10860,7 +10738,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyWithCounterMatchAll",
+				"rule_type": "aclRuleDenyWithCounterMatchAll",
 			},
 		}, {name: "deny all with counter and without logging with continue verdict",
 			config: &RuleConfiguration{
@@ -10876,7 +10754,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithCounterMatchAll",
+				"rule_type": "aclRuleDenyWithCounterMatchAll",
 			},
 		}, {name: "deny all with counter and without logging with continue verdict 1",
 			config: &RuleConfiguration{
@@ -10891,7 +10769,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithCounterMatchAll",
+				"rule_type": "aclRuleDenyWithCounterMatchAll",
 			},
 		}, {name: "deny all with counter and without logging with continue verdict 2",
 			config: &RuleConfiguration{
@@ -10909,7 +10787,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithCounterMatchAll",
+				"rule_type":    "aclRuleDenyWithCounterMatchAll",
 			},
 		}, {name: "deny all with counter and without logging with continue verdict 3",
 			config: &RuleConfiguration{
@@ -10926,7 +10804,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithCounterMatchAll",
+				"rule_type":    "aclRuleDenyWithCounterMatchAll",
 			},
 		}, {name: "deny with counter and without logging with deny verdict",
 			config: &RuleConfiguration{
@@ -10938,7 +10816,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyWithCounter",
+				"rule_type": "aclRuleDenyWithCounter",
 			},
 		}, {name: "deny with counter and without logging with continue verdict",
 			config: &RuleConfiguration{
@@ -10951,7 +10829,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithCounter",
+				"rule_type": "aclRuleDenyWithCounter",
 			},
 		}, {name: "deny with counter and without logging with continue verdict 1",
 			config: &RuleConfiguration{
@@ -10963,7 +10841,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithCounter",
+				"rule_type": "aclRuleDenyWithCounter",
 			},
 		}, {name: "deny with counter and without logging with continue verdict 2",
 			config: &RuleConfiguration{
@@ -10978,7 +10856,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithCounter",
+				"rule_type":    "aclRuleDenyWithCounter",
 			},
 		}, {name: "deny with counter and without logging with continue verdict 3",
 			config: &RuleConfiguration{
@@ -10992,7 +10870,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithCounter",
+				"rule_type":    "aclRuleDenyWithCounter",
 			},
 		}, {name: "deny any and stop processing with debug logging and with counter with deny stop verdict",
 			config: &RuleConfiguration{
@@ -11007,7 +10885,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerCounterMatchAnyStop",
+				"rule_type": "aclRuleDenyWithDebugLoggerCounterMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with debug logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -11023,7 +10901,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerCounterMatchAnyStop",
+				"rule_type": "aclRuleDenyWithDebugLoggerCounterMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with debug logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -11038,7 +10916,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerCounterMatchAnyStop",
+				"rule_type": "aclRuleDenyWithDebugLoggerCounterMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with debug logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -11056,7 +10934,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithDebugLoggerCounterMatchAnyStop",
+				"rule_type":    "aclRuleDenyWithDebugLoggerCounterMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with debug logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -11073,7 +10951,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithDebugLoggerCounterMatchAnyStop",
+				"rule_type":    "aclRuleDenyWithDebugLoggerCounterMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with info logging and with counter with deny stop verdict",
 			config: &RuleConfiguration{
@@ -11088,7 +10966,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerCounterMatchAnyStop",
+				"rule_type": "aclRuleDenyWithInfoLoggerCounterMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with info logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -11104,7 +10982,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerCounterMatchAnyStop",
+				"rule_type": "aclRuleDenyWithInfoLoggerCounterMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with info logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -11119,7 +10997,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerCounterMatchAnyStop",
+				"rule_type": "aclRuleDenyWithInfoLoggerCounterMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with info logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -11137,7 +11015,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithInfoLoggerCounterMatchAnyStop",
+				"rule_type":    "aclRuleDenyWithInfoLoggerCounterMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with info logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -11154,7 +11032,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithInfoLoggerCounterMatchAnyStop",
+				"rule_type":    "aclRuleDenyWithInfoLoggerCounterMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with warn logging and with counter with deny stop verdict",
 			config: &RuleConfiguration{
@@ -11169,7 +11047,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerCounterMatchAnyStop",
+				"rule_type": "aclRuleDenyWithWarnLoggerCounterMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with warn logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -11185,7 +11063,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerCounterMatchAnyStop",
+				"rule_type": "aclRuleDenyWithWarnLoggerCounterMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with warn logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -11200,7 +11078,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerCounterMatchAnyStop",
+				"rule_type": "aclRuleDenyWithWarnLoggerCounterMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with warn logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -11218,7 +11096,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithWarnLoggerCounterMatchAnyStop",
+				"rule_type":    "aclRuleDenyWithWarnLoggerCounterMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with warn logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -11235,7 +11113,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithWarnLoggerCounterMatchAnyStop",
+				"rule_type":    "aclRuleDenyWithWarnLoggerCounterMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with error logging and with counter with deny stop verdict",
 			config: &RuleConfiguration{
@@ -11250,7 +11128,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerCounterMatchAnyStop",
+				"rule_type": "aclRuleDenyWithErrorLoggerCounterMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with error logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -11266,7 +11144,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerCounterMatchAnyStop",
+				"rule_type": "aclRuleDenyWithErrorLoggerCounterMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with error logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -11281,7 +11159,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerCounterMatchAnyStop",
+				"rule_type": "aclRuleDenyWithErrorLoggerCounterMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with error logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -11299,7 +11177,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithErrorLoggerCounterMatchAnyStop",
+				"rule_type":    "aclRuleDenyWithErrorLoggerCounterMatchAnyStop",
 			},
 		}, {name: "deny any and stop processing with error logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -11316,7 +11194,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithErrorLoggerCounterMatchAnyStop",
+				"rule_type":    "aclRuleDenyWithErrorLoggerCounterMatchAnyStop",
 			},
 		}, {name: "deny all and stop processing with debug logging and with counter with deny stop verdict",
 			config: &RuleConfiguration{
@@ -11332,7 +11210,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerCounterMatchAllStop",
+				"rule_type": "aclRuleDenyWithDebugLoggerCounterMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with debug logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -11348,7 +11226,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerCounterMatchAllStop",
+				"rule_type": "aclRuleDenyWithDebugLoggerCounterMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with debug logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -11363,7 +11241,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerCounterMatchAllStop",
+				"rule_type": "aclRuleDenyWithDebugLoggerCounterMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with debug logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -11381,7 +11259,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithDebugLoggerCounterMatchAllStop",
+				"rule_type":    "aclRuleDenyWithDebugLoggerCounterMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with debug logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -11398,7 +11276,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithDebugLoggerCounterMatchAllStop",
+				"rule_type":    "aclRuleDenyWithDebugLoggerCounterMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with info logging and with counter with deny stop verdict",
 			config: &RuleConfiguration{
@@ -11414,7 +11292,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerCounterMatchAllStop",
+				"rule_type": "aclRuleDenyWithInfoLoggerCounterMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with info logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -11430,7 +11308,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerCounterMatchAllStop",
+				"rule_type": "aclRuleDenyWithInfoLoggerCounterMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with info logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -11445,7 +11323,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerCounterMatchAllStop",
+				"rule_type": "aclRuleDenyWithInfoLoggerCounterMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with info logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -11463,7 +11341,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithInfoLoggerCounterMatchAllStop",
+				"rule_type":    "aclRuleDenyWithInfoLoggerCounterMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with info logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -11480,7 +11358,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithInfoLoggerCounterMatchAllStop",
+				"rule_type":    "aclRuleDenyWithInfoLoggerCounterMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with warn logging and with counter with deny stop verdict",
 			config: &RuleConfiguration{
@@ -11496,7 +11374,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerCounterMatchAllStop",
+				"rule_type": "aclRuleDenyWithWarnLoggerCounterMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with warn logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -11512,7 +11390,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerCounterMatchAllStop",
+				"rule_type": "aclRuleDenyWithWarnLoggerCounterMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with warn logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -11527,7 +11405,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerCounterMatchAllStop",
+				"rule_type": "aclRuleDenyWithWarnLoggerCounterMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with warn logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -11545,7 +11423,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithWarnLoggerCounterMatchAllStop",
+				"rule_type":    "aclRuleDenyWithWarnLoggerCounterMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with warn logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -11562,7 +11440,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithWarnLoggerCounterMatchAllStop",
+				"rule_type":    "aclRuleDenyWithWarnLoggerCounterMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with error logging and with counter with deny stop verdict",
 			config: &RuleConfiguration{
@@ -11578,7 +11456,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerCounterMatchAllStop",
+				"rule_type": "aclRuleDenyWithErrorLoggerCounterMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with error logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -11594,7 +11472,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerCounterMatchAllStop",
+				"rule_type": "aclRuleDenyWithErrorLoggerCounterMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with error logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -11609,7 +11487,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerCounterMatchAllStop",
+				"rule_type": "aclRuleDenyWithErrorLoggerCounterMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with error logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -11627,7 +11505,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithErrorLoggerCounterMatchAllStop",
+				"rule_type":    "aclRuleDenyWithErrorLoggerCounterMatchAllStop",
 			},
 		}, {name: "deny all and stop processing with error logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -11644,7 +11522,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithErrorLoggerCounterMatchAllStop",
+				"rule_type":    "aclRuleDenyWithErrorLoggerCounterMatchAllStop",
 			},
 		}, {name: "deny and stop processing with debug logging and with counter with deny stop verdict",
 			config: &RuleConfiguration{
@@ -11656,7 +11534,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerCounterStop",
+				"rule_type": "aclRuleDenyWithDebugLoggerCounterStop",
 			},
 		}, {name: "deny and stop processing with debug logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -11669,7 +11547,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerCounterStop",
+				"rule_type": "aclRuleDenyWithDebugLoggerCounterStop",
 			},
 		}, {name: "deny and stop processing with debug logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -11681,7 +11559,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerCounterStop",
+				"rule_type": "aclRuleDenyWithDebugLoggerCounterStop",
 			},
 		}, {name: "deny and stop processing with debug logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -11696,7 +11574,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithDebugLoggerCounterStop",
+				"rule_type":    "aclRuleDenyWithDebugLoggerCounterStop",
 			},
 		}, {name: "deny and stop processing with debug logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -11710,7 +11588,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithDebugLoggerCounterStop",
+				"rule_type":    "aclRuleDenyWithDebugLoggerCounterStop",
 			},
 		}, {name: "deny and stop processing with info logging and with counter with deny stop verdict",
 			config: &RuleConfiguration{
@@ -11722,7 +11600,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerCounterStop",
+				"rule_type": "aclRuleDenyWithInfoLoggerCounterStop",
 			},
 		}, {name: "deny and stop processing with info logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -11735,7 +11613,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerCounterStop",
+				"rule_type": "aclRuleDenyWithInfoLoggerCounterStop",
 			},
 		}, {name: "deny and stop processing with info logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -11747,7 +11625,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerCounterStop",
+				"rule_type": "aclRuleDenyWithInfoLoggerCounterStop",
 			},
 		}, {name: "deny and stop processing with info logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -11762,7 +11640,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithInfoLoggerCounterStop",
+				"rule_type":    "aclRuleDenyWithInfoLoggerCounterStop",
 			},
 		}, {name: "deny and stop processing with info logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -11776,7 +11654,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithInfoLoggerCounterStop",
+				"rule_type":    "aclRuleDenyWithInfoLoggerCounterStop",
 			},
 		}, {name: "deny and stop processing with warn logging and with counter with deny stop verdict",
 			config: &RuleConfiguration{
@@ -11788,7 +11666,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerCounterStop",
+				"rule_type": "aclRuleDenyWithWarnLoggerCounterStop",
 			},
 		}, {name: "deny and stop processing with warn logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -11801,7 +11679,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerCounterStop",
+				"rule_type": "aclRuleDenyWithWarnLoggerCounterStop",
 			},
 		}, {name: "deny and stop processing with warn logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -11813,7 +11691,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerCounterStop",
+				"rule_type": "aclRuleDenyWithWarnLoggerCounterStop",
 			},
 		}, {name: "deny and stop processing with warn logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -11828,7 +11706,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithWarnLoggerCounterStop",
+				"rule_type":    "aclRuleDenyWithWarnLoggerCounterStop",
 			},
 		}, {name: "deny and stop processing with warn logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -11842,7 +11720,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithWarnLoggerCounterStop",
+				"rule_type":    "aclRuleDenyWithWarnLoggerCounterStop",
 			},
 		}, {name: "deny and stop processing with error logging and with counter with deny stop verdict",
 			config: &RuleConfiguration{
@@ -11854,7 +11732,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDenyStop),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerCounterStop",
+				"rule_type": "aclRuleDenyWithErrorLoggerCounterStop",
 			},
 		}, {name: "deny and stop processing with error logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -11867,7 +11745,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerCounterStop",
+				"rule_type": "aclRuleDenyWithErrorLoggerCounterStop",
 			},
 		}, {name: "deny and stop processing with error logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -11879,7 +11757,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerCounterStop",
+				"rule_type": "aclRuleDenyWithErrorLoggerCounterStop",
 			},
 		}, {name: "deny and stop processing with error logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -11894,7 +11772,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithErrorLoggerCounterStop",
+				"rule_type":    "aclRuleDenyWithErrorLoggerCounterStop",
 			},
 		}, {name: "deny and stop processing with error logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -11908,7 +11786,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithErrorLoggerCounterStop",
+				"rule_type":    "aclRuleDenyWithErrorLoggerCounterStop",
 			},
 		}, {name: "deny any with debug logging and with counter with deny verdict",
 			config: &RuleConfiguration{
@@ -11923,7 +11801,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerCounterMatchAny",
+				"rule_type": "aclRuleDenyWithDebugLoggerCounterMatchAny",
 			},
 		}, {name: "deny any with debug logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -11939,7 +11817,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerCounterMatchAny",
+				"rule_type": "aclRuleDenyWithDebugLoggerCounterMatchAny",
 			},
 		}, {name: "deny any with debug logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -11954,7 +11832,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerCounterMatchAny",
+				"rule_type": "aclRuleDenyWithDebugLoggerCounterMatchAny",
 			},
 		}, {name: "deny any with debug logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -11972,7 +11850,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithDebugLoggerCounterMatchAny",
+				"rule_type":    "aclRuleDenyWithDebugLoggerCounterMatchAny",
 			},
 		}, {name: "deny any with debug logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -11989,7 +11867,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithDebugLoggerCounterMatchAny",
+				"rule_type":    "aclRuleDenyWithDebugLoggerCounterMatchAny",
 			},
 		}, {name: "deny any with info logging and with counter with deny verdict",
 			config: &RuleConfiguration{
@@ -12004,7 +11882,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerCounterMatchAny",
+				"rule_type": "aclRuleDenyWithInfoLoggerCounterMatchAny",
 			},
 		}, {name: "deny any with info logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -12020,7 +11898,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerCounterMatchAny",
+				"rule_type": "aclRuleDenyWithInfoLoggerCounterMatchAny",
 			},
 		}, {name: "deny any with info logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -12035,7 +11913,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerCounterMatchAny",
+				"rule_type": "aclRuleDenyWithInfoLoggerCounterMatchAny",
 			},
 		}, {name: "deny any with info logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -12053,7 +11931,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithInfoLoggerCounterMatchAny",
+				"rule_type":    "aclRuleDenyWithInfoLoggerCounterMatchAny",
 			},
 		}, {name: "deny any with info logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -12070,7 +11948,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithInfoLoggerCounterMatchAny",
+				"rule_type":    "aclRuleDenyWithInfoLoggerCounterMatchAny",
 			},
 		}, {name: "deny any with warn logging and with counter with deny verdict",
 			config: &RuleConfiguration{
@@ -12085,7 +11963,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerCounterMatchAny",
+				"rule_type": "aclRuleDenyWithWarnLoggerCounterMatchAny",
 			},
 		}, {name: "deny any with warn logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -12101,7 +11979,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerCounterMatchAny",
+				"rule_type": "aclRuleDenyWithWarnLoggerCounterMatchAny",
 			},
 		}, {name: "deny any with warn logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -12116,7 +11994,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerCounterMatchAny",
+				"rule_type": "aclRuleDenyWithWarnLoggerCounterMatchAny",
 			},
 		}, {name: "deny any with warn logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -12134,7 +12012,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithWarnLoggerCounterMatchAny",
+				"rule_type":    "aclRuleDenyWithWarnLoggerCounterMatchAny",
 			},
 		}, {name: "deny any with warn logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -12151,7 +12029,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithWarnLoggerCounterMatchAny",
+				"rule_type":    "aclRuleDenyWithWarnLoggerCounterMatchAny",
 			},
 		}, {name: "deny any with error logging and with counter with deny verdict",
 			config: &RuleConfiguration{
@@ -12166,7 +12044,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerCounterMatchAny",
+				"rule_type": "aclRuleDenyWithErrorLoggerCounterMatchAny",
 			},
 		}, {name: "deny any with error logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -12182,7 +12060,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerCounterMatchAny",
+				"rule_type": "aclRuleDenyWithErrorLoggerCounterMatchAny",
 			},
 		}, {name: "deny any with error logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -12197,7 +12075,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerCounterMatchAny",
+				"rule_type": "aclRuleDenyWithErrorLoggerCounterMatchAny",
 			},
 		}, {name: "deny any with error logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -12215,7 +12093,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithErrorLoggerCounterMatchAny",
+				"rule_type":    "aclRuleDenyWithErrorLoggerCounterMatchAny",
 			},
 		}, {name: "deny any with error logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -12232,7 +12110,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithErrorLoggerCounterMatchAny",
+				"rule_type":    "aclRuleDenyWithErrorLoggerCounterMatchAny",
 			},
 		}, {name: "deny all with debug logging and with counter with deny verdict",
 			config: &RuleConfiguration{
@@ -12248,7 +12126,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerCounterMatchAll",
+				"rule_type": "aclRuleDenyWithDebugLoggerCounterMatchAll",
 			},
 		}, {name: "deny all with debug logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -12264,7 +12142,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerCounterMatchAll",
+				"rule_type": "aclRuleDenyWithDebugLoggerCounterMatchAll",
 			},
 		}, {name: "deny all with debug logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -12279,7 +12157,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerCounterMatchAll",
+				"rule_type": "aclRuleDenyWithDebugLoggerCounterMatchAll",
 			},
 		}, {name: "deny all with debug logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -12297,7 +12175,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithDebugLoggerCounterMatchAll",
+				"rule_type":    "aclRuleDenyWithDebugLoggerCounterMatchAll",
 			},
 		}, {name: "deny all with debug logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -12314,7 +12192,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithDebugLoggerCounterMatchAll",
+				"rule_type":    "aclRuleDenyWithDebugLoggerCounterMatchAll",
 			},
 		}, {name: "deny all with info logging and with counter with deny verdict",
 			config: &RuleConfiguration{
@@ -12330,7 +12208,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerCounterMatchAll",
+				"rule_type": "aclRuleDenyWithInfoLoggerCounterMatchAll",
 			},
 		}, {name: "deny all with info logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -12346,7 +12224,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerCounterMatchAll",
+				"rule_type": "aclRuleDenyWithInfoLoggerCounterMatchAll",
 			},
 		}, {name: "deny all with info logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -12361,7 +12239,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerCounterMatchAll",
+				"rule_type": "aclRuleDenyWithInfoLoggerCounterMatchAll",
 			},
 		}, {name: "deny all with info logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -12379,7 +12257,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithInfoLoggerCounterMatchAll",
+				"rule_type":    "aclRuleDenyWithInfoLoggerCounterMatchAll",
 			},
 		}, {name: "deny all with info logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -12396,7 +12274,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithInfoLoggerCounterMatchAll",
+				"rule_type":    "aclRuleDenyWithInfoLoggerCounterMatchAll",
 			},
 		}, {name: "deny all with warn logging and with counter with deny verdict",
 			config: &RuleConfiguration{
@@ -12412,7 +12290,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerCounterMatchAll",
+				"rule_type": "aclRuleDenyWithWarnLoggerCounterMatchAll",
 			},
 		}, {name: "deny all with warn logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -12428,7 +12306,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerCounterMatchAll",
+				"rule_type": "aclRuleDenyWithWarnLoggerCounterMatchAll",
 			},
 		}, {name: "deny all with warn logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -12443,7 +12321,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerCounterMatchAll",
+				"rule_type": "aclRuleDenyWithWarnLoggerCounterMatchAll",
 			},
 		}, {name: "deny all with warn logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -12461,7 +12339,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithWarnLoggerCounterMatchAll",
+				"rule_type":    "aclRuleDenyWithWarnLoggerCounterMatchAll",
 			},
 		}, {name: "deny all with warn logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -12478,7 +12356,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithWarnLoggerCounterMatchAll",
+				"rule_type":    "aclRuleDenyWithWarnLoggerCounterMatchAll",
 			},
 		}, {name: "deny all with error logging and with counter with deny verdict",
 			config: &RuleConfiguration{
@@ -12494,7 +12372,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerCounterMatchAll",
+				"rule_type": "aclRuleDenyWithErrorLoggerCounterMatchAll",
 			},
 		}, {name: "deny all with error logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -12510,7 +12388,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerCounterMatchAll",
+				"rule_type": "aclRuleDenyWithErrorLoggerCounterMatchAll",
 			},
 		}, {name: "deny all with error logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -12525,7 +12403,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerCounterMatchAll",
+				"rule_type": "aclRuleDenyWithErrorLoggerCounterMatchAll",
 			},
 		}, {name: "deny all with error logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -12543,7 +12421,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithErrorLoggerCounterMatchAll",
+				"rule_type":    "aclRuleDenyWithErrorLoggerCounterMatchAll",
 			},
 		}, {name: "deny all with error logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -12560,7 +12438,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithErrorLoggerCounterMatchAll",
+				"rule_type":    "aclRuleDenyWithErrorLoggerCounterMatchAll",
 			},
 		}, {name: "deny with debug logging and with counter with deny verdict",
 			config: &RuleConfiguration{
@@ -12572,7 +12450,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerCounter",
+				"rule_type": "aclRuleDenyWithDebugLoggerCounter",
 			},
 		}, {name: "deny with debug logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -12585,7 +12463,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerCounter",
+				"rule_type": "aclRuleDenyWithDebugLoggerCounter",
 			},
 		}, {name: "deny with debug logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -12597,7 +12475,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithDebugLoggerCounter",
+				"rule_type": "aclRuleDenyWithDebugLoggerCounter",
 			},
 		}, {name: "deny with debug logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -12612,7 +12490,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithDebugLoggerCounter",
+				"rule_type":    "aclRuleDenyWithDebugLoggerCounter",
 			},
 		}, {name: "deny with debug logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -12626,7 +12504,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithDebugLoggerCounter",
+				"rule_type":    "aclRuleDenyWithDebugLoggerCounter",
 			},
 		}, {name: "deny with info logging and with counter with deny verdict",
 			config: &RuleConfiguration{
@@ -12638,7 +12516,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerCounter",
+				"rule_type": "aclRuleDenyWithInfoLoggerCounter",
 			},
 		}, {name: "deny with info logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -12651,7 +12529,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerCounter",
+				"rule_type": "aclRuleDenyWithInfoLoggerCounter",
 			},
 		}, {name: "deny with info logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -12663,7 +12541,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithInfoLoggerCounter",
+				"rule_type": "aclRuleDenyWithInfoLoggerCounter",
 			},
 		}, {name: "deny with info logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -12678,7 +12556,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithInfoLoggerCounter",
+				"rule_type":    "aclRuleDenyWithInfoLoggerCounter",
 			},
 		}, {name: "deny with info logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -12692,7 +12570,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithInfoLoggerCounter",
+				"rule_type":    "aclRuleDenyWithInfoLoggerCounter",
 			},
 		}, {name: "deny with warn logging and with counter with deny verdict",
 			config: &RuleConfiguration{
@@ -12704,7 +12582,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerCounter",
+				"rule_type": "aclRuleDenyWithWarnLoggerCounter",
 			},
 		}, {name: "deny with warn logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -12717,7 +12595,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerCounter",
+				"rule_type": "aclRuleDenyWithWarnLoggerCounter",
 			},
 		}, {name: "deny with warn logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -12729,7 +12607,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithWarnLoggerCounter",
+				"rule_type": "aclRuleDenyWithWarnLoggerCounter",
 			},
 		}, {name: "deny with warn logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -12744,7 +12622,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithWarnLoggerCounter",
+				"rule_type":    "aclRuleDenyWithWarnLoggerCounter",
 			},
 		}, {name: "deny with warn logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -12758,7 +12636,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithWarnLoggerCounter",
+				"rule_type":    "aclRuleDenyWithWarnLoggerCounter",
 			},
 		}, {name: "deny with error logging and with counter with deny verdict",
 			config: &RuleConfiguration{
@@ -12770,7 +12648,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictDeny),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerCounter",
+				"rule_type": "aclRuleDenyWithErrorLoggerCounter",
 			},
 		}, {name: "deny with error logging and with counter with continue verdict",
 			config: &RuleConfiguration{
@@ -12783,7 +12661,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerCounter",
+				"rule_type": "aclRuleDenyWithErrorLoggerCounter",
 			},
 		}, {name: "deny with error logging and with counter with continue verdict 1",
 			config: &RuleConfiguration{
@@ -12795,7 +12673,7 @@ func TestEvalAclRule(t *testing.T) {
 			},
 			want: map[string]interface{}{
 				"verdict":   getRuleVerdictName(ruleVerdictContinue),
-				"rule_type": "*acl.aclRuleDenyWithErrorLoggerCounter",
+				"rule_type": "aclRuleDenyWithErrorLoggerCounter",
 			},
 		}, {name: "deny with error logging and with counter with continue verdict 2",
 			config: &RuleConfiguration{
@@ -12810,7 +12688,7 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithErrorLoggerCounter",
+				"rule_type":    "aclRuleDenyWithErrorLoggerCounter",
 			},
 		}, {name: "deny with error logging and with counter with continue verdict 3",
 			config: &RuleConfiguration{
@@ -12824,14 +12702,14 @@ func TestEvalAclRule(t *testing.T) {
 			want: map[string]interface{}{
 				"verdict":      getRuleVerdictName(ruleVerdictContinue),
 				"empty_fields": true,
-				"rule_type":    "*acl.aclRuleDenyWithErrorLoggerCounter",
+				"rule_type":    "aclRuleDenyWithErrorLoggerCounter",
 			},
 		},
 	}
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Logf(tc.name)
-			var rule aclRule
+			var rule *aclRule
 			ctx := context.Background()
 			logger := utils.NewLogger()
 			parsedACLRule, err := newACLRule(ctx, 0, tc.config, logger)
@@ -12839,7 +12717,7 @@ func TestEvalAclRule(t *testing.T) {
 				return
 			}
 			rule = parsedACLRule
-			ruleType := reflect.TypeOf(rule).String()
+			ruleType := rule.getConfig(ctx).ruleType
 			got := make(map[string]interface{})
 			got["rule_type"] = ruleType
 			if tc.emptyFields {
@@ -12856,3 +12734,222 @@ func TestEvalAclRule(t *testing.T) {
 		})
 	}
 }
+
+// testRuleType is a combination of the match mode and the actions of
+// a compiled rule.
+type testRuleType struct {
+	action   string
+	mode     string
+	stop     bool
+	counter  bool
+	logLevel string
+}
+
+// getTestRuleTypes returns all the combinations of the match modes and the
+// actions of the rules.
+func getTestRuleTypes() []testRuleType {
+	var ruleTypes []testRuleType
+	for _, action := range []string{"allow", "deny"} {
+		for _, mode := range []string{"single", "all", "any"} {
+			for _, stop := range []bool{false, true} {
+				for _, counter := range []bool{false, true} {
+					for _, logLevel := range []string{"", "debug", "info", "warn", "error"} {
+						ruleTypes = append(ruleTypes, testRuleType{
+							action:   action,
+							mode:     mode,
+							stop:     stop,
+							counter:  counter,
+							logLevel: logLevel,
+						})
+					}
+				}
+			}
+		}
+	}
+	return ruleTypes
+}
+
+func (rt testRuleType) config() *RuleConfiguration {
+	cfg := &RuleConfiguration{
+		Comment:    "foobar barfoo",
+		Conditions: []string{"exact match roles foobar"},
+		Action:     rt.action,
+	}
+	if rt.mode != "single" {
+		cfg.Conditions = append(cfg.Conditions, "exact match org nyc")
+	}
+	if rt.mode == "any" {
+		cfg.Action += " any"
+	}
+	if rt.stop {
+		cfg.Action += " stop"
+	}
+	if rt.counter {
+		cfg.Action += " counter"
+	}
+	if rt.logLevel != "" {
+		cfg.Action += " log " + rt.logLevel
+	}
+	return cfg
+}
+
+func (rt testRuleType) name() string {
+	s := "aclRule" + strings.Title(rt.action)
+	if rt.counter || rt.logLevel != "" {
+		s += "With"
+	}
+	if rt.logLevel != "" {
+		s += strings.Title(rt.logLevel) + "Logger"
+	}
+	if rt.counter {
+		s += "Counter"
+	}
+	switch rt.mode {
+	case "all":
+		s += "MatchAll"
+	case "any":
+		s += "MatchAny"
+	}
+	if rt.stop {
+		s += "Stop"
+	}
+	return s
+}
+
+func (rt testRuleType) verdict() ruleVerdict {
+	switch {
+	case rt.action == "allow" && rt.stop:
+		return ruleVerdictAllowStop
+	case rt.action == "allow":
+		return ruleVerdictAllow
+	case rt.stop:
+		return ruleVerdictDenyStop
+	}
+	return ruleVerdictDeny
+}
+
+func TestEvalAclRuleCounters(t *testing.T) {
+	// The inputs of the rules along with the outcomes of the evaluation per
+	// match mode, i.e. whether the rule matches and whether the rule counts
+	// the miss. The rules having all their fields in the input data count
+	// the misses. The rules matching any condition count the misses
+	// regardless.
+	type outcome struct {
+		match bool
+		count bool
+	}
+	var inputs = []struct {
+		name  string
+		input map[string]interface{}
+		want  map[string]outcome
+	}{
+		{
+			name: "matching input",
+			input: map[string]interface{}{
+				"roles": []string{"foobar"},
+				"org":   []string{"nyc"},
+			},
+			want: map[string]outcome{
+				"single": {match: true, count: true},
+				"all":    {match: true, count: true},
+				"any":    {match: true, count: true},
+			},
+		},
+		{
+			name: "input partially matching",
+			input: map[string]interface{}{
+				"roles": []string{"foobar"},
+				"org":   []string{"sfo"},
+			},
+			want: map[string]outcome{
+				"single": {match: true, count: true},
+				"all":    {match: false, count: true},
+				"any":    {match: true, count: true},
+			},
+		},
+		{
+			name: "input partially matching without some fields",
+			input: map[string]interface{}{
+				"org": []string{"nyc"},
+			},
+			want: map[string]outcome{
+				"single": {match: false, count: false},
+				"all":    {match: false, count: false},
+				"any":    {match: true, count: true},
+			},
+		},
+		{
+			name: "input not matching",
+			input: map[string]interface{}{
+				"name":  "John Smith",
+				"roles": []string{"barfoo"},
+				"org":   []string{"sfo"},
+			},
+			want: map[string]outcome{
+				"single": {match: false, count: true},
+				"all":    {match: false, count: true},
+				"any":    {match: false, count: true},
+			},
+		},
+		{
+			name: "input without fields",
+			input: map[string]interface{}{
+				"name": "John Smith",
+			},
+			want: map[string]outcome{
+				"single": {match: false, count: false},
+				"all":    {match: false, count: false},
+				"any":    {match: false, count: true},
+			},
+		},
+	}
+
+	for _, rt := range getTestRuleTypes() {
+		for _, emptyFields := range []bool{false, true} {
+			for _, in := range inputs {
+				name := fmt.Sprintf("%s with %s", rt.name(), in.name)
+				if emptyFields {
+					name += " and empty fields"
+				}
+				t.Run(name, func(t *testing.T) {
+					ctx := context.Background()
+					rule, err := newACLRule(ctx, 0, rt.config(), utils.NewLogger())
+					if err != nil {
+						t.Fatalf("unexpected error: %v", err)
+					}
+					want := in.want[rt.mode]
+					if emptyFields {
+						// The rule without the fields never matches.
+						rule.emptyFields(ctx)
+						want = outcome{count: true}
+					}
+					wantVerdict := ruleVerdictContinue
+					if want.match {
+						wantVerdict = rt.verdict()
+					}
+					got := map[string]interface{}{
+						"verdict": getRuleVerdictName(rule.eval(ctx, in.input)),
+					}
+					if rule.counter != nil {
+						got["counter_match"] = rule.counter.counterMatch
+						got["counter_miss"] = rule.counter.counterMiss
+					}
+					expected := map[string]interface{}{
+						"verdict": getRuleVerdictName(wantVerdict),
+					}
+					if rt.counter {
+						expected["counter_match"] = uint64(0)
+						expected["counter_miss"] = uint64(0)
+						switch {
+						case want.count && want.match:
+							expected["counter_match"] = uint64(1)
+						case want.count:
+							expected["counter_miss"] = uint64(1)
+						}
+					}
+					tests.EvalObjects(t, "match result", expected, got)
+				})
+			}
+		}
+	}
+}
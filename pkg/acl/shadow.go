@@ -0,0 +1,91 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acl
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"sync/atomic"
+)
+
+// ShadowCounters is the snapshot of the counters of the shadow AccessList.
+// WouldDeny counts the requests allowed by the enforced AccessList and
+// denied by the shadow one. WouldAllow counts the reverse.
+type ShadowCounters struct {
+	Agree      uint64 `json:"agree" xml:"agree" yaml:"agree"`
+	WouldDeny  uint64 `json:"would_deny" xml:"would_deny" yaml:"would_deny"`
+	WouldAllow uint64 `json:"would_allow" xml:"would_allow" yaml:"would_allow"`
+}
+
+// shadowPolicy is the AccessList evaluated alongside the enforced one. Its
+// decisions are compared with the enforced ones, but never enforced.
+type shadowPolicy struct {
+	accessList *AccessList
+	agree      uint64
+	wouldDeny  uint64
+	wouldAllow uint64
+}
+
+// SetShadow sets the shadow AccessList. The shadow AccessList is evaluated
+// with the same input data after the enforced one. The disagreements
+// between the two are counted and logged. The decisions of the shadow
+// AccessList do not affect the decisions of AccessList.
+func (acl *AccessList) SetShadow(shadow *AccessList) {
+	if shadow == nil {
+		acl.shadow = nil
+	} else {
+		acl.shadow = &shadowPolicy{accessList: shadow}
+	}
+	acl.updateInputFields()
+}
+
+// GetShadowCounters returns the snapshot of the counters of the shadow
+// AccessList. If there is no shadow AccessList, the function returns nil.
+func (acl *AccessList) GetShadowCounters() *ShadowCounters {
+	if acl.shadow == nil {
+		return nil
+	}
+	return &ShadowCounters{
+		Agree:      atomic.LoadUint64(&acl.shadow.agree),
+		WouldDeny:  atomic.LoadUint64(&acl.shadow.wouldDeny),
+		WouldAllow: atomic.LoadUint64(&acl.shadow.wouldAllow),
+	}
+}
+
+// compare evaluates the shadow AccessList and compares its decision with
+// the decision of the enforced AccessList.
+func (s *shadowPolicy) compare(ctx context.Context, data map[string]interface{}, allow bool, logger *zap.Logger) {
+	shadowAllow := s.accessList.Allow(ctx, data)
+	var result string
+	switch {
+	case shadowAllow == allow:
+		atomic.AddUint64(&s.agree, 1)
+		return
+	case allow:
+		atomic.AddUint64(&s.wouldDeny, 1)
+		result = "would_deny"
+	default:
+		atomic.AddUint64(&s.wouldAllow, 1)
+		result = "would_allow"
+	}
+	if logger == nil {
+		return
+	}
+	logger.Info(
+		"acl shadow disagreement",
+		zap.String("result", result),
+		zap.Any("user", data),
+	)
+}
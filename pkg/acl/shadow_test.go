@@ -0,0 +1,124 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acl
+
+import (
+	"context"
+	"github.com/greenpau/caddy-authorize/internal/tests"
+	"github.com/greenpau/caddy-authorize/pkg/utils"
+	"testing"
+)
+
+func TestAccessListShadow(t *testing.T) {
+	var testcases = []struct {
+		name          string
+		config        []*RuleConfiguration
+		shadowConfig  []*RuleConfiguration
+		inputs        []map[string]interface{}
+		want          []bool
+		counters      *ShadowCounters
+		requestFields []string
+	}{
+		{
+			name: "stricter shadow policy",
+			config: []*RuleConfiguration{
+				{
+					Conditions: []string{"match roles admin editor viewer"},
+					Action:     `allow`,
+				},
+			},
+			shadowConfig: []*RuleConfiguration{
+				{
+					Conditions: []string{"match roles admin editor"},
+					Action:     `allow`,
+				},
+				{
+					Conditions: []string{"match roles guest"},
+					Action:     `allow`,
+				},
+			},
+			inputs: []map[string]interface{}{
+				{"roles": []string{"admin"}},
+				{"roles": []string{"viewer"}},
+				{"roles": []string{"viewer"}},
+				{"roles": []string{"guest"}},
+				{"roles": []string{"anonymous"}},
+			},
+			// The shadow policy does not affect the decisions.
+			want: []bool{true, true, true, false, false},
+			counters: &ShadowCounters{
+				Agree:      2,
+				WouldDeny:  2,
+				WouldAllow: 1,
+			},
+		},
+		{
+			name: "shadow policy with request fields",
+			config: []*RuleConfiguration{
+				{
+					Conditions: []string{"match roles viewer"},
+					Action:     `allow`,
+				},
+			},
+			shadowConfig: []*RuleConfiguration{
+				{
+					Conditions: []string{
+						"match roles viewer",
+						"exact match scheme https",
+					},
+					Action: `allow`,
+				},
+			},
+			inputs: []map[string]interface{}{
+				{"roles": []string{"viewer"}, "scheme": "http"},
+				{"roles": []string{"viewer"}, "scheme": "https"},
+			},
+			want: []bool{true, true},
+			counters: &ShadowCounters{
+				Agree:     1,
+				WouldDeny: 1,
+			},
+			requestFields: []string{"scheme"},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			logger := utils.NewLogger()
+			accessList := NewAccessList()
+			accessList.SetLogger(logger)
+			if err := accessList.AddRules(ctx, tc.config); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if accessList.GetShadowCounters() != nil {
+				t.Fatalf("unexpected shadow counters")
+			}
+			shadow := NewAccessList()
+			shadow.SetLogger(logger)
+			if err := shadow.AddRules(ctx, tc.shadowConfig); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			accessList.SetShadow(shadow)
+
+			var got []bool
+			for _, input := range tc.inputs {
+				got = append(got, accessList.Allow(ctx, input))
+			}
+			tests.EvalObjects(t, "decisions", tc.want, got)
+			tests.EvalObjects(t, "counters", tc.counters, accessList.GetShadowCounters())
+			tests.EvalObjects(t, "request fields", tc.requestFields, accessList.GetRequestFields())
+		})
+	}
+}
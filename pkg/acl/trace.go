@@ -0,0 +1,205 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acl
+
+import (
+	"context"
+)
+
+// The reasons for the decisions of AccessList.
+const (
+	// DecisionReasonExplicitAllow is the reason when a rule allowed access.
+	DecisionReasonExplicitAllow = "explicit_allow"
+	// DecisionReasonExplicitDeny is the reason when a rule denied access.
+	DecisionReasonExplicitDeny = "explicit_deny"
+	// DecisionReasonNoAllow is the reason when no rule allowed access.
+	DecisionReasonNoAllow = "no_allow"
+	// DecisionReasonDefaultAllow is the reason when no rule allowed access,
+	// but AccessList allows access by default.
+	DecisionReasonDefaultAllow = "default_allow"
+)
+
+// DecisionTrace is the record of the evaluation of AccessList. It holds the
+// evaluated rules, in order, and the reason for the decision.
+type DecisionTrace struct {
+	Allow  bool         `json:"allow" xml:"allow" yaml:"allow"`
+	Reason string       `json:"reason" xml:"reason" yaml:"reason"`
+	Rules  []*RuleTrace `json:"rules" xml:"rules" yaml:"rules"`
+}
+
+// RuleTrace is the record of the evaluation of an access list rule.
+type RuleTrace struct {
+	Index      int               `json:"index" xml:"index" yaml:"index"`
+	Tag        string            `json:"tag" xml:"tag" yaml:"tag"`
+	Comment    string            `json:"comment" xml:"comment" yaml:"comment"`
+	Conditions []*ConditionTrace `json:"conditions" xml:"conditions" yaml:"conditions"`
+	Verdict    string            `json:"verdict" xml:"verdict" yaml:"verdict"`
+}
+
+// ConditionTrace is the record of the evaluation of a rule condition. The
+// input is the value of the field in the input data. The result of a negated
+// condition is negated.
+type ConditionTrace struct {
+	Condition string      `json:"condition" xml:"condition" yaml:"condition"`
+	Field     string      `json:"field" xml:"field" yaml:"field"`
+	Input     interface{} `json:"input" xml:"input" yaml:"input"`
+	Found     bool        `json:"found" xml:"found" yaml:"found"`
+	Negate    bool        `json:"negate" xml:"negate" yaml:"negate"`
+	Match     bool        `json:"match" xml:"match" yaml:"match"`
+}
+
+type traceContextKey struct{}
+
+// NewTraceContext returns a context carrying an empty decision trace. When
+// the tracing is enabled in AccessList, the Allow function records the
+// decision trace in the context.
+func NewTraceContext(ctx context.Context) (context.Context, *DecisionTrace) {
+	trace := &DecisionTrace{}
+	return context.WithValue(ctx, traceContextKey{}, trace), trace
+}
+
+// EnableTrace enables the recording of decision traces in the contexts
+// created with NewTraceContext. When the tracing is disabled, the Allow
+// function does not look up the trace in the context.
+func (acl *AccessList) EnableTrace() {
+	acl.traceEnabled = true
+}
+
+// AllowWithTrace takes in client identity and metadata and returns the
+// decision along with its trace.
+func (acl *AccessList) AllowWithTrace(ctx context.Context, data map[string]interface{}) (bool, *DecisionTrace) {
+	if acl.file != nil {
+		return acl.file.load().AllowWithTrace(ctx, data)
+	}
+	trace := &DecisionTrace{}
+	return acl.allowWithTrace(ctx, data, trace), trace
+}
+
+func (acl *AccessList) allowWithTrace(ctx context.Context, data map[string]interface{}, trace *DecisionTrace) bool {
+	*trace = DecisionTrace{}
+	var grantAccess bool
+	for i, rule := range acl.rules {
+		v := rule.evalInput(ctx, data, nil)
+		trace.Rules = append(trace.Rules, rule.trace(ctx, i, data, v))
+		switch v {
+		case ruleVerdictAllowStop:
+			return trace.decide(true, DecisionReasonExplicitAllow)
+		case ruleVerdictAllow:
+			grantAccess = true
+		case ruleVerdictDenyStop, ruleVerdictDeny:
+			return trace.decide(false, DecisionReasonExplicitDeny)
+		}
+	}
+	if grantAccess {
+		return trace.decide(true, DecisionReasonExplicitAllow)
+	}
+	if acl.defaultAllow {
+		return trace.decide(true, DecisionReasonDefaultAllow)
+	}
+	return trace.decide(false, DecisionReasonNoAllow)
+}
+
+// GetDecidingRule returns the trace of the rule which decided the outcome,
+// i.e. the rule denying access or the last rule allowing it. When no rule
+// decided the outcome, the function returns nil.
+func (trace *DecisionTrace) GetDecidingRule() *RuleTrace {
+	if trace.Reason != DecisionReasonExplicitAllow && trace.Reason != DecisionReasonExplicitDeny {
+		return nil
+	}
+	for i := len(trace.Rules) - 1; i >= 0; i-- {
+		if trace.Rules[i].Verdict != getRuleVerdictTraceName(ruleVerdictContinue) {
+			return trace.Rules[i]
+		}
+	}
+	return nil
+}
+
+func (trace *DecisionTrace) decide(allow bool, reason string) bool {
+	trace.Allow = allow
+	trace.Reason = reason
+	return allow
+}
+
+// trace returns the record of the evaluation of the rule. The conditions
+// are evaluated again, because the evaluation of the rule stops at the
+// first condition determining the outcome.
+func (rule *aclRule) trace(ctx context.Context, index int, data map[string]interface{}, verdict ruleVerdict) *RuleTrace {
+	t := &RuleTrace{
+		Index:      index,
+		Tag:        rule.config.tag,
+		Comment:    rule.config.comment,
+		Conditions: []*ConditionTrace{},
+		Verdict:    getRuleVerdictTraceName(verdict),
+	}
+	if rule.expr != nil {
+		t.Conditions = traceCondExpr(ctx, rule.expr, data, t.Conditions)
+		return t
+	}
+	for _, m := range rule.matchers {
+		v, found := data[m.field]
+		t.Conditions = append(t.Conditions, &ConditionTrace{
+			Condition: m.condition.getConfig(ctx).input,
+			Field:     m.field,
+			Input:     v,
+			Found:     found,
+			Match:     found && m.condition.match(ctx, v),
+		})
+	}
+	return t
+}
+
+func traceCondExpr(ctx context.Context, expr ruleCondExpr, data map[string]interface{}, traces []*ConditionTrace) []*ConditionTrace {
+	switch c := expr.(type) {
+	case *ruleCondGroup:
+		for _, member := range c.members {
+			traces = traceCondExpr(ctx, member, data, traces)
+		}
+	case *ruleCondLeaf:
+		v, found := data[c.field]
+		traces = append(traces, &ConditionTrace{
+			Condition: c.condition.getConfig(ctx).input,
+			Field:     c.field,
+			Input:     v,
+			Found:     found,
+			Negate:    c.negate,
+			Match:     c.eval(ctx, data),
+		})
+	case *ruleCondPlaceholderLeaf:
+		v, found := data[c.field]
+		traces = append(traces, &ConditionTrace{
+			Condition: c.input,
+			Field:     c.field,
+			Input:     v,
+			Found:     found,
+			Negate:    c.negate,
+			Match:     c.eval(ctx, data),
+		})
+	}
+	return traces
+}
+
+func getRuleVerdictTraceName(s ruleVerdict) string {
+	switch s {
+	case ruleVerdictDeny:
+		return "deny"
+	case ruleVerdictDenyStop:
+		return "deny stop"
+	case ruleVerdictAllow:
+		return "allow"
+	case ruleVerdictAllowStop:
+		return "allow stop"
+	}
+	return "continue"
+}
@@ -0,0 +1,195 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acl
+
+import (
+	"context"
+	"github.com/greenpau/caddy-authorize/internal/tests"
+	"github.com/greenpau/caddy-authorize/pkg/utils"
+	"testing"
+)
+
+func TestAllowWithTrace(t *testing.T) {
+	var testcases = []struct {
+		name         string
+		config       []*RuleConfiguration
+		defaultAllow bool
+		input        map[string]interface{}
+		want         *DecisionTrace
+	}{
+		{
+			name: "explicit deny by second rule",
+			config: []*RuleConfiguration{
+				{
+					Conditions: []string{"match roles admin"},
+					Action:     `allow stop`,
+				},
+				{
+					Comment: "deny guests",
+					Conditions: []string{
+						"match roles guest",
+						"prefix match path /admin",
+					},
+					Action: `deny tag guests`,
+				},
+			},
+			input: map[string]interface{}{
+				"roles": []string{"guest"},
+				"path":  "/admin/users",
+			},
+			want: &DecisionTrace{
+				Reason: DecisionReasonExplicitDeny,
+				Rules: []*RuleTrace{
+					{
+						Index: 0,
+						Tag:   "rule0",
+						Conditions: []*ConditionTrace{
+							{Condition: "match roles admin", Field: "roles", Input: []string{"guest"}, Found: true},
+						},
+						Verdict: "continue",
+					},
+					{
+						Index:   1,
+						Tag:     "guests",
+						Comment: "deny guests",
+						Conditions: []*ConditionTrace{
+							{Condition: "match roles guest", Field: "roles", Input: []string{"guest"}, Found: true, Match: true},
+							{Condition: "prefix match path /admin", Field: "path", Input: "/admin/users", Found: true, Match: true},
+						},
+						Verdict: "deny",
+					},
+				},
+			},
+		},
+		{
+			name: "explicit allow with missing field",
+			config: []*RuleConfiguration{
+				{
+					Conditions: []string{"match org nyc"},
+					Action:     `deny stop`,
+				},
+				{
+					Conditions: []string{"match roles viewer"},
+					Action:     `allow`,
+				},
+			},
+			input: map[string]interface{}{
+				"roles": []string{"viewer"},
+			},
+			want: &DecisionTrace{
+				Allow:  true,
+				Reason: DecisionReasonExplicitAllow,
+				Rules: []*RuleTrace{
+					{
+						Index: 0,
+						Tag:   "rule0",
+						Conditions: []*ConditionTrace{
+							{Condition: "match org nyc", Field: "org"},
+						},
+						Verdict: "continue",
+					},
+					{
+						Index: 1,
+						Tag:   "rule1",
+						Conditions: []*ConditionTrace{
+							{Condition: "match roles viewer", Field: "roles", Input: []string{"viewer"}, Found: true, Match: true},
+						},
+						Verdict: "allow",
+					},
+				},
+			},
+		},
+		{
+			name: "no allow with negated condition",
+			config: []*RuleConfiguration{
+				{
+					Conditions: []string{"not match roles guest"},
+					Action:     `allow stop`,
+				},
+			},
+			input: map[string]interface{}{
+				"roles": []string{"guest"},
+			},
+			want: &DecisionTrace{
+				Reason: DecisionReasonNoAllow,
+				Rules: []*RuleTrace{
+					{
+						Index: 0,
+						Tag:   "rule0",
+						Conditions: []*ConditionTrace{
+							{Condition: "match roles guest", Field: "roles", Input: []string{"guest"}, Found: true, Negate: true},
+						},
+						Verdict: "continue",
+					},
+				},
+			},
+		},
+		{
+			name: "default allow",
+			config: []*RuleConfiguration{
+				{
+					Conditions: []string{"match roles admin"},
+					Action:     `allow`,
+				},
+			},
+			defaultAllow: true,
+			input: map[string]interface{}{
+				"roles": []string{"viewer"},
+			},
+			want: &DecisionTrace{
+				Allow:  true,
+				Reason: DecisionReasonDefaultAllow,
+				Rules: []*RuleTrace{
+					{
+						Index: 0,
+						Tag:   "rule0",
+						Conditions: []*ConditionTrace{
+							{Condition: "match roles admin", Field: "roles", Input: []string{"viewer"}, Found: true},
+						},
+						Verdict: "continue",
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			accessList := NewAccessList()
+			accessList.SetLogger(utils.NewLogger())
+			if tc.defaultAllow {
+				accessList.SetDefaultAllowAction()
+			}
+			if err := accessList.AddRules(ctx, tc.config); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			allow, trace := accessList.AllowWithTrace(ctx, tc.input)
+			if allow != tc.want.Allow {
+				t.Fatalf("unexpected decision: %t (actual) vs. %t (expected)", allow, tc.want.Allow)
+			}
+			tests.EvalObjects(t, "trace", tc.want, trace)
+
+			// The trace is recorded in the context only when enabled.
+			traceCtx, ctxTrace := NewTraceContext(ctx)
+			accessList.Allow(traceCtx, tc.input)
+			tests.EvalObjects(t, "disabled trace", &DecisionTrace{}, ctxTrace)
+			accessList.EnableTrace()
+			if allow := accessList.Allow(traceCtx, tc.input); allow != tc.want.Allow {
+				t.Fatalf("unexpected decision: %t (actual) vs. %t (expected)", allow, tc.want.Allow)
+			}
+			tests.EvalObjects(t, "context trace", tc.want, ctxTrace)
+		})
+	}
+}
@@ -17,15 +17,21 @@ package authz
 import (
 	"context"
 	"fmt"
+	"html/template"
+	"net"
 	"net/http"
 	"net/url"
 	"strings"
 	"time"
 
 	"github.com/greenpau/caddy-authorize/pkg/acl"
+	"github.com/greenpau/caddy-authorize/pkg/errors"
+	"github.com/greenpau/caddy-authorize/pkg/grantor"
 	"github.com/greenpau/caddy-authorize/pkg/handlers"
 	"github.com/greenpau/caddy-authorize/pkg/kms"
 	"github.com/greenpau/caddy-authorize/pkg/options"
+	"github.com/greenpau/caddy-authorize/pkg/user"
+	"github.com/greenpau/caddy-authorize/pkg/utils"
 	"github.com/greenpau/caddy-authorize/pkg/utils/urlutils"
 	"github.com/greenpau/caddy-authorize/pkg/validator"
 	"go.uber.org/zap"
@@ -56,8 +62,17 @@ type Authorizer struct {
 	BypassConfigs []*BypassConfig `json:"bypass_configs,omitempty" xml:"bypass_configs,omitempty" yaml:"bypass_configs,omitempty"`
 	// The list of mappings between header names and field names.
 	HeaderInjectionConfigs []*HeaderInjectionConfig `json:"header_injection_configs,omitempty" xml:"header_injection_configs,omitempty" yaml:"header_injection_configs,omitempty"`
-	AccessListRules        []*acl.RuleConfiguration `json:"access_list_rules,omitempty" xml:"access_list_rules,omitempty" yaml:"access_list_rules,omitempty"`
-	CryptoKeyConfigs       []*kms.CryptoKeyConfig   `json:"crypto_key_configs,omitempty" xml:"crypto_key_configs,omitempty" yaml:"crypto_key_configs,omitempty"`
+	// The names of the headers removed from the requests of authorized
+	// users, in addition to the injected headers.
+	RemoveHeaders   []string                 `json:"remove_headers,omitempty" xml:"remove_headers,omitempty" yaml:"remove_headers,omitempty"`
+	AccessListRules []*acl.RuleConfiguration `json:"access_list_rules,omitempty" xml:"access_list_rules,omitempty" yaml:"access_list_rules,omitempty"`
+	// The path to the JSON or YAML file with the access list rules. The rules
+	// are reloaded when the file changes.
+	AccessListFile string `json:"access_list_file,omitempty" xml:"access_list_file,omitempty" yaml:"access_list_file,omitempty"`
+	// The rules of the shadow access list. The shadow access list is evaluated
+	// alongside the enforced one, but its decisions are not enforced.
+	ShadowAccessListRules []*acl.RuleConfiguration `json:"shadow_access_list_rules,omitempty" xml:"shadow_access_list_rules,omitempty" yaml:"shadow_access_list_rules,omitempty"`
+	CryptoKeyConfigs      []*kms.CryptoKeyConfig   `json:"crypto_key_configs,omitempty" xml:"crypto_key_configs,omitempty" yaml:"crypto_key_configs,omitempty"`
 	// CryptoKeyStoreConfig hold the default configuration for the keys, e.g. token name and lifetime.
 	CryptoKeyStoreConfig        map[string]interface{} `json:"crypto_key_store_config,omitempty" xml:"crypto_key_store_config,omitempty" yaml:"crypto_key_store_config,omitempty"`
 	AllowedTokenSources         []string               `json:"allowed_token_sources,omitempty" xml:"allowed_token_sources,omitempty" yaml:"allowed_token_sources,omitempty"`
@@ -69,27 +84,89 @@ type Authorizer struct {
 	ValidateAccessListPathClaim bool                   `json:"validate_access_list_path_claim,omitempty" xml:"validate_access_list_path_claim,omitempty" yaml:"validate_access_list_path_claim,omitempty"`
 	ValidateSourceAddress       bool                   `json:"validate_source_address,omitempty" xml:"validate_source_address,omitempty" yaml:"validate_source_address,omitempty"`
 	PassClaimsWithHeaders       bool                   `json:"pass_claims_with_headers,omitempty" xml:"pass_claims_with_headers,omitempty" yaml:"pass_claims_with_headers,omitempty"`
-	tokenValidator              *validator.TokenValidator
-	opts                        *options.TokenValidatorOptions
-	accessList                  *acl.AccessList
+	// The configuration of the access list decision traces.
+	AccessListTrace *AccessListTraceConfig `json:"access_list_trace,omitempty" xml:"access_list_trace,omitempty" yaml:"access_list_trace,omitempty"`
+	// The named access list policies and the routes selecting them by the
+	// path prefix and the method of a request. The requests not matching any
+	// route are evaluated with the access list of the instance.
+	AccessListPolicies []*AccessListPolicy `json:"access_list_policies,omitempty" xml:"access_list_policies,omitempty" yaml:"access_list_policies,omitempty"`
+	AccessListRoutes   []*AccessListRoute  `json:"access_list_routes,omitempty" xml:"access_list_routes,omitempty" yaml:"access_list_routes,omitempty"`
+	// The roles implied by other roles and the aliases of the roles. The roles
+	// of a user are expanded prior to the evaluation of the access list.
+	RoleHierarchy *user.RoleHierarchy `json:"role_hierarchy,omitempty" xml:"role_hierarchy,omitempty" yaml:"role_hierarchy,omitempty"`
+	// The paths to the html/template files of the unauthorized, forbidden
+	// and redirect pages.
+	PageTemplates map[string]string `json:"page_templates,omitempty" xml:"page_templates,omitempty" yaml:"page_templates,omitempty"`
+	// The IP addresses and the networks of the proxies trusted to set the
+	// X-Forwarded headers of the requests.
+	TrustedProxies []string `json:"trusted_proxies,omitempty" xml:"trusted_proxies,omitempty" yaml:"trusted_proxies,omitempty"`
+	// The hosts allowed in the redirect URL passed to the auth URL.
+	RedirectHosts []string `json:"redirect_hosts,omitempty" xml:"redirect_hosts,omitempty" yaml:"redirect_hosts,omitempty"`
+	// The hosts allowed in the auth URL derived from the issuer of an
+	// expired token, in addition to the host of the auth URL.
+	AuthURLHosts []string `json:"auth_url_hosts,omitempty" xml:"auth_url_hosts,omitempty" yaml:"auth_url_hosts,omitempty"`
+	// The configuration of the token issued to the upstream services in
+	// exchange for the validated token.
+	UpstreamTokenConfig *UpstreamTokenConfig `json:"upstream_token_config,omitempty" xml:"upstream_token_config,omitempty" yaml:"upstream_token_config,omitempty"`
+	// The configuration of the sliding sessions.
+	SessionRefreshConfig *SessionRefreshConfig `json:"session_refresh_config,omitempty" xml:"session_refresh_config,omitempty" yaml:"session_refresh_config,omitempty"`
+	// The attributes of the auth cookies set by the plugin, by token name.
+	CookieConfigs  []*CookieConfig `json:"cookie_configs,omitempty" xml:"cookie_configs,omitempty" yaml:"cookie_configs,omitempty"`
+	tokenValidator *validator.TokenValidator
+	opts           *options.TokenValidatorOptions
+	accessList     *acl.AccessList
+	// The compiled access list routes, longest path first.
+	policyRoutes []*policyRoute
+	// The access lists of the access list policies, by policy name.
+	policyAccessLists map[string]*acl.AccessList
 	// Enable authorization bypass for specific URIs.
 	bypassEnabled bool
-	// The names of the headers injected by an instance.
-	injectedHeaders     map[string]bool
+	// The names of the headers removed from the requests prior to the
+	// header injection.
+	removedHeaders []string
+	// The templates of the pages, by page name.
+	pages map[string]*template.Template
+	// The parsed networks of the trusted proxies.
+	trustedProxies []*net.IPNet
+	// The grantor of the upstream tokens.
+	grantor *grantor.TokenGrantor
+	// The key store signing the refreshed tokens of the sliding sessions.
+	keystore *kms.CryptoKeyStore
+	// The hooks checking whether the tokens are revoked, and the ones of
+	// the primary instance.
+	revocationHooks        *revocationHooks
+	primaryRevocationHooks *revocationHooks
+	// The cookie configs, by token name.
+	cookieConfigs       map[string]*CookieConfig
 	logger              *zap.Logger
 	startedAt           time.Time
 	primaryInstanceName string
+	// The instance manager and the generation the instance belongs to.
+	manager    *InstanceManager
+	generation *instanceGeneration
 }
 
 // Provision provisions JWT authorization provider instances.
 func (m *Authorizer) Provision(upstreamOptions map[string]interface{}) error {
+	return m.ProvisionWithManager(AuthManager, upstreamOptions)
+}
+
+// ProvisionWithManager provisions the instance with the provided instance
+// manager, as opposed to the global AuthManager. The named policies use
+// their own manager, so that they do not depend on the other instances.
+// The "context" option holds the context of the config being loaded. The
+// instances provisioned with the same context belong to the same generation.
+func (m *Authorizer) ProvisionWithManager(mgr *InstanceManager, upstreamOptions map[string]interface{}) error {
 	ctx := context.Background()
+	if v, ok := upstreamOptions["context"].(context.Context); ok && v != nil {
+		ctx = v
+	}
 	if _, exists := upstreamOptions["logger"]; !exists {
 		return fmt.Errorf("configuration requires valid logger")
 	}
 	m.logger = upstreamOptions["logger"].(*zap.Logger)
 	m.startedAt = time.Now().UTC()
-	if err := AuthManager.Register(ctx, m); err != nil {
+	if err := mgr.Register(ctx, m); err != nil {
 		return err
 	}
 	m.logger.Info(
@@ -102,8 +179,14 @@ func (m *Authorizer) Provision(upstreamOptions map[string]interface{}) error {
 
 // Validate implements caddy.Validator.
 func (m *Authorizer) Validate() error {
+	return m.ValidateWithManager(AuthManager)
+}
+
+// ValidateWithManager validates the instance provisioned with the provided
+// instance manager.
+func (m *Authorizer) ValidateWithManager(mgr *InstanceManager) error {
 	ctx := context.Background()
-	if err := AuthManager.Validate(ctx, m); err != nil {
+	if err := mgr.Validate(ctx, m); err != nil {
 		return err
 	}
 	m.logger.Info(
@@ -113,6 +196,19 @@ func (m *Authorizer) Validate() error {
 	return nil
 }
 
+// Cleanup stops the background activities of an Authorizer instance, e.g.
+// watching the access list file, and removes the instance from its instance
+// manager.
+func (m *Authorizer) Cleanup() error {
+	if m.accessList != nil {
+		m.accessList.Close()
+	}
+	if m.manager != nil {
+		m.manager.Unregister(m)
+	}
+	return nil
+}
+
 // Authenticate authorizes access based on the presense and content of JWT token.
 func (m Authorizer) Authenticate(w http.ResponseWriter, r *http.Request, upstreamOptions map[string]interface{}) (map[string]interface{}, bool, error) {
 	var sessionID string
@@ -131,54 +227,93 @@ func (m Authorizer) Authenticate(w http.ResponseWriter, r *http.Request, upstrea
 		}
 	}
 
-	usr, err := m.tokenValidator.Authorize(ctx, r)
+	var trace *acl.DecisionTrace
+	if m.AccessListTrace != nil {
+		ctx, trace = acl.NewTraceContext(ctx)
+	}
+
+	if resolver, ok := upstreamOptions["replacer"].(utils.PlaceholderResolver); ok {
+		ctx = utils.NewPlaceholderResolverContext(ctx, resolver)
+	}
+
+	var decision *acl.Decision
+	repl, publishPlaceholders := upstreamOptions["replacer"].(placeholderSetter)
+	if publishPlaceholders {
+		ctx, decision = acl.NewDecisionContext(ctx)
+	}
+
+	tokenValidator := m.getTokenValidator(r)
+	usr, err := tokenValidator.Authorize(ctx, r)
+	if err == nil && trace != nil && trace.Reason == "" && m.isTraceRequester(usr) {
+		// The access list is not evaluated for the cached users, unless
+		// the requester gets the decision trace in the response.
+		err = tokenValidator.ReauthorizeUser(ctx, r, usr)
+	}
+	if err == nil && m.isRevoked(usr) {
+		err = errors.ErrTokenRevoked
+	}
+	if trace != nil {
+		m.handleTrace(w, usr, trace)
+	}
 	if err != nil {
+		reason := errors.GetReasonCode(err)
 		m.logger.Debug(
 			"token validation error",
 			zap.String("session_id", sessionID),
+			zap.String("reason", string(reason)),
 			zap.String("error", err.Error()),
 		)
-		if strings.Contains(err.Error(), "user role is valid, but not allowed by") {
+		denialCounter.WithLabelValues(m.Context, string(reason)).Inc()
+		apiRequest := handlers.IsAPIRequest(r)
+		if reason == errors.ReasonACLDenied || reason == errors.ReasonPathClaimDenied {
+			if apiRequest {
+				handlers.HandleBearerError(w, 403, handlers.BearerErrorInsufficientScope, reason, getReasonDetail(reason))
+				return nil, false, err
+			}
 			if m.ForbiddenURL != "" {
-				if strings.Contains(m.ForbiddenURL, "{") && strings.Contains(m.ForbiddenURL, "}") {
-					// Run through placeholder replacer.
-					redirectLocation := m.ForbiddenURL
-					for _, placeholder := range placeholders {
-						switch placeholder {
-						case "uri", "http.request.uri":
-							redirectLocation = strings.ReplaceAll(redirectLocation, "{"+placeholder+"}", r.URL.String())
-						case "url":
-							redirectLocation = strings.ReplaceAll(redirectLocation, "{"+placeholder+"}", urlutils.GetCurrentURL(r))
-						}
-					}
-					w.Header().Set("Location", redirectLocation)
-				} else {
-					w.Header().Set("Location", m.ForbiddenURL)
-				}
+				w.Header().Set("Location", m.getForbiddenURL(r))
 				w.WriteHeader(303)
-			} else {
-				w.WriteHeader(403)
+				w.Write([]byte(`Forbidden`))
+				return nil, false, err
 			}
-			w.Write([]byte(`Forbidden`))
+			m.handlePage(w, r, 403, handlers.PageForbidden, handlers.NewPageData(r, reason, getPageClaims(usr)))
 			return nil, false, err
 		}
 		// Expire authentication cookies.
-		tvCookies := m.tokenValidator.GetAuthCookies()
+		tvCookies := tokenValidator.GetAuthCookies()
 		if tvCookies != nil {
 			for _, cookie := range r.Cookies() {
 				if _, exists := tvCookies[cookie.Name]; exists {
-					w.Header().Add("Set-Cookie", cookie.Name+"=delete; path=/; expires=Thu, 01 Jan 1970 00:00:00 GMT")
+					w.Header().Add("Set-Cookie", m.newExpiredCookie(r, cookie.Name).String())
 				}
 			}
 		}
+		// API clients are not redirected to the login page.
+		if apiRequest {
+			if reason == errors.ReasonNoToken {
+				handlers.HandleBearerError(w, 401, "", reason, getReasonDetail(reason))
+			} else {
+				handlers.HandleBearerError(w, 401, handlers.BearerErrorInvalidToken, reason, getReasonDetail(reason))
+			}
+			return nil, false, err
+		}
 		// If enabled, handle redirect.
 		if !m.AuthRedirectDisabled {
 			redirOpts := make(map[string]interface{})
 			if usr != nil {
-				// If the issuer URL contains callback URL, then redirect to it.
+				// If the issuer URL contains callback URL, then redirect to it,
+				// provided its host is allowed.
 				if usr.Authenticator.URL != "" && strings.HasPrefix(usr.Authenticator.URL, "http") {
-					usr.Authenticator.URL = strings.TrimSuffix(usr.Authenticator.URL, "authorization-code-callback")
-					redirOpts["auth_url_path"] = usr.Authenticator.URL
+					if m.isAllowedAuthURL(usr.Authenticator.URL) {
+						usr.Authenticator.URL = strings.TrimSuffix(usr.Authenticator.URL, "authorization-code-callback")
+						redirOpts["auth_url_path"] = usr.Authenticator.URL
+					} else {
+						m.logger.Warn(
+							"refused auth url derived from token issuer",
+							zap.String("session_id", sessionID),
+							zap.String("auth_url", usr.Authenticator.URL),
+						)
+					}
 				}
 			}
 			if _, exists := redirOpts["auth_url_path"]; !exists {
@@ -186,11 +321,19 @@ func (m Authorizer) Authenticate(w http.ResponseWriter, r *http.Request, upstrea
 			}
 			redirOpts["auth_redirect_query_disabled"] = m.AuthRedirectQueryDisabled
 			redirOpts["redirect_param"] = m.AuthRedirectQueryParameter
+			redirOpts["trusted_proxies"] = m.trustedProxies
+			if len(m.RedirectHosts) > 0 {
+				redirOpts["redirect_hosts"] = m.RedirectHosts
+			}
 			if m.AuthRedirectStatusCode > 0 {
 				redirOpts["auth_redirect_status_code"] = m.AuthRedirectStatusCode
 			}
 			//redirOpts["logger"] = m.logger
-			if m.RedirectWithJavascript {
+			if m.RedirectWithJavascript && handlers.AcceptsHTML(r) {
+				if tmpl, exists := m.pages[handlers.PageRedirect]; exists {
+					redirOpts["template"] = tmpl
+				}
+				redirOpts["page_data"] = handlers.NewPageData(r, reason, getPageClaims(usr))
 				handlers.HandleJSRedirect(w, r, redirOpts)
 			} else {
 				m.logger.Debug(
@@ -198,55 +341,162 @@ func (m Authorizer) Authenticate(w http.ResponseWriter, r *http.Request, upstrea
 				)
 				handlers.HandleHeaderRedirect(w, r, redirOpts)
 			}
+		} else if _, exists := m.pages[handlers.PageUnauthorized]; exists {
+			m.handlePage(w, r, 401, handlers.PageUnauthorized, handlers.NewPageData(r, reason, getPageClaims(usr)))
 		}
 		return nil, false, err
 	}
 
 	m.injectHeaders(r, usr)
 	m.stripAuthToken(r, usr)
+	if m.grantor != nil {
+		if err := m.grantUpstreamToken(r, usr); err != nil {
+			m.logger.Error(
+				"upstream token error",
+				zap.String("session_id", sessionID),
+				zap.String("error", err.Error()),
+			)
+			w.WriteHeader(500)
+			w.Write([]byte(`Internal Server Error`))
+			return nil, false, err
+		}
+	}
+	if publishPlaceholders {
+		m.setPlaceholders(repl, usr, decision)
+	}
+	if m.keystore != nil {
+		if err := m.refreshSession(w, r, usr); err != nil {
+			m.logger.Warn(
+				"session refresh error",
+				zap.String("session_id", sessionID),
+				zap.String("error", err.Error()),
+			)
+		}
+	}
 	if usr.Cached {
 		return usr.GetRequestIdentity(), true, nil
 	}
 
-	userIdentity := make(map[string]interface{})
-	userIdentity["roles"] = strings.Join(usr.Claims.Roles, " ")
-	if usr.Claims.ID != "" {
-		userIdentity["claim_id"] = usr.Claims.ID
+	userIdentity := m.getUserIdentity(usr)
+	usr.SetRequestIdentity(userIdentity)
+
+	if err := tokenValidator.CacheUser(usr); err != nil {
+		m.logger.Error(
+			"token caching error",
+			zap.String("session_id", sessionID),
+			zap.String("error", err.Error()),
+		)
 	}
-	if usr.Claims.Subject != "" {
-		userIdentity["sub"] = usr.Claims.Subject
+	return userIdentity, true, nil
+}
+
+// getForbiddenURL returns the forbidden URL with the placeholders replaced.
+// The {url} placeholder is replaced with an empty string when the host of
+// the request is not one of the redirect hosts.
+func (m *Authorizer) getForbiddenURL(r *http.Request) string {
+	if !strings.Contains(m.ForbiddenURL, "{") || !strings.Contains(m.ForbiddenURL, "}") {
+		return m.ForbiddenURL
 	}
-	if usr.Claims.Email != "" {
-		userIdentity["email"] = usr.Claims.Email
-	}
-
-	switch m.UserIdentityField {
-	case "sub", "subject":
-		userIdentity["id"] = usr.Claims.Subject
-	case "id":
-		userIdentity["id"] = usr.Claims.ID
-	default:
-		if usr.Claims.Email == "" {
-			userIdentity["id"] = usr.Claims.Subject
-		} else {
-			userIdentity["id"] = usr.Claims.Email
+	// Run through placeholder replacer.
+	redirectLocation := m.ForbiddenURL
+	for _, placeholder := range placeholders {
+		switch placeholder {
+		case "uri", "http.request.uri":
+			redirectLocation = strings.ReplaceAll(redirectLocation, "{"+placeholder+"}", r.URL.String())
+		case "url":
+			var currentURL string
+			baseURL := urlutils.GetTrustedBaseURL(r, m.trustedProxies)
+			if u, err := url.Parse(baseURL); err == nil && (len(m.RedirectHosts) == 0 || urlutils.IsAllowedHost(u.Host, m.RedirectHosts)) {
+				currentURL = baseURL + r.URL.Path
+			}
+			redirectLocation = strings.ReplaceAll(redirectLocation, "{"+placeholder+"}", currentURL)
 		}
 	}
+	return redirectLocation
+}
 
-	if usr.Claims.Name != "" {
-		userIdentity["name"] = usr.Claims.Name
+// isAllowedAuthURL returns true when the host of the auth URL is either the
+// host of the configured auth URL, or one of the allowed auth URL hosts.
+func (m *Authorizer) isAllowedAuthURL(s string) bool {
+	u, err := url.Parse(s)
+	if err != nil || u.Host == "" {
+		return false
 	}
-	if usr.Claims.Email != "" {
-		userIdentity["email"] = usr.Claims.Email
+	if authURL, err := url.Parse(m.AuthURLPath); err == nil && authURL.Host != "" {
+		if strings.EqualFold(u.Host, authURL.Host) {
+			return true
+		}
 	}
-	usr.SetRequestIdentity(userIdentity)
+	return urlutils.IsAllowedHost(u.Host, m.AuthURLHosts)
+}
 
-	if err := m.tokenValidator.CacheUser(usr); err != nil {
+// handlePage responds with the page to the clients accepting HTML, and
+// with the status text to the others.
+func (m *Authorizer) handlePage(w http.ResponseWriter, r *http.Request, code int, name string, data *handlers.PageData) {
+	if tmpl, exists := m.pages[name]; exists && handlers.AcceptsHTML(r) {
+		err := handlers.HandlePage(w, code, tmpl, data)
+		if err == nil {
+			return
+		}
 		m.logger.Error(
-			"token caching error",
-			zap.String("session_id", sessionID),
+			"failed rendering page",
+			zap.String("page", name),
 			zap.String("error", err.Error()),
 		)
 	}
-	return userIdentity, true, nil
+	w.WriteHeader(code)
+	w.Write([]byte(http.StatusText(code)))
+}
+
+// getPageClaims returns the claims of the user available to the page
+// templates. The token and the other sensitive claims are omitted.
+func getPageClaims(usr *user.User) map[string]interface{} {
+	if usr == nil || usr.Claims == nil {
+		return nil
+	}
+	claims := make(map[string]interface{})
+	if usr.Claims.Name != "" {
+		claims["name"] = usr.Claims.Name
+	}
+	if usr.Claims.Email != "" {
+		claims["email"] = usr.Claims.Email
+	}
+	if usr.Claims.Subject != "" {
+		claims["sub"] = usr.Claims.Subject
+	}
+	if usr.Claims.Username != "" {
+		claims["username"] = usr.Claims.Username
+	}
+	if usr.Claims.Issuer != "" {
+		claims["iss"] = usr.Claims.Issuer
+	}
+	if usr.Claims.PictureURL != "" {
+		claims["picture"] = usr.Claims.PictureURL
+	}
+	if len(usr.Claims.Roles) > 0 {
+		claims["roles"] = usr.Claims.Roles
+	}
+	return claims
+}
+
+var reasonDetails = map[errors.ReasonCode]string{
+	errors.ReasonNoToken:         "the request has no access token",
+	errors.ReasonMalformed:       "the access token is malformed",
+	errors.ReasonBadSignature:    "the access token signature is invalid",
+	errors.ReasonExpired:         "the access token is expired",
+	errors.ReasonNotYetValid:     "the access token is not yet valid",
+	errors.ReasonIssuerMismatch:  "the access token issuer is not trusted",
+	errors.ReasonACLDenied:       "the access token does not grant access to the resource",
+	errors.ReasonAddressMismatch: "the access token is bound to another source address",
+	errors.ReasonPathClaimDenied: "the access token does not grant access to the path",
+	errors.ReasonRevoked:         "the access token is revoked",
+}
+
+// getReasonDetail returns the description of the reason code, suitable
+// for the responses to the clients.
+func getReasonDetail(reason errors.ReasonCode) string {
+	if detail, exists := reasonDetails[reason]; exists {
+		return detail
+	}
+	return "the access token is invalid"
 }
@@ -0,0 +1,129 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/greenpau/caddy-authorize/pkg/utils/urlutils"
+)
+
+// CookieConfig holds the attributes of the auth cookies the plugin sets,
+// i.e. the refreshed tokens and the expired cookies. The attributes must
+// match the ones the cookies were set with, otherwise the browsers keep
+// the expired cookies.
+type CookieConfig struct {
+	// The name of the token the attributes apply to. The config without
+	// the token name applies to the tokens having no config of their own.
+	TokenName string `json:"token_name,omitempty" xml:"token_name,omitempty" yaml:"token_name,omitempty"`
+	Domain    string `json:"domain,omitempty" xml:"domain,omitempty" yaml:"domain,omitempty"`
+	// The path of the cookie. Defaults to /.
+	Path string `json:"path,omitempty" xml:"path,omitempty" yaml:"path,omitempty"`
+	// The SameSite attribute, i.e. lax, strict, or none. Defaults to lax.
+	SameSite string `json:"same_site,omitempty" xml:"same_site,omitempty" yaml:"same_site,omitempty"`
+	// Set the Secure attribute regardless of the scheme of the request. By
+	// default, the attribute is set for HTTPS requests only.
+	Secure bool `json:"secure,omitempty" xml:"secure,omitempty" yaml:"secure,omitempty"`
+	// Disable the HttpOnly attribute.
+	HTTPOnlyDisabled bool `json:"http_only_disabled,omitempty" xml:"http_only_disabled,omitempty" yaml:"http_only_disabled,omitempty"`
+}
+
+var cookieSameSiteModes = map[string]http.SameSite{
+	"":       http.SameSiteLaxMode,
+	"lax":    http.SameSiteLaxMode,
+	"strict": http.SameSiteStrictMode,
+	"none":   http.SameSiteNoneMode,
+}
+
+// Validate validates CookieConfig.
+func (cfg *CookieConfig) Validate() error {
+	if strings.ContainsAny(cfg.TokenName, " ;=") {
+		return fmt.Errorf("cookie token name %q is invalid", cfg.TokenName)
+	}
+	if strings.ContainsAny(cfg.Domain, " ;/") {
+		return fmt.Errorf("cookie domain %q is invalid", cfg.Domain)
+	}
+	if cfg.Path != "" && (!strings.HasPrefix(cfg.Path, "/") || strings.ContainsAny(cfg.Path, " ;")) {
+		return fmt.Errorf("cookie path %q is invalid", cfg.Path)
+	}
+	if _, exists := cookieSameSiteModes[cfg.SameSite]; !exists {
+		return fmt.Errorf("cookie samesite %q is unsupported", cfg.SameSite)
+	}
+	return nil
+}
+
+// configureCookies validates the cookie configs and indexes them by token
+// name.
+func (m *Authorizer) configureCookies() error {
+	m.cookieConfigs = make(map[string]*CookieConfig)
+	for _, cfg := range m.CookieConfigs {
+		if err := cfg.Validate(); err != nil {
+			return err
+		}
+		if _, exists := m.cookieConfigs[cfg.TokenName]; exists {
+			return fmt.Errorf("duplicate cookie config for token name %q", cfg.TokenName)
+		}
+		m.cookieConfigs[cfg.TokenName] = cfg
+	}
+	return nil
+}
+
+// getCookieConfig returns the cookie config of the token name, the default
+// cookie config, or the empty one.
+func (m *Authorizer) getCookieConfig(tokenName string) *CookieConfig {
+	if cfg, exists := m.cookieConfigs[tokenName]; exists {
+		return cfg
+	}
+	if cfg, exists := m.cookieConfigs[""]; exists {
+		return cfg
+	}
+	return &CookieConfig{}
+}
+
+// newCookie returns the cookie with the attributes of the cookie config of
+// the token name.
+func (m *Authorizer) newCookie(r *http.Request, name, value string, maxAge int) *http.Cookie {
+	cfg := m.getCookieConfig(name)
+	cookie := &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Domain:   cfg.Domain,
+		Path:     cfg.Path,
+		MaxAge:   maxAge,
+		HttpOnly: !cfg.HTTPOnlyDisabled,
+		SameSite: cookieSameSiteModes[cfg.SameSite],
+	}
+	if cookie.Path == "" {
+		cookie.Path = "/"
+	}
+	// The browsers reject the cookies with SameSite=None without Secure.
+	if cfg.Secure || cookie.SameSite == http.SameSiteNoneMode {
+		cookie.Secure = true
+	} else {
+		cookie.Secure = strings.HasPrefix(urlutils.GetTrustedBaseURL(r, m.trustedProxies), "https://")
+	}
+	return cookie
+}
+
+// newExpiredCookie returns the cookie expiring the auth cookie having the
+// name.
+func (m *Authorizer) newExpiredCookie(r *http.Request, name string) *http.Cookie {
+	cookie := m.newCookie(r, name, "delete", -1)
+	cookie.Expires = time.Unix(0, 0)
+	return cookie
+}
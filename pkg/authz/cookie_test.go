@@ -0,0 +1,159 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"fmt"
+	"github.com/greenpau/caddy-authorize/internal/tests"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCookieConfig(t *testing.T) {
+	var testcases = []struct {
+		name      string
+		configs   []*CookieConfig
+		shouldErr bool
+		err       error
+	}{
+		{
+			name: "valid configs",
+			configs: []*CookieConfig{
+				{Domain: "contoso.com"},
+				{TokenName: "access_token", Domain: "app.contoso.com", Path: "/app", SameSite: "strict", Secure: true},
+			},
+		},
+		{
+			name:      "config with invalid domain",
+			configs:   []*CookieConfig{{Domain: "contoso.com; Path=/"}},
+			shouldErr: true,
+			err:       fmt.Errorf(`cookie domain "contoso.com; Path=/" is invalid`),
+		},
+		{
+			name:      "config with relative path",
+			configs:   []*CookieConfig{{Path: "app"}},
+			shouldErr: true,
+			err:       fmt.Errorf(`cookie path "app" is invalid`),
+		},
+		{
+			name:      "config with unsupported samesite",
+			configs:   []*CookieConfig{{SameSite: "default"}},
+			shouldErr: true,
+			err:       fmt.Errorf(`cookie samesite "default" is unsupported`),
+		},
+		{
+			name: "duplicate configs",
+			configs: []*CookieConfig{
+				{TokenName: "access_token", Domain: "contoso.com"},
+				{TokenName: "access_token", Path: "/app"},
+			},
+			shouldErr: true,
+			err:       fmt.Errorf(`duplicate cookie config for token name "access_token"`),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := &Authorizer{CookieConfigs: tc.configs}
+			err := m.configureCookies()
+			tests.EvalErr(t, err, tc.configs, tc.shouldErr, tc.err)
+		})
+	}
+}
+
+func TestNewCookie(t *testing.T) {
+	m := &Authorizer{
+		CookieConfigs: []*CookieConfig{
+			{Domain: "contoso.com"},
+			{TokenName: "access_token", Domain: "app.contoso.com", Path: "/app", SameSite: "strict", HTTPOnlyDisabled: true},
+			{TokenName: "jwt_access_token", SameSite: "none"},
+			{TokenName: "id_token", Secure: true},
+		},
+	}
+	if err := m.configureCookies(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var testcases = []struct {
+		name    string
+		url     string
+		cookie  string
+		expired bool
+		want    string
+	}{
+		{
+			name:   "cookie with default config",
+			url:    "http://app.contoso.com/",
+			cookie: "refresh_token",
+			want:   "refresh_token=foo; Path=/; Domain=contoso.com; Max-Age=300; HttpOnly; SameSite=Lax",
+		},
+		{
+			name:   "cookie with default config over https",
+			url:    "https://app.contoso.com/",
+			cookie: "refresh_token",
+			want:   "refresh_token=foo; Path=/; Domain=contoso.com; Max-Age=300; HttpOnly; Secure; SameSite=Lax",
+		},
+		{
+			name:   "cookie with token name config",
+			url:    "http://app.contoso.com/",
+			cookie: "access_token",
+			want:   "access_token=foo; Path=/app; Domain=app.contoso.com; Max-Age=300; SameSite=Strict",
+		},
+		{
+			name:   "cookie with samesite none",
+			url:    "http://app.contoso.com/",
+			cookie: "jwt_access_token",
+			want:   "jwt_access_token=foo; Path=/; Max-Age=300; HttpOnly; Secure; SameSite=None",
+		},
+		{
+			name:   "cookie with secure attribute",
+			url:    "http://app.contoso.com/",
+			cookie: "id_token",
+			want:   "id_token=foo; Path=/; Max-Age=300; HttpOnly; Secure; SameSite=Lax",
+		},
+		{
+			name:    "expired cookie with token name config",
+			url:     "http://app.contoso.com/",
+			cookie:  "access_token",
+			expired: true,
+			want:    "access_token=delete; Path=/app; Domain=app.contoso.com; Expires=Thu, 01 Jan 1970 00:00:00 GMT; Max-Age=0; SameSite=Strict",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", tc.url, nil)
+			var got string
+			if tc.expired {
+				got = m.newExpiredCookie(r, tc.cookie).String()
+			} else {
+				got = m.newCookie(r, tc.cookie, "foo", 300).String()
+			}
+			tests.EvalObjects(t, "cookie", tc.want, got)
+		})
+	}
+}
+
+func TestAuthenticateExpiresCookies(t *testing.T) {
+	m := newEndpointTestAuthorizer(t, []*CookieConfig{
+		{TokenName: "access_token", Domain: "contoso.com", Path: "/app"},
+	})
+	r := httptest.NewRequest("GET", "https://app.contoso.com/app/dashboard", nil)
+	r.Header.Set("Cookie", "access_token=invalid")
+	w := httptest.NewRecorder()
+	_, ok, _ := m.Authenticate(w, r, nil)
+	tests.EvalObjects(t, "authorized", false, ok)
+	want := []string{
+		"access_token=delete; Path=/app; Domain=contoso.com; Expires=Thu, 01 Jan 1970 00:00:00 GMT; Max-Age=0; HttpOnly; Secure; SameSite=Lax",
+	}
+	tests.EvalObjects(t, "cookies", want, w.Header()["Set-Cookie"])
+}
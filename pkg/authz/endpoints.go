@@ -0,0 +1,228 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/greenpau/caddy-authorize/pkg/errors"
+	"github.com/greenpau/caddy-authorize/pkg/handlers"
+	"github.com/greenpau/caddy-authorize/pkg/user"
+	"github.com/greenpau/caddy-authorize/pkg/utils/urlutils"
+)
+
+// hiddenClaims are the claims omitted from the responses of the whoami
+// endpoint, i.e. the address bound to the token, the identifier of the
+// token, and the tokens embedded in the claims.
+var hiddenClaims = map[string]bool{
+	"addr":          true,
+	"jti":           true,
+	"access_token":  true,
+	"id_token":      true,
+	"refresh_token": true,
+}
+
+// LogoutConfig is the configuration of the logout endpoint.
+type LogoutConfig struct {
+	// The URL the users are redirected to after the logout. Defaults to
+	// the auth URL of the instance.
+	RedirectURL string `json:"redirect_url,omitempty" xml:"redirect_url,omitempty" yaml:"redirect_url,omitempty"`
+	// The HTTP methods of the logout requests, i.e. GET and POST. Defaults
+	// to both.
+	AllowedMethods []string `json:"allowed_methods,omitempty" xml:"allowed_methods,omitempty" yaml:"allowed_methods,omitempty"`
+}
+
+// WhoamiResponse is the response of the whoami endpoint.
+type WhoamiResponse struct {
+	ID          string                 `json:"id,omitempty" xml:"id,omitempty" yaml:"id,omitempty"`
+	Roles       []string               `json:"roles,omitempty" xml:"roles,omitempty" yaml:"roles,omitempty"`
+	TokenSource string                 `json:"token_source,omitempty" xml:"token_source,omitempty" yaml:"token_source,omitempty"`
+	Claims      map[string]interface{} `json:"claims,omitempty" xml:"claims,omitempty" yaml:"claims,omitempty"`
+}
+
+// Validate validates LogoutConfig.
+func (cfg *LogoutConfig) Validate() error {
+	if cfg.RedirectURL != "" && !strings.HasPrefix(cfg.RedirectURL, "/") &&
+		!strings.HasPrefix(cfg.RedirectURL, "http://") && !strings.HasPrefix(cfg.RedirectURL, "https://") {
+		return fmt.Errorf("logout redirect url %q is invalid", cfg.RedirectURL)
+	}
+	for i, method := range cfg.AllowedMethods {
+		method = strings.ToUpper(method)
+		if method != http.MethodGet && method != http.MethodPost {
+			return fmt.Errorf("logout method %q is unsupported", cfg.AllowedMethods[i])
+		}
+		cfg.AllowedMethods[i] = method
+	}
+	return nil
+}
+
+// getAllowedMethods returns the HTTP methods of the logout requests.
+func (cfg *LogoutConfig) getAllowedMethods() []string {
+	if len(cfg.AllowedMethods) == 0 {
+		return []string{http.MethodGet, http.MethodPost}
+	}
+	return cfg.AllowedMethods
+}
+
+// HandleWhoami responds with the identity and the claims of the user
+// making the request. The claims in hiddenClaims are omitted. The token
+// is validated and checked for revocation, but the access list does not
+// apply, i.e. the endpoint path need not be allowed. The requests of the
+// users with invalid tokens are responded to with the bearer errors.
+func (m *Authorizer) HandleWhoami(w http.ResponseWriter, r *http.Request) error {
+	usr, err := m.getTokenValidator(r).Authenticate(context.Background(), r)
+	if err == nil && m.isRevoked(usr) {
+		err = errors.ErrTokenRevoked
+	}
+	if err != nil {
+		reason := errors.GetReasonCode(err)
+		switch reason {
+		case errors.ReasonNoToken:
+			handlers.HandleBearerError(w, 401, "", reason, getReasonDetail(reason))
+		default:
+			handlers.HandleBearerError(w, 401, handlers.BearerErrorInvalidToken, reason, getReasonDetail(reason))
+		}
+		return err
+	}
+
+	resp := &WhoamiResponse{
+		ID:          m.getUserIdentity(usr)["id"].(string),
+		Roles:       usr.Claims.Roles,
+		TokenSource: usr.TokenSource,
+		Claims:      make(map[string]interface{}),
+	}
+	for k, v := range usr.GetTokenClaims() {
+		if hiddenClaims[k] {
+			continue
+		}
+		resp.Claims[k] = v
+	}
+	body, err := json.Marshal(resp)
+	if err != nil {
+		w.WriteHeader(500)
+		w.Write([]byte(`Internal Server Error`))
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(200)
+	w.Write(body)
+	return nil
+}
+
+// HandleLogout expires the auth cookies of the instance and redirects the
+// user to the redirect URL of the config. The cookies are expired
+// regardless of whether the user is authorized, with the attributes of
+// their cookie configs. Only the requests with the allowed methods of the
+// config, and having either no Origin header or the origin of the request,
+// are accepted.
+func (m *Authorizer) HandleLogout(w http.ResponseWriter, r *http.Request, cfg *LogoutConfig) error {
+	if cfg == nil {
+		cfg = &LogoutConfig{}
+	}
+	allowedMethods := cfg.getAllowedMethods()
+	var allowed bool
+	for _, method := range allowedMethods {
+		if r.Method == method {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		w.Header().Set("Allow", strings.Join(allowedMethods, ", "))
+		w.WriteHeader(405)
+		w.Write([]byte(`Method Not Allowed`))
+		return errors.ErrLogoutMethod.WithArgs(r.Method)
+	}
+	if origin := r.Header.Get("Origin"); origin != "" {
+		if baseURL := urlutils.GetTrustedBaseURL(r, m.trustedProxies); !strings.EqualFold(origin, baseURL) {
+			w.WriteHeader(403)
+			w.Write([]byte(`Forbidden`))
+			return errors.ErrLogoutOrigin.WithArgs(origin, baseURL)
+		}
+	}
+	for _, name := range m.getAuthCookieNames() {
+		w.Header().Add("Set-Cookie", m.newExpiredCookie(r, name).String())
+	}
+	redirectURL := cfg.RedirectURL
+	if redirectURL == "" {
+		redirectURL = m.AuthURLPath
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Location", redirectURL)
+	w.WriteHeader(303)
+	return nil
+}
+
+// getAuthCookieNames returns the sorted names of the auth cookies of the
+// token validators of the instance and of its access list policies.
+func (m *Authorizer) getAuthCookieNames() []string {
+	names := make(map[string]bool)
+	if m.tokenValidator != nil {
+		for name := range m.tokenValidator.GetAuthCookies() {
+			names[name] = true
+		}
+	}
+	for _, route := range m.policyRoutes {
+		for name := range route.tokenValidator.GetAuthCookies() {
+			names[name] = true
+		}
+	}
+	var arr []string
+	for name := range names {
+		arr = append(arr, name)
+	}
+	sort.Strings(arr)
+	return arr
+}
+
+// getUserIdentity returns the identity of the user passed to caddyauth.
+// The identifier of the user is the claim selected with UserIdentityField.
+func (m *Authorizer) getUserIdentity(usr *user.User) map[string]interface{} {
+	userIdentity := make(map[string]interface{})
+	userIdentity["roles"] = strings.Join(usr.Claims.Roles, " ")
+	if usr.Claims.ID != "" {
+		userIdentity["claim_id"] = usr.Claims.ID
+	}
+	if usr.Claims.Subject != "" {
+		userIdentity["sub"] = usr.Claims.Subject
+	}
+	if usr.Claims.Email != "" {
+		userIdentity["email"] = usr.Claims.Email
+	}
+
+	switch m.UserIdentityField {
+	case "sub", "subject":
+		userIdentity["id"] = usr.Claims.Subject
+	case "id":
+		userIdentity["id"] = usr.Claims.ID
+	default:
+		if usr.Claims.Email == "" {
+			userIdentity["id"] = usr.Claims.Subject
+		} else {
+			userIdentity["id"] = usr.Claims.Email
+		}
+	}
+
+	if usr.Claims.Name != "" {
+		userIdentity["name"] = usr.Claims.Name
+	}
+	return userIdentity
+}
@@ -0,0 +1,300 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	jwtlib "github.com/golang-jwt/jwt"
+	"github.com/greenpau/caddy-authorize/internal/tests"
+	"github.com/greenpau/caddy-authorize/internal/testutils"
+	"github.com/greenpau/caddy-authorize/pkg/acl"
+	"github.com/greenpau/caddy-authorize/pkg/errors"
+	"github.com/greenpau/caddy-authorize/pkg/kms"
+	"github.com/greenpau/caddy-authorize/pkg/user"
+	"github.com/greenpau/caddy-authorize/pkg/utils"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestLogoutConfig(t *testing.T) {
+	var testcases = []struct {
+		name      string
+		config    *LogoutConfig
+		shouldErr bool
+		err       error
+	}{
+		{
+			name:   "empty config",
+			config: &LogoutConfig{},
+		},
+		{
+			name:   "valid config",
+			config: &LogoutConfig{RedirectURL: "https://auth.contoso.com/login"},
+		},
+		{
+			name:      "config with relative redirect url",
+			config:    &LogoutConfig{RedirectURL: "login"},
+			shouldErr: true,
+			err:       fmt.Errorf(`logout redirect url "login" is invalid`),
+		},
+		{
+			name:   "config with allowed methods",
+			config: &LogoutConfig{AllowedMethods: []string{"get", "POST"}},
+		},
+		{
+			name:      "config with unsupported method",
+			config:    &LogoutConfig{AllowedMethods: []string{"delete"}},
+			shouldErr: true,
+			err:       fmt.Errorf(`logout method "delete" is unsupported`),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.Validate()
+			tests.EvalErr(t, err, tc.config, tc.shouldErr, tc.err)
+		})
+	}
+}
+
+func newEndpointTestAuthorizer(t *testing.T, cookieConfigs []*CookieConfig) *Authorizer {
+	keys, err := kms.ParseCryptoKeyConfigs("crypto key verify " + testutils.GetSharedKey())
+	if err != nil {
+		t.Fatalf("failed parsing key config: %v", err)
+	}
+	m := &Authorizer{
+		PrimaryInstance:  true,
+		Context:          "default",
+		AuthURLPath:      "/auth",
+		CryptoKeyConfigs: keys,
+		AccessListRules: []*acl.RuleConfiguration{
+			{Conditions: []string{"match roles guest"}, Action: `allow`},
+		},
+		CookieConfigs: cookieConfigs,
+		logger:        utils.NewLogger(),
+	}
+	m.AddRevocationHook(func(usr *user.User) bool {
+		return usr.Claims.ID == "revoked"
+	})
+	if err := NewInstanceManager().Register(context.Background(), m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return m
+}
+
+func TestHandleWhoami(t *testing.T) {
+	m := newEndpointTestAuthorizer(t, nil)
+	now := time.Now().Unix()
+	newToken := func(jti string, roles string) string {
+		token, err := jwtlib.NewWithClaims(jwtlib.SigningMethodHS512, jwtlib.MapClaims{
+			"jti":          jti,
+			"exp":          now + 600,
+			"sub":          "jsmith",
+			"email":        "jsmith@contoso.com",
+			"roles":        roles,
+			"addr":         "10.0.2.2",
+			"access_token": "upstream",
+		}).SignedString([]byte(testutils.GetSharedKey()))
+		if err != nil {
+			t.Fatalf("failed signing token: %v", err)
+		}
+		return token
+	}
+
+	var testcases = []struct {
+		name  string
+		token string
+		code  int
+		want  map[string]interface{}
+	}{
+		{
+			name:  "authorized user",
+			token: newToken("a1", "guest"),
+			code:  200,
+			want: map[string]interface{}{
+				"id":           "jsmith@contoso.com",
+				"roles":        []interface{}{"guest"},
+				"token_source": "cookie",
+				"claims": map[string]interface{}{
+					"exp":   float64(now + 600),
+					"sub":   "jsmith",
+					"email": "jsmith@contoso.com",
+					"roles": "guest",
+				},
+			},
+		},
+		{
+			name: "user without token",
+			code: 401,
+			want: map[string]interface{}{
+				"type":   "about:blank",
+				"title":  "Unauthorized",
+				"status": float64(401),
+				"detail": "the request has no access token",
+				"reason": "no_token",
+			},
+		},
+		{
+			name:  "user not allowed by access list",
+			token: newToken("a2", "viewer"),
+			code:  200,
+		},
+		{
+			name:  "user with revoked token",
+			token: newToken("revoked", "guest"),
+			code:  401,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/whoami", nil)
+			if tc.token != "" {
+				r.Header.Set("Cookie", "access_token="+tc.token)
+			}
+			w := httptest.NewRecorder()
+			m.HandleWhoami(w, r)
+			tests.EvalObjects(t, "code", tc.code, w.Code)
+			if tc.want == nil {
+				return
+			}
+			got := make(map[string]interface{})
+			if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+				t.Fatalf("failed parsing response: %v", err)
+			}
+			tests.EvalObjects(t, "response", tc.want, got)
+		})
+	}
+}
+
+func TestHandleLogout(t *testing.T) {
+	var testcases = []struct {
+		name          string
+		method        string
+		origin        string
+		config        *LogoutConfig
+		cookieConfigs []*CookieConfig
+		code          int
+		allow         string
+		location      string
+		cookies       []string
+		shouldErr     bool
+		err           error
+	}{
+		{
+			name:     "logout with default config",
+			code:     303,
+			location: "/auth",
+			cookies: []string{
+				"access_token=delete; Path=/; Expires=Thu, 01 Jan 1970 00:00:00 GMT; Max-Age=0; HttpOnly; SameSite=Lax",
+			},
+		},
+		{
+			name:   "logout with cookie domain and path",
+			config: &LogoutConfig{RedirectURL: "https://auth.contoso.com/logout"},
+			cookieConfigs: []*CookieConfig{
+				{TokenName: "access_token", Domain: "contoso.com", Path: "/app", SameSite: "strict"},
+			},
+			code:     303,
+			location: "https://auth.contoso.com/logout",
+			cookies: []string{
+				"access_token=delete; Path=/app; Domain=contoso.com; Expires=Thu, 01 Jan 1970 00:00:00 GMT; Max-Age=0; HttpOnly; SameSite=Strict",
+			},
+		},
+		{
+			name:     "logout from same origin",
+			origin:   "http://app.contoso.com",
+			code:     303,
+			location: "/auth",
+			cookies: []string{
+				"access_token=delete; Path=/; Expires=Thu, 01 Jan 1970 00:00:00 GMT; Max-Age=0; HttpOnly; SameSite=Lax",
+			},
+		},
+		{
+			name:      "logout from another origin",
+			origin:    "https://evil.com",
+			code:      403,
+			shouldErr: true,
+			err:       errors.ErrLogoutOrigin.WithArgs("https://evil.com", "http://app.contoso.com"),
+		},
+		{
+			name:     "logout with get method",
+			method:   "GET",
+			code:     303,
+			location: "/auth",
+			cookies: []string{
+				"access_token=delete; Path=/; Expires=Thu, 01 Jan 1970 00:00:00 GMT; Max-Age=0; HttpOnly; SameSite=Lax",
+			},
+		},
+		{
+			name:     "logout with post method allowed",
+			config:   &LogoutConfig{AllowedMethods: []string{"POST"}},
+			code:     303,
+			location: "/auth",
+			cookies: []string{
+				"access_token=delete; Path=/; Expires=Thu, 01 Jan 1970 00:00:00 GMT; Max-Age=0; HttpOnly; SameSite=Lax",
+			},
+		},
+		{
+			name:      "logout with get method and post method allowed",
+			method:    "GET",
+			config:    &LogoutConfig{AllowedMethods: []string{"POST"}},
+			code:      405,
+			allow:     "POST",
+			shouldErr: true,
+			err:       errors.ErrLogoutMethod.WithArgs("GET"),
+		},
+		{
+			name:     "logout with get method and get method allowed",
+			method:   "GET",
+			config:   &LogoutConfig{AllowedMethods: []string{"GET"}},
+			code:     303,
+			location: "/auth",
+			cookies: []string{
+				"access_token=delete; Path=/; Expires=Thu, 01 Jan 1970 00:00:00 GMT; Max-Age=0; HttpOnly; SameSite=Lax",
+			},
+		},
+		{
+			name:      "logout with put method",
+			method:    "PUT",
+			code:      405,
+			allow:     "GET, POST",
+			shouldErr: true,
+			err:       errors.ErrLogoutMethod.WithArgs("PUT"),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := newEndpointTestAuthorizer(t, tc.cookieConfigs)
+			if tc.method == "" {
+				tc.method = "POST"
+			}
+			r := httptest.NewRequest(tc.method, "http://app.contoso.com/logout", nil)
+			if tc.origin != "" {
+				r.Header.Set("Origin", tc.origin)
+			}
+			w := httptest.NewRecorder()
+			err := m.HandleLogout(w, r, tc.config)
+			tests.EvalObjects(t, "code", tc.code, w.Code)
+			tests.EvalObjects(t, "allow", tc.allow, w.Header().Get("Allow"))
+			if tests.EvalErr(t, err, tc.config, tc.shouldErr, tc.err) {
+				return
+			}
+			tests.EvalObjects(t, "location", tc.location, w.Header().Get("Location"))
+			tests.EvalObjects(t, "cookies", tc.cookies, w.Header()["Set-Cookie"])
+		})
+	}
+}
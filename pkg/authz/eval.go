@@ -0,0 +1,149 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"context"
+	"fmt"
+	"github.com/greenpau/caddy-authorize/pkg/acl"
+	"github.com/greenpau/caddy-authorize/pkg/errors"
+	"github.com/greenpau/caddy-authorize/pkg/user"
+	"github.com/greenpau/caddy-authorize/pkg/validator"
+	"net"
+	"net/http"
+	"sort"
+)
+
+// EvalRequest is the request evaluated offline by an Authorizer instance.
+// It holds either a token or the claims of the user.
+type EvalRequest struct {
+	Token         string                 `json:"token,omitempty" xml:"token,omitempty" yaml:"token,omitempty"`
+	Claims        map[string]interface{} `json:"claims,omitempty" xml:"claims,omitempty" yaml:"claims,omitempty"`
+	Method        string                 `json:"method,omitempty" xml:"method,omitempty" yaml:"method,omitempty"`
+	URL           string                 `json:"url,omitempty" xml:"url,omitempty" yaml:"url,omitempty"`
+	SourceAddress string                 `json:"source_address,omitempty" xml:"source_address,omitempty" yaml:"source_address,omitempty"`
+}
+
+// EvalResult is the outcome of the offline evaluation of a request. The
+// rule is the access list rule which decided the outcome, if any.
+type EvalResult struct {
+	Instance string                 `json:"instance,omitempty" xml:"instance,omitempty" yaml:"instance,omitempty"`
+	Allow    bool                   `json:"allow" xml:"allow" yaml:"allow"`
+	Error    string                 `json:"error,omitempty" xml:"error,omitempty" yaml:"error,omitempty"`
+	Reason   errors.ReasonCode      `json:"reason,omitempty" xml:"reason,omitempty" yaml:"reason,omitempty"`
+	Claims   map[string]interface{} `json:"claims,omitempty" xml:"claims,omitempty" yaml:"claims,omitempty"`
+	Rule     *acl.RuleTrace         `json:"rule,omitempty" xml:"rule,omitempty" yaml:"rule,omitempty"`
+	Trace    *acl.DecisionTrace     `json:"trace,omitempty" xml:"trace,omitempty" yaml:"trace,omitempty"`
+}
+
+// Eval evaluates the request with the keystore, the request validation,
+// and the access list of an Authorizer instance, without running traffic.
+// The token is verified by the keystore. The claims are trusted as if they
+// came from a valid token. The decision trace is available when the access
+// list trace is configured.
+func (m *Authorizer) Eval(req *EvalRequest) (*EvalResult, error) {
+	if m.tokenValidator == nil || m.accessList == nil {
+		return nil, errors.ErrEvalNotProvisioned.WithArgs(m.Name)
+	}
+	switch {
+	case req.Token == "" && req.Claims == nil:
+		return nil, errors.ErrEvalNoCredentials
+	case req.Token != "" && req.Claims != nil:
+		return nil, errors.ErrEvalMixedCredentials
+	}
+
+	r, err := newEvalRequest(req)
+	if err != nil {
+		return nil, errors.ErrEvalRequest.WithArgs(err)
+	}
+
+	ctx, trace := acl.NewTraceContext(context.Background())
+	tokenValidator := m.getTokenValidator(r)
+	var usr *user.User
+	if req.Token != "" {
+		addEvalToken(r, tokenValidator, req.Token)
+		usr, err = tokenValidator.Authorize(ctx, r)
+	} else {
+		usr, err = user.NewUser(req.Claims)
+		if err != nil {
+			return nil, errors.ErrEvalClaims.WithArgs(err)
+		}
+		err = tokenValidator.AuthorizeUser(ctx, r, usr)
+	}
+
+	result := &EvalResult{
+		Instance: m.Name,
+		Allow:    err == nil,
+	}
+	if err != nil {
+		result.Error = err.Error()
+		result.Reason = errors.GetReasonCode(err)
+	}
+	if usr != nil && usr.Claims != nil {
+		result.Claims = usr.AsMap()
+	}
+	if trace.Reason != "" {
+		result.Rule = trace.GetDecidingRule()
+		result.Trace = trace
+	}
+	return result, nil
+}
+
+func newEvalRequest(req *EvalRequest) (*http.Request, error) {
+	method := req.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	r, err := http.NewRequest(method, req.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	r.RemoteAddr = "127.0.0.1:0"
+	if req.SourceAddress != "" {
+		if net.ParseIP(req.SourceAddress) == nil {
+			return nil, fmt.Errorf("invalid source address %q", req.SourceAddress)
+		}
+		r.RemoteAddr = net.JoinHostPort(req.SourceAddress, "0")
+	}
+	return r, nil
+}
+
+// addEvalToken adds the token to the request via the token source of the
+// token validator having the highest priority.
+func addEvalToken(r *http.Request, tokenValidator *validator.TokenValidator, token string) {
+	tokenName := "access_token"
+	tokenNames := tokenValidator.GetAuthCookies()
+	if _, exists := tokenNames[tokenName]; !exists {
+		var names []string
+		for name := range tokenNames {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		if len(names) > 0 {
+			tokenName = names[0]
+		}
+	}
+
+	switch tokenValidator.GetSourcePriority()[0] {
+	case "cookie":
+		r.AddCookie(&http.Cookie{Name: tokenName, Value: token})
+	case "query":
+		q := r.URL.Query()
+		q.Set(tokenName, token)
+		r.URL.RawQuery = q.Encode()
+	default:
+		r.Header.Set("Authorization", tokenName+"="+token)
+	}
+}
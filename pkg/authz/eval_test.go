@@ -0,0 +1,253 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/greenpau/caddy-authorize/internal/tests"
+	"github.com/greenpau/caddy-authorize/internal/testutils"
+	"github.com/greenpau/caddy-authorize/pkg/acl"
+	"github.com/greenpau/caddy-authorize/pkg/errors"
+	"github.com/greenpau/caddy-authorize/pkg/kms"
+	"github.com/greenpau/caddy-authorize/pkg/user"
+	"github.com/greenpau/caddy-authorize/pkg/utils"
+	"testing"
+)
+
+func TestEval(t *testing.T) {
+	usr := testutils.NewTestUser()
+	if err := testutils.NewTestCryptoKeyStore().SignToken("access_token", "HS512", usr); err != nil {
+		t.Fatalf("failed signing token: %v", err)
+	}
+	keys, err := kms.ParseCryptoKeyConfigs("crypto key verify " + testutils.GetSharedKey())
+	if err != nil {
+		t.Fatalf("failed parsing key config: %v", err)
+	}
+	rules := []*acl.RuleConfiguration{
+		{
+			Comment:    "deny admin host",
+			Conditions: []string{"exact match host admin.contoso.com"},
+			Action:     `deny stop`,
+		},
+		{
+			Comment:    "allow guests",
+			Conditions: []string{"match roles guest"},
+			Action:     `allow`,
+		},
+	}
+
+	var testcases = []struct {
+		name      string
+		req       *EvalRequest
+		want      map[string]interface{}
+		shouldErr bool
+		err       error
+	}{
+		{
+			name: "allow token",
+			req: &EvalRequest{
+				Token: usr.Token,
+				URL:   "https://app.contoso.com/dashboard",
+			},
+			want: map[string]interface{}{
+				"allow":  true,
+				"reason": acl.DecisionReasonExplicitAllow,
+				"rule":   1,
+			},
+		},
+		{
+			name: "deny token by host",
+			req: &EvalRequest{
+				Token: usr.Token,
+				URL:   "https://admin.contoso.com/dashboard",
+			},
+			want: map[string]interface{}{
+				"allow":       false,
+				"reason":      acl.DecisionReasonExplicitDeny,
+				"rule":        0,
+				"error":       errors.ErrAccessNotAllowed.Error(),
+				"deny_reason": errors.ReasonACLDenied,
+			},
+		},
+		{
+			name: "deny invalid token",
+			req: &EvalRequest{
+				Token: "foobar.foobar.foobar.foobar.foobar.foobar",
+				URL:   "https://app.contoso.com/dashboard",
+			},
+			want: map[string]interface{}{
+				"allow":       false,
+				"error":       errors.ErrValidatorInvalidToken.WithArgs(errors.ErrCryptoKeyStoreParseTokenFailed).Error(),
+				"deny_reason": errors.ReasonMalformed,
+			},
+		},
+		{
+			name: "deny claims without matching roles",
+			req: &EvalRequest{
+				Claims: map[string]interface{}{"email": "jsmith@contoso.com", "roles": []interface{}{"viewer"}},
+				Method: "POST",
+				URL:    "https://app.contoso.com/dashboard",
+			},
+			want: map[string]interface{}{
+				"allow":       false,
+				"reason":      acl.DecisionReasonNoAllow,
+				"error":       errors.ErrAccessNotAllowed.Error(),
+				"deny_reason": errors.ReasonACLDenied,
+			},
+		},
+		{
+			name: "allow claims",
+			req: &EvalRequest{
+				Claims:        map[string]interface{}{"email": "jsmith@contoso.com", "roles": []interface{}{"guest"}},
+				URL:           "https://app.contoso.com/dashboard",
+				SourceAddress: "10.0.0.1",
+			},
+			want: map[string]interface{}{
+				"allow":  true,
+				"reason": acl.DecisionReasonExplicitAllow,
+				"rule":   1,
+			},
+		},
+		{
+			name: "allow claims with implied role",
+			req: &EvalRequest{
+				Claims: map[string]interface{}{"email": "jsmith@contoso.com", "roles": []interface{}{"administrator"}},
+				URL:    "https://app.contoso.com/dashboard",
+			},
+			want: map[string]interface{}{
+				"allow":  true,
+				"reason": acl.DecisionReasonExplicitAllow,
+				"rule":   1,
+			},
+		},
+		{
+			name:      "fail without token and claims",
+			req:       &EvalRequest{URL: "https://app.contoso.com/dashboard"},
+			shouldErr: true,
+			err:       errors.ErrEvalNoCredentials,
+		},
+		{
+			name: "fail with token and claims",
+			req: &EvalRequest{
+				Token:  usr.Token,
+				Claims: map[string]interface{}{"roles": []interface{}{"guest"}},
+				URL:    "https://app.contoso.com/dashboard",
+			},
+			shouldErr: true,
+			err:       errors.ErrEvalMixedCredentials,
+		},
+		{
+			name: "fail with invalid source address",
+			req: &EvalRequest{
+				Token:         usr.Token,
+				URL:           "https://app.contoso.com/dashboard",
+				SourceAddress: "foobar",
+			},
+			shouldErr: true,
+			err:       errors.ErrEvalRequest.WithArgs(fmt.Errorf(`invalid source address "foobar"`)),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			mgr := NewInstanceManager()
+			m := &Authorizer{
+				PrimaryInstance:  true,
+				Context:          "default",
+				CryptoKeyConfigs: keys,
+				AccessListRules:  rules,
+				AccessListTrace:  &AccessListTraceConfig{},
+				RoleHierarchy: &user.RoleHierarchy{
+					Implies: map[string][]string{"admin": {"guest"}},
+					Aliases: map[string]string{"administrator": "admin"},
+				},
+				logger: utils.NewLogger(),
+			}
+			if err := mgr.Register(context.Background(), m); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			result, err := m.Eval(tc.req)
+			if tests.EvalErr(t, err, tc.req, tc.shouldErr, tc.err) {
+				return
+			}
+			got := map[string]interface{}{
+				"allow": result.Allow,
+			}
+			if result.Error != "" {
+				got["error"] = result.Error
+			}
+			if result.Reason != "" {
+				got["deny_reason"] = result.Reason
+			}
+			if result.Trace != nil {
+				got["reason"] = result.Trace.Reason
+			}
+			if result.Rule != nil {
+				got["rule"] = result.Rule.Index
+			}
+			tests.EvalObjects(t, "result", tc.want, got)
+		})
+	}
+}
+
+func TestRoleHierarchyCycle(t *testing.T) {
+	m := &Authorizer{
+		PrimaryInstance: true,
+		Context:         "default",
+		AccessListRules: []*acl.RuleConfiguration{
+			{
+				Conditions: []string{"match roles viewer"},
+				Action:     `allow`,
+			},
+		},
+		RoleHierarchy: &user.RoleHierarchy{
+			Implies: map[string][]string{"editor": {"viewer"}, "viewer": {"editor"}},
+		},
+		logger: utils.NewLogger(),
+	}
+	err := NewInstanceManager().Register(context.Background(), m)
+	tests.EvalErr(t, err, m.RoleHierarchy, true, errors.ErrInvalidConfiguration.WithArgs(
+		"jwt-default-000001", errors.ErrRoleHierarchyCycle.WithArgs("editor -> viewer -> editor"),
+	))
+}
+
+func TestEvalResultJSON(t *testing.T) {
+	var testcases = []struct {
+		name   string
+		result *EvalResult
+		want   string
+	}{
+		{
+			name:   "allowed request",
+			result: &EvalResult{Allow: true},
+			want:   `{"allow":true}`,
+		},
+		{
+			name:   "denied request",
+			result: &EvalResult{Reason: errors.ReasonACLDenied},
+			want:   `{"allow":false,"reason":"acl_denied"}`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := json.Marshal(tc.result)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			tests.EvalObjects(t, "json", tc.want, string(b))
+		})
+	}
+}
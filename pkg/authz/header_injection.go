@@ -15,16 +15,46 @@
 package authz
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"github.com/greenpau/caddy-authorize/pkg/user"
 	"net/http"
 	"strings"
 )
 
-// HeaderInjectionConfig contains the entry for the authorization bypass.
+// The headers injected with inject headers with claims directive.
+var defaultInjectedHeaders = []string{
+	"X-Token-User-Name",
+	"X-Token-User-Email",
+	"X-Token-User-Roles",
+	"X-Token-Subject",
+}
+
+// HeaderInjectionConfig contains the entry for the header injection. The
+// value of the header is either the value of a claim field, the template
+// combining the values of claim fields, or the base64-encoded JSON object
+// of the selected claim fields.
 type HeaderInjectionConfig struct {
 	Header string `json:"header,omitempty" xml:"header,omitempty" yaml:"header,omitempty"`
 	Field  string `json:"field,omitempty" xml:"field,omitempty" yaml:"field,omitempty"`
+	// The template of the value, e.g. "{name} <{email}>".
+	Template string `json:"template,omitempty" xml:"template,omitempty" yaml:"template,omitempty"`
+	// The separator joining the values of list claims. Defaults to space.
+	Separator string `json:"separator,omitempty" xml:"separator,omitempty" yaml:"separator,omitempty"`
+	// The claim fields encoded as base64-encoded JSON object.
+	Fields []string `json:"fields,omitempty" xml:"fields,omitempty" yaml:"fields,omitempty"`
+	// The roles of the users getting the header. When empty, the header is
+	// injected for any authorized user.
+	Roles    []string `json:"roles,omitempty" xml:"roles,omitempty" yaml:"roles,omitempty"`
+	segments []headerTemplateSegment
+}
+
+// headerTemplateSegment is either the literal text or the claim field
+// of a header template.
+type headerTemplateSegment struct {
+	text  string
+	field string
 }
 
 // Validate validates HeaderInjectionConfig
@@ -34,18 +64,131 @@ func (c *HeaderInjectionConfig) Validate() error {
 	if c.Header == "" {
 		return fmt.Errorf("undefined header name")
 	}
-	if c.Field == "" {
+	var sources int
+	for _, found := range []bool{c.Field != "", c.Template != "", len(c.Fields) > 0} {
+		if found {
+			sources++
+		}
+	}
+	switch {
+	case sources == 0:
 		return fmt.Errorf("undefined field name")
+	case sources > 1:
+		return fmt.Errorf("header %q must have either field, template, or fields", c.Header)
+	case c.Separator != "" && len(c.Fields) > 0:
+		return fmt.Errorf("header %q separator is not supported with fields", c.Header)
+	}
+	for _, k := range c.Fields {
+		if strings.TrimSpace(k) == "" {
+			return fmt.Errorf("header %q has empty field name", c.Header)
+		}
+	}
+	if c.Template != "" {
+		segments, err := parseHeaderTemplate(c.Template)
+		if err != nil {
+			return fmt.Errorf("header %q template %q is invalid: %v", c.Header, c.Template, err)
+		}
+		c.segments = segments
 	}
 	return nil
 }
 
+func parseHeaderTemplate(s string) ([]headerTemplateSegment, error) {
+	var segments []headerTemplateSegment
+	var fieldFound bool
+	for s != "" {
+		i := strings.IndexAny(s, "{}")
+		if i < 0 {
+			segments = append(segments, headerTemplateSegment{text: s})
+			break
+		}
+		if s[i] == '}' {
+			return nil, fmt.Errorf("unexpected closing brace")
+		}
+		if i > 0 {
+			segments = append(segments, headerTemplateSegment{text: s[:i]})
+		}
+		j := strings.IndexAny(s[i+1:], "{}")
+		if j < 0 || s[i+1+j] == '{' {
+			return nil, fmt.Errorf("unclosed brace")
+		}
+		field := strings.TrimSpace(s[i+1 : i+1+j])
+		if field == "" {
+			return nil, fmt.Errorf("empty field name")
+		}
+		segments = append(segments, headerTemplateSegment{field: field})
+		fieldFound = true
+		s = s[i+j+2:]
+	}
+	if !fieldFound {
+		return nil, fmt.Errorf("no field names")
+	}
+	return segments, nil
+}
+
+// getValue returns the value of the header. The value is empty when none
+// of the claim fields are found.
+func (c *HeaderInjectionConfig) getValue(usr *user.User) string {
+	sep := c.Separator
+	if sep == "" {
+		sep = " "
+	}
+	switch {
+	case len(c.Fields) > 0:
+		claims := make(map[string]interface{})
+		for _, k := range c.Fields {
+			if v := usr.GetClaimByField(k); v != nil {
+				claims[k] = v
+			}
+		}
+		if len(claims) == 0 {
+			return ""
+		}
+		b, err := json.Marshal(claims)
+		if err != nil {
+			return ""
+		}
+		return base64.StdEncoding.EncodeToString(b)
+	case len(c.segments) > 0:
+		var sb strings.Builder
+		var fieldFound bool
+		for _, segment := range c.segments {
+			if segment.field == "" {
+				sb.WriteString(segment.text)
+				continue
+			}
+			values := usr.GetClaimValuesByField(segment.field)
+			if len(values) > 0 {
+				fieldFound = true
+			}
+			sb.WriteString(strings.Join(values, sep))
+		}
+		if !fieldFound {
+			return ""
+		}
+		return sb.String()
+	}
+	return strings.Join(usr.GetClaimValuesByField(c.Field), sep)
+}
+
+// injectHeaders removes the headers the plugin injects, and the headers
+// configured for removal, from the request before injecting the headers
+// for the user. It prevents clients from spoofing the injected headers.
+// The default X-Token headers are injected when the claims are passed with
+// the headers, whereas the custom headers are injected regardless. The
+// injected headers are cached with the user.
 func (m *Authorizer) injectHeaders(r *http.Request, usr *user.User) {
-	if m.PassClaimsWithHeaders {
-		// Inject default X-Token headers.
-		headers := usr.GetRequestHeaders()
-		if headers == nil {
-			headers = make(map[string]string)
+	for _, k := range m.removedHeaders {
+		r.Header.Del(k)
+	}
+	if !m.PassClaimsWithHeaders && len(m.HeaderInjectionConfigs) == 0 {
+		return
+	}
+	headers := usr.GetRequestHeaders()
+	if headers == nil {
+		headers = make(map[string]string)
+		if m.PassClaimsWithHeaders {
+			// Inject default X-Token headers.
 			if usr.Claims.Name != "" {
 				headers["X-Token-User-Name"] = usr.Claims.Name
 			}
@@ -58,26 +201,24 @@ func (m *Authorizer) injectHeaders(r *http.Request, usr *user.User) {
 			if usr.Claims.Subject != "" {
 				headers["X-Token-Subject"] = usr.Claims.Subject
 			}
-			usr.SetRequestHeaders(headers)
 		}
-
-		for k, v := range headers {
-			if m.injectedHeaders != nil {
-				if _, exists := m.injectedHeaders[k]; exists {
-					continue
-				}
-			}
-			r.Header.Set(k, v)
+		// Inject custom headers. The custom headers take precedence over
+		// the default ones.
+		for _, entry := range m.HeaderInjectionConfigs {
+			delete(headers, entry.Header)
 		}
-	}
-
-	if m.injectedHeaders != nil {
-		// Inject custom headers.
 		for _, entry := range m.HeaderInjectionConfigs {
-			if v := usr.GetClaimValueByField(entry.Field); v != "" {
-				r.Header.Set(entry.Header, v)
+			if len(entry.Roles) > 0 && !usr.HasRole(entry.Roles...) {
+				continue
+			}
+			if v := entry.getValue(usr); v != "" {
+				headers[entry.Header] = v
 			}
 		}
+		usr.SetRequestHeaders(headers)
+	}
+	for k, v := range headers {
+		r.Header.Set(k, v)
 	}
 }
 
@@ -85,6 +226,12 @@ func (m *Authorizer) stripAuthToken(r *http.Request, usr *user.User) {
 	if !m.StripTokenEnabled {
 		return
 	}
+	removeAuthToken(r, usr)
+}
+
+// removeAuthToken removes the token of the user from the source it came
+// from, i.e. the cookie or the query parameter.
+func removeAuthToken(r *http.Request, usr *user.User) {
 	switch usr.TokenSource {
 	case "cookie":
 		if usr.TokenName != "" {
@@ -112,5 +259,13 @@ func (m *Authorizer) stripAuthToken(r *http.Request, usr *user.User) {
 				}
 			}
 		}
+	case "query":
+		if usr.TokenName != "" {
+			values := r.URL.Query()
+			if _, exists := values[usr.TokenName]; exists {
+				values.Del(usr.TokenName)
+				r.URL.RawQuery = values.Encode()
+			}
+		}
 	}
 }
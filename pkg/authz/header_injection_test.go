@@ -0,0 +1,318 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"context"
+	"fmt"
+	"github.com/greenpau/caddy-authorize/internal/tests"
+	"github.com/greenpau/caddy-authorize/internal/testutils"
+	"github.com/greenpau/caddy-authorize/pkg/acl"
+	"github.com/greenpau/caddy-authorize/pkg/kms"
+	"github.com/greenpau/caddy-authorize/pkg/user"
+	"github.com/greenpau/caddy-authorize/pkg/utils"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHeaderInjectionConfig(t *testing.T) {
+	var testcases = []struct {
+		name      string
+		config    *HeaderInjectionConfig
+		shouldErr bool
+		err       error
+	}{
+		{
+			name:   "field",
+			config: &HeaderInjectionConfig{Header: "X-Email", Field: "email"},
+		},
+		{
+			name:   "template",
+			config: &HeaderInjectionConfig{Header: "X-User", Template: "{name} <{email}>"},
+		},
+		{
+			name:   "fields",
+			config: &HeaderInjectionConfig{Header: "X-Userinfo", Fields: []string{"sub", "email"}},
+		},
+		{
+			name:      "undefined header name",
+			config:    &HeaderInjectionConfig{Field: "email"},
+			shouldErr: true,
+			err:       fmt.Errorf("undefined header name"),
+		},
+		{
+			name:      "undefined field name",
+			config:    &HeaderInjectionConfig{Header: "X-Email"},
+			shouldErr: true,
+			err:       fmt.Errorf("undefined field name"),
+		},
+		{
+			name:      "field and template",
+			config:    &HeaderInjectionConfig{Header: "X-User", Field: "email", Template: "{name}"},
+			shouldErr: true,
+			err:       fmt.Errorf(`header "X-User" must have either field, template, or fields`),
+		},
+		{
+			name:      "separator with fields",
+			config:    &HeaderInjectionConfig{Header: "X-Userinfo", Fields: []string{"sub"}, Separator: ","},
+			shouldErr: true,
+			err:       fmt.Errorf(`header "X-Userinfo" separator is not supported with fields`),
+		},
+		{
+			name:      "template without fields",
+			config:    &HeaderInjectionConfig{Header: "X-User", Template: "anonymous"},
+			shouldErr: true,
+			err:       fmt.Errorf(`header "X-User" template "anonymous" is invalid: no field names`),
+		},
+		{
+			name:      "template with unclosed brace",
+			config:    &HeaderInjectionConfig{Header: "X-User", Template: "{name <{email}>"},
+			shouldErr: true,
+			err:       fmt.Errorf(`header "X-User" template "{name <{email}>" is invalid: unclosed brace`),
+		},
+		{
+			name:      "template with unexpected closing brace",
+			config:    &HeaderInjectionConfig{Header: "X-User", Template: "name} <{email}>"},
+			shouldErr: true,
+			err:       fmt.Errorf(`header "X-User" template "name} <{email}>" is invalid: unexpected closing brace`),
+		},
+		{
+			name:      "template with empty field name",
+			config:    &HeaderInjectionConfig{Header: "X-User", Template: "{} <{email}>"},
+			shouldErr: true,
+			err:       fmt.Errorf(`header "X-User" template "{} <{email}>" is invalid: empty field name`),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.Validate()
+			tests.EvalErr(t, err, tc.config, tc.shouldErr, tc.err)
+		})
+	}
+}
+
+func TestInjectHeaders(t *testing.T) {
+	newToken := func(roles string) string {
+		usr, err := user.NewUser(map[string]interface{}{
+			"exp":   float64(time.Now().Add(10 * time.Minute).Unix()),
+			"sub":   "jsmith",
+			"name":  "John Smith",
+			"email": "jsmith@contoso.com",
+			"roles": roles,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := testutils.NewTestCryptoKeyStore().SignToken("access_token", "HS512", usr); err != nil {
+			t.Fatalf("failed signing token: %v", err)
+		}
+		return usr.Token
+	}
+	keys, err := kms.ParseCryptoKeyConfigs("crypto key verify " + testutils.GetSharedKey())
+	if err != nil {
+		t.Fatalf("failed parsing key config: %v", err)
+	}
+	m := &Authorizer{
+		PrimaryInstance:  true,
+		Context:          "default",
+		AuthURLPath:      "/auth",
+		CryptoKeyConfigs: keys,
+		AccessListRules: []*acl.RuleConfiguration{
+			{Conditions: []string{"match roles admin guest"}, Action: `allow`},
+		},
+		HeaderInjectionConfigs: []*HeaderInjectionConfig{
+			{Header: "X-User", Template: "{name} <{email}>"},
+			{Header: "X-Groups", Field: "roles", Separator: ","},
+			{Header: "X-Userinfo", Fields: []string{"sub", "email", "roles"}, Roles: []string{"admin"}},
+			{Header: "X-Token-Subject", Template: "user/{sub}"},
+		},
+		RemoveHeaders: []string{"X-Remote-User"},
+		logger:        utils.NewLogger(),
+	}
+	if err := NewInstanceManager().Register(context.Background(), m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	adminToken := newToken("admin guest")
+
+	var testcases = []struct {
+		name    string
+		token   string
+		headers map[string]string
+		want    map[string]string
+	}{
+		{
+			name:  "admin",
+			token: adminToken,
+			want: map[string]string{
+				"X-User":             "John Smith <jsmith@contoso.com>",
+				"X-Groups":           "admin,guest",
+				"X-Userinfo":         "eyJlbWFpbCI6ImpzbWl0aEBjb250b3NvLmNvbSIsInJvbGVzIjpbImFkbWluIiwiZ3Vlc3QiXSwic3ViIjoianNtaXRoIn0=",
+				"X-Token-Subject":    "user/jsmith",
+				"X-Token-User-Name":  "John Smith",
+				"X-Token-User-Email": "jsmith@contoso.com",
+				"X-Token-User-Roles": "admin guest",
+			},
+		},
+		{
+			name:  "cached admin with spoofed headers",
+			token: adminToken,
+			headers: map[string]string{
+				"X-User":        "root",
+				"X-Remote-User": "root",
+			},
+			want: map[string]string{
+				"X-User":             "John Smith <jsmith@contoso.com>",
+				"X-Groups":           "admin,guest",
+				"X-Userinfo":         "eyJlbWFpbCI6ImpzbWl0aEBjb250b3NvLmNvbSIsInJvbGVzIjpbImFkbWluIiwiZ3Vlc3QiXSwic3ViIjoianNtaXRoIn0=",
+				"X-Token-Subject":    "user/jsmith",
+				"X-Token-User-Name":  "John Smith",
+				"X-Token-User-Email": "jsmith@contoso.com",
+				"X-Token-User-Roles": "admin guest",
+			},
+		},
+		{
+			name:  "guest with spoofed headers",
+			token: newToken("guest"),
+			headers: map[string]string{
+				"X-Userinfo":    "eyJzdWIiOiJyb290In0=",
+				"X-Remote-User": "root",
+			},
+			want: map[string]string{
+				"X-User":             "John Smith <jsmith@contoso.com>",
+				"X-Groups":           "guest",
+				"X-Token-Subject":    "user/jsmith",
+				"X-Token-User-Name":  "John Smith",
+				"X-Token-User-Email": "jsmith@contoso.com",
+				"X-Token-User-Roles": "guest",
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/dashboard", nil)
+			r.Header.Set("Cookie", "access_token="+tc.token)
+			for k, v := range tc.headers {
+				r.Header.Set(k, v)
+			}
+			if _, ok, err := m.Authenticate(httptest.NewRecorder(), r, nil); !ok {
+				t.Fatalf("unexpected authentication failure: %v", err)
+			}
+			got := make(map[string]string)
+			for k := range r.Header {
+				if k != "Cookie" {
+					got[k] = r.Header.Get(k)
+				}
+			}
+			tests.EvalObjects(t, "headers", tc.want, got)
+		})
+	}
+}
+
+func TestInjectHeadersSecondaryInstance(t *testing.T) {
+	usr, err := user.NewUser(map[string]interface{}{
+		"exp":   float64(time.Now().Add(10 * time.Minute).Unix()),
+		"sub":   "jsmith",
+		"email": "jsmith@contoso.com",
+		"roles": "guest",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := testutils.NewTestCryptoKeyStore().SignToken("access_token", "HS512", usr); err != nil {
+		t.Fatalf("failed signing token: %v", err)
+	}
+	keys, err := kms.ParseCryptoKeyConfigs("crypto key verify " + testutils.GetSharedKey())
+	if err != nil {
+		t.Fatalf("failed parsing key config: %v", err)
+	}
+	rules := []*acl.RuleConfiguration{
+		{Conditions: []string{"match roles guest"}, Action: `allow`},
+	}
+
+	var testcases = []struct {
+		name      string
+		primary   *Authorizer
+		secondary *Authorizer
+		headers   map[string]string
+		want      map[string]string
+	}{
+		{
+			name: "secondary with custom header under primary without claims",
+			primary: &Authorizer{
+				AccessListRules: rules,
+			},
+			secondary: &Authorizer{
+				HeaderInjectionConfigs: []*HeaderInjectionConfig{
+					{Header: "X-Email", Field: "email"},
+				},
+			},
+			headers: map[string]string{
+				"X-Email": "root@contoso.com",
+			},
+			want: map[string]string{
+				"X-Email": "jsmith@contoso.com",
+			},
+		},
+		{
+			name: "secondary inheriting claims from primary",
+			primary: &Authorizer{
+				AccessListRules:       rules,
+				PassClaimsWithHeaders: true,
+			},
+			secondary: &Authorizer{},
+			// The user has no name, therefore the spoofed name header must
+			// be removed rather than overwritten.
+			headers: map[string]string{
+				"X-Token-User-Name": "root",
+			},
+			want: map[string]string{
+				"X-Token-Subject":    "jsmith",
+				"X-Token-User-Email": "jsmith@contoso.com",
+				"X-Token-User-Roles": "guest",
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			mgr := NewInstanceManager()
+			tc.primary.PrimaryInstance = true
+			tc.primary.CryptoKeyConfigs = keys
+			for _, m := range []*Authorizer{tc.primary, tc.secondary} {
+				m.Context = "default"
+				m.logger = utils.NewLogger()
+				if err := mgr.Register(ctx, m); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			r := httptest.NewRequest("GET", "/dashboard", nil)
+			r.Header.Set("Cookie", "access_token="+usr.Token)
+			for k, v := range tc.headers {
+				r.Header.Set(k, v)
+			}
+			if _, ok, err := tc.secondary.Authenticate(httptest.NewRecorder(), r, nil); !ok {
+				t.Fatalf("unexpected authentication failure: %v", err)
+			}
+			got := make(map[string]string)
+			for k := range r.Header {
+				if k != "Cookie" {
+					got[k] = r.Header.Get(k)
+				}
+			}
+			tests.EvalObjects(t, "headers", tc.want, got)
+		})
+	}
+}
@@ -19,14 +19,22 @@ import (
 	"fmt"
 	"github.com/greenpau/caddy-authorize/pkg/acl"
 	"github.com/greenpau/caddy-authorize/pkg/errors"
+	"github.com/greenpau/caddy-authorize/pkg/handlers"
 	"github.com/greenpau/caddy-authorize/pkg/kms"
 	"github.com/greenpau/caddy-authorize/pkg/options"
+	"github.com/greenpau/caddy-authorize/pkg/utils/urlutils"
 	"github.com/greenpau/caddy-authorize/pkg/validator"
 	"go.uber.org/zap"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 )
 
+// accessListFileReloadInterval is the interval at which the access list
+// file is checked for changes.
+var accessListFileReloadInterval = 5 * time.Second
+
 // InstanceStatus is the state of an Instance.
 type InstanceStatus int
 
@@ -49,7 +57,26 @@ type InstanceManager struct {
 	Members          map[string]*Authorizer `json:"members,omitempty" xml:"members,omitempty" yaml:"members,omitempty"`
 	PrimaryInstances map[string]*Authorizer `json:"primary_instances,omitempty" xml:"primary_instances,omitempty" yaml:"primary_instances,omitempty"`
 	MemberCount      map[string]int         `json:"member_count,omitempty" xml:"member_count,omitempty" yaml:"member_count,omitempty"`
-	backlog          map[string]string
+	// Holds the generations of the instances, keyed by the context of the
+	// config which provisioned them.
+	generations     map[context.Context]*instanceGeneration
+	generationCount uint64
+}
+
+// instanceGeneration holds the instances provisioned with the same config.
+// The non-primary instances inherit the settings of the primary instance
+// of their context within their generation only. A new generation begins
+// with each config load, and it ends when its instances are cleaned up.
+type instanceGeneration struct {
+	id  uint64
+	ctx context.Context
+	// Holds the primary instances, keyed by context name.
+	primaries map[string]*Authorizer
+	// Holds the non-primary instances waiting for their primary instance,
+	// keyed by instance name.
+	backlog map[string]*Authorizer
+	// The number of the registered instances.
+	members int
 }
 
 // AuthManager is the global authorization provider pool.
@@ -66,33 +93,46 @@ func NewInstanceManager() *InstanceManager {
 		Members:          make(map[string]*Authorizer),
 		PrimaryInstances: make(map[string]*Authorizer),
 		MemberCount:      make(map[string]int),
-		backlog:          make(map[string]string),
+		generations:      make(map[context.Context]*instanceGeneration),
 	}
 	return mgr
 }
 
-// Validate validates the provisioning of an Authorizer instance.
+// Validate validates the provisioning of an Authorizer instance. The
+// non-primary instances of the generation of a primary instance, which
+// were registered prior to it, are registered again.
 func (mgr *InstanceManager) Validate(ctx context.Context, m *Authorizer) error {
 	if !m.PrimaryInstance {
 		return nil
 	}
 	m.logger.Debug("Instance validation", zap.String("instance_name", m.Name))
-	for instanceName, ctxName := range mgr.backlog {
-		if ctxName != m.Context {
-			continue
+	mgr.mu.Lock()
+	var backlog []*Authorizer
+	if gen := m.generation; gen != nil {
+		for _, instance := range gen.backlog {
+			if instance.Context == m.Context {
+				backlog = append(backlog, instance)
+			}
 		}
-		instance := mgr.Members[instanceName]
+	}
+	mgr.mu.Unlock()
+	sort.Slice(backlog, func(i, j int) bool {
+		return backlog[i].Name < backlog[j].Name
+	})
+	for _, instance := range backlog {
 		if err := mgr.Register(ctx, instance); err != nil {
 			return errors.ErrInstanceManagerValidate.WithArgs(m.Name, err)
 		}
-		m.logger.Debug("Non-primary instance validated", zap.String("instance_name", instanceName))
+		m.logger.Debug("Non-primary instance validated", zap.String("instance_name", instance.Name))
 	}
 
 	m.logger.Debug("Primary instance validated", zap.String("instance_name", m.Name))
 	return nil
 }
 
-// Register registers authorization provider instance with the pool.
+// Register registers authorization provider instance with the pool. The
+// instance joins the generation of the config, whose context is ctx,
+// unless it has been registered already.
 func (mgr *InstanceManager) Register(ctx context.Context, m *Authorizer) error {
 	var primaryInstance *Authorizer
 	mgr.mu.Lock()
@@ -104,24 +144,43 @@ func (mgr *InstanceManager) Register(ctx context.Context, m *Authorizer) error {
 		counter := mgr.incrementMemberCount(m.Context)
 		m.Name = fmt.Sprintf("jwt-%s-%06d", m.Context, counter)
 	}
+	gen := m.generation
+	if gen == nil {
+		gen = mgr.getGeneration(ctx)
+		gen.members++
+		m.generation = gen
+		m.manager = mgr
+	}
 
-	status := mgr.getInstanceStatus(m)
+	status := getInstanceStatus(gen, m)
 	switch status {
 	case DelaySecondary:
-		mgr.backlog[m.Name] = m.Context
+		gen.backlog[m.Name] = m
 		mgr.Members[m.Name] = m
 		return nil
 	case DuplicatePrimary:
+		mgr.unregister(m)
 		return errors.ErrTooManyPrimaryInstances.WithArgs(m.Context)
 	case BootstrapPrimary:
-		m.logger.Debug("Primary instance registration", zap.String("instance_name", m.Name))
+		m.logger.Debug(
+			"Primary instance registration",
+			zap.String("instance_name", m.Name),
+			zap.Uint64("generation", gen.id),
+		)
+		gen.primaries[m.Context] = m
 		mgr.PrimaryInstances[m.Context] = m
 		mgr.Members[m.Name] = m
 	default:
 		// This is BootstrapSecondary.
-		m.logger.Debug("Non-primary instance registration", zap.String("instance_name", m.Name))
-		m.primaryInstanceName = mgr.PrimaryInstances[m.Context].Name
-		primaryInstance = mgr.PrimaryInstances[m.Context]
+		m.logger.Debug(
+			"Non-primary instance registration",
+			zap.String("instance_name", m.Name),
+			zap.Uint64("generation", gen.id),
+		)
+		primaryInstance = gen.primaries[m.Context]
+		m.primaryInstanceName = primaryInstance.Name
+		delete(gen.backlog, m.Name)
+		mgr.Members[m.Name] = m
 	}
 
 	// Set authentication redirect URL.
@@ -167,22 +226,47 @@ func (mgr *InstanceManager) Register(ctx context.Context, m *Authorizer) error {
 		m.bypassEnabled = true
 	}
 
-	// Configure header injection.
+	// Configure header injection. The passing of the claims with the default
+	// headers is inherited from the primary instance prior to building the
+	// list of the removed headers.
+	if !m.PrimaryInstance {
+		m.PassClaimsWithHeaders = primaryInstance.PassClaimsWithHeaders
+	}
 	if len(m.HeaderInjectionConfigs) == 0 && !m.PrimaryInstance {
 		if len(primaryInstance.HeaderInjectionConfigs) > 0 {
 			m.HeaderInjectionConfigs = primaryInstance.HeaderInjectionConfigs
 		}
 	}
 
+	if len(m.RemoveHeaders) == 0 && !m.PrimaryInstance {
+		m.RemoveHeaders = primaryInstance.RemoveHeaders
+	}
+
 	if len(m.HeaderInjectionConfigs) > 0 {
-		m.injectedHeaders = make(map[string]bool)
 		for _, entry := range m.HeaderInjectionConfigs {
 			if err := entry.Validate(); err != nil {
 				return errors.ErrInvalidConfiguration.WithArgs(m.Name, err)
 			}
-			m.injectedHeaders[entry.Header] = true
 		}
-		m.PassClaimsWithHeaders = true
+		if m.PrimaryInstance {
+			m.PassClaimsWithHeaders = true
+		}
+	}
+
+	// The injected headers are removed from the requests, so that the
+	// clients could not spoof them.
+	m.removedHeaders = nil
+	for _, k := range m.RemoveHeaders {
+		if strings.TrimSpace(k) == "" {
+			return errors.ErrInvalidConfiguration.WithArgs(m.Name, "empty name of the header to remove")
+		}
+		m.removedHeaders = append(m.removedHeaders, k)
+	}
+	if m.PassClaimsWithHeaders {
+		m.removedHeaders = append(m.removedHeaders, defaultInjectedHeaders...)
+	}
+	for _, entry := range m.HeaderInjectionConfigs {
+		m.removedHeaders = append(m.removedHeaders, entry.Header)
 	}
 
 	// Set miscellaneous parameters.
@@ -190,12 +274,71 @@ func (mgr *InstanceManager) Register(ctx context.Context, m *Authorizer) error {
 		if m.ForbiddenURL == "" {
 			m.ForbiddenURL = primaryInstance.ForbiddenURL
 		}
-		m.PassClaimsWithHeaders = primaryInstance.PassClaimsWithHeaders
 		m.RedirectWithJavascript = primaryInstance.RedirectWithJavascript
 	}
 
-	// Initialize token validator and associated options.
-	m.tokenValidator = validator.NewTokenValidator()
+	// Load the page templates.
+	if len(m.PageTemplates) == 0 && !m.PrimaryInstance {
+		m.PageTemplates = primaryInstance.PageTemplates
+	}
+	pages, err := handlers.LoadPageTemplates(m.PageTemplates)
+	if err != nil {
+		return errors.ErrInvalidConfiguration.WithArgs(m.Name, err)
+	}
+	m.pages = pages
+
+	// Configure the trusted proxies and the allowed redirect hosts.
+	if !m.PrimaryInstance {
+		if len(m.TrustedProxies) == 0 {
+			m.TrustedProxies = primaryInstance.TrustedProxies
+		}
+		if len(m.RedirectHosts) == 0 {
+			m.RedirectHosts = primaryInstance.RedirectHosts
+		}
+		if len(m.AuthURLHosts) == 0 {
+			m.AuthURLHosts = primaryInstance.AuthURLHosts
+		}
+	}
+	trustedProxies, err := urlutils.ParseTrustedProxies(m.TrustedProxies)
+	if err != nil {
+		return errors.ErrInvalidConfiguration.WithArgs(m.Name, err)
+	}
+	m.trustedProxies = trustedProxies
+
+	// Configure the grantor of the upstream tokens.
+	if m.UpstreamTokenConfig == nil && !m.PrimaryInstance {
+		m.UpstreamTokenConfig = primaryInstance.UpstreamTokenConfig
+	}
+	m.grantor = nil
+	if m.UpstreamTokenConfig != nil {
+		if err := m.UpstreamTokenConfig.Validate(); err != nil {
+			return errors.ErrInvalidConfiguration.WithArgs(m.Name, err)
+		}
+		g, err := m.UpstreamTokenConfig.newTokenGrantor()
+		if err != nil {
+			return errors.ErrInvalidConfiguration.WithArgs(m.Name, err)
+		}
+		m.grantor = g
+	}
+	for _, hosts := range [][]string{m.RedirectHosts, m.AuthURLHosts} {
+		for _, host := range hosts {
+			if host == "" || strings.ContainsAny(host, "/ ") {
+				return errors.ErrInvalidConfiguration.WithArgs(m.Name, fmt.Errorf("host %q is invalid", host))
+			}
+		}
+	}
+
+	// Configure access list decision traces.
+	if m.AccessListTrace == nil && !m.PrimaryInstance {
+		m.AccessListTrace = primaryInstance.AccessListTrace
+	}
+	if m.AccessListTrace != nil {
+		if err := m.AccessListTrace.Validate(); err != nil {
+			return errors.ErrInvalidConfiguration.WithArgs(m.Name, err)
+		}
+	}
+
+	// Initialize token validator options.
 	m.opts = options.NewTokenValidatorOptions()
 
 	if m.ValidateMethodPath {
@@ -262,34 +405,107 @@ func (mgr *InstanceManager) Register(ctx context.Context, m *Authorizer) error {
 		}
 	}
 
+	// Configure the attributes of the auth cookies.
+	if len(m.CookieConfigs) == 0 && !m.PrimaryInstance {
+		m.CookieConfigs = primaryInstance.CookieConfigs
+	}
+	if err := m.configureCookies(); err != nil {
+		return errors.ErrInvalidConfiguration.WithArgs(m.Name, err)
+	}
+
+	// Configure the sliding sessions. The refreshed tokens are signed with
+	// the keys of the instance. The hooks of the primary instance are looked
+	// up per request, since they could be added after the registration.
+	if m.revocationHooks == nil {
+		m.revocationHooks = &revocationHooks{}
+	}
+	if !m.PrimaryInstance {
+		if m.SessionRefreshConfig == nil {
+			m.SessionRefreshConfig = primaryInstance.SessionRefreshConfig
+		}
+		m.primaryRevocationHooks = primaryInstance.revocationHooks
+	}
+	m.keystore = nil
+	if m.SessionRefreshConfig != nil {
+		if err := m.SessionRefreshConfig.Validate(); err != nil {
+			return errors.ErrInvalidConfiguration.WithArgs(m.Name, err)
+		}
+		if err := ks.HasSignKeys(); err != nil {
+			return errors.ErrInvalidConfiguration.WithArgs(m.Name, errors.ErrSessionRefreshNoKeys.WithArgs(err))
+		}
+		m.keystore = ks
+	}
+
 	// Load access list.
-	if len(m.AccessListRules) == 0 && !m.PrimaryInstance {
+	if len(m.AccessListRules) > 0 && m.AccessListFile != "" {
+		return errors.ErrInvalidConfiguration.WithArgs(m.Name, "access list rules and access list file are mutually exclusive")
+	}
+	if len(m.AccessListRules) == 0 && m.AccessListFile == "" && !m.PrimaryInstance {
 		m.AccessListRules = primaryInstance.AccessListRules
+		m.AccessListFile = primaryInstance.AccessListFile
 	}
-	if len(m.AccessListRules) == 0 {
+	if len(m.AccessListRules) == 0 && m.AccessListFile == "" {
 		return errors.ErrInvalidConfiguration.WithArgs(m.Name, "access list rule config not found")
 	}
 	accessList := acl.NewAccessList()
 	accessList.SetLogger(m.logger)
-	if err := accessList.AddRules(ctx, m.AccessListRules); err != nil {
-		return errors.ErrInvalidConfiguration.WithArgs(m.Name, err)
+	if m.AccessListTrace != nil {
+		accessList.EnableTrace()
+	}
+	accessList.EnableDecisions()
+	if len(m.ShadowAccessListRules) == 0 && !m.PrimaryInstance {
+		m.ShadowAccessListRules = primaryInstance.ShadowAccessListRules
+	}
+	if len(m.ShadowAccessListRules) > 0 {
+		shadow := acl.NewAccessList()
+		shadow.SetLogger(m.logger.Named("shadow"))
+		if err := shadow.AddRules(ctx, m.ShadowAccessListRules); err != nil {
+			return errors.ErrInvalidConfiguration.WithArgs(m.Name, fmt.Errorf("shadow acl: %v", err))
+		}
+		accessList.SetShadow(shadow)
 	}
-
-	// Configure token validator with keys and access list.
-	if err := m.tokenValidator.Configure(ctx, ks.GetVerifyKeys(), accessList, m.opts); err != nil {
-		return errors.ErrInvalidConfiguration.WithArgs(m.Name, err)
+	if m.AccessListFile != "" {
+		if err := accessList.WatchFile(ctx, m.AccessListFile, accessListFileReloadInterval); err != nil {
+			return errors.ErrInvalidConfiguration.WithArgs(m.Name, err)
+		}
+	} else {
+		if err := accessList.AddRules(ctx, m.AccessListRules); err != nil {
+			return errors.ErrInvalidConfiguration.WithArgs(m.Name, err)
+		}
 	}
+	m.accessList = accessList
 
 	// Set allow token sources and their priority.
 	if len(m.AllowedTokenSources) == 0 && !m.PrimaryInstance {
 		m.AllowedTokenSources = primaryInstance.AllowedTokenSources
 	}
-	if len(m.AllowedTokenSources) > 0 {
-		if err := m.tokenValidator.SetSourcePriority(m.AllowedTokenSources); err != nil {
+
+	// Configure role hierarchy.
+	if m.RoleHierarchy == nil && !m.PrimaryInstance {
+		// The hierarchy of the primary instance has been validated already.
+		m.RoleHierarchy = primaryInstance.RoleHierarchy
+	} else if m.RoleHierarchy != nil {
+		if err := m.RoleHierarchy.Validate(); err != nil {
 			return errors.ErrInvalidConfiguration.WithArgs(m.Name, err)
 		}
 	}
 
+	// Configure token validator with keys and access list.
+	tokenValidator, err := m.newTokenValidator(ctx, ks.GetVerifyKeys(), accessList, m.opts, m.AllowedTokenSources)
+	if err != nil {
+		return errors.ErrInvalidConfiguration.WithArgs(m.Name, err)
+	}
+	m.tokenValidator = tokenValidator
+
+	// Configure access list policies and their routes.
+	if len(m.AccessListPolicies) == 0 && len(m.AccessListRoutes) == 0 && !m.PrimaryInstance {
+		m.AccessListPolicies = primaryInstance.AccessListPolicies
+		m.AccessListRoutes = primaryInstance.AccessListRoutes
+	}
+	if err := m.configurePolicies(ctx, ks.GetVerifyKeys()); err != nil {
+		return errors.ErrInvalidConfiguration.WithArgs(m.Name, err)
+	}
+
 	m.logger.Debug(
 		"JWT token configuration provisioned",
 		zap.String("instance_name", m.Name),
@@ -297,11 +513,42 @@ func (mgr *InstanceManager) Register(ctx context.Context, m *Authorizer) error {
 		zap.String("token_sources", strings.Join(m.tokenValidator.GetSourcePriority(), " ")),
 		zap.Any("token_validator_options", m.opts),
 		zap.Any("access_list_rules", m.AccessListRules),
+		zap.String("access_list_file", m.AccessListFile),
+		zap.Any("shadow_access_list_rules", m.ShadowAccessListRules),
+		zap.Any("role_hierarchy", m.RoleHierarchy),
+		zap.Any("access_list_policies", m.AccessListPolicies),
+		zap.Any("access_list_routes", m.AccessListRoutes),
 		zap.String("forbidden_path", m.ForbiddenURL),
+		zap.Any("page_templates", m.PageTemplates),
+		zap.Strings("trusted_proxies", m.TrustedProxies),
+		zap.Strings("redirect_hosts", m.RedirectHosts),
+		zap.Strings("auth_url_hosts", m.AuthURLHosts),
+		zap.Bool("upstream_token_enabled", m.grantor != nil),
+		zap.Any("session_refresh", m.SessionRefreshConfig),
+		zap.Any("cookie_configs", m.CookieConfigs),
 	)
 	return nil
 }
 
+// newTokenValidator returns a token validator with the keys, the access list,
+// the options, and the token sources, along with the role hierarchy of an
+// Authorizer instance.
+func (m *Authorizer) newTokenValidator(ctx context.Context, keys []*kms.CryptoKey, accessList *acl.AccessList, opts *options.TokenValidatorOptions, tokenSources []string) (*validator.TokenValidator, error) {
+	tv := validator.NewTokenValidator()
+	if err := tv.Configure(ctx, keys, accessList, opts); err != nil {
+		return nil, err
+	}
+	if len(tokenSources) > 0 {
+		if err := tv.SetSourcePriority(tokenSources); err != nil {
+			return nil, err
+		}
+	}
+	if m.RoleHierarchy != nil {
+		tv.SetRoleHierarchy(m.RoleHierarchy)
+	}
+	return tv, nil
+}
+
 func (mgr *InstanceManager) incrementMemberCount(ctxName string) int {
 	if _, exists := mgr.MemberCount[ctxName]; exists {
 		mgr.MemberCount[ctxName]++
@@ -311,26 +558,82 @@ func (mgr *InstanceManager) incrementMemberCount(ctxName string) int {
 	return mgr.MemberCount[ctxName]
 }
 
-func (mgr *InstanceManager) getInstanceStatus(m *Authorizer) InstanceStatus {
-	primary, primaryFound := mgr.PrimaryInstances[m.Context]
+// getInstanceStatus returns the status of an instance within its generation.
+func getInstanceStatus(gen *instanceGeneration, m *Authorizer) InstanceStatus {
+	primary, primaryFound := gen.primaries[m.Context]
 	if !primaryFound {
-		// Initial startup with no primary instance.
 		if m.PrimaryInstance {
 			return BootstrapPrimary
 		}
 		return DelaySecondary
 	}
-	timeDiff := m.startedAt.Sub(primary.startedAt).Milliseconds()
-	if timeDiff > 1000 {
-		// Reload
-		if m.PrimaryInstance {
+	if m.PrimaryInstance {
+		if primary == m {
 			return BootstrapPrimary
 		}
-		return DelaySecondary
-	}
-	if m.PrimaryInstance {
-		// Initial startup and likely multiple primary instances.
 		return DuplicatePrimary
 	}
 	return BootstrapSecondary
 }
+
+// getGeneration returns the generation of the config having the context.
+// The generation begins when the first instance of the config registers.
+func (mgr *InstanceManager) getGeneration(ctx context.Context) *instanceGeneration {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if gen, exists := mgr.generations[ctx]; exists {
+		return gen
+	}
+	mgr.generationCount++
+	gen := &instanceGeneration{
+		id:        mgr.generationCount,
+		ctx:       ctx,
+		primaries: make(map[string]*Authorizer),
+		backlog:   make(map[string]*Authorizer),
+	}
+	mgr.generations[ctx] = gen
+	return gen
+}
+
+// GetPrimaryInstance returns the primary instance of the context.
+func (mgr *InstanceManager) GetPrimaryInstance(ctxName string) (*Authorizer, error) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+	m, exists := mgr.PrimaryInstances[ctxName]
+	if !exists {
+		return nil, errors.ErrEndpointNoPrimaryInstance.WithArgs(ctxName)
+	}
+	return m, nil
+}
+
+// Unregister removes an instance from the pool. The generation of the
+// instance ends when its last instance is removed.
+func (mgr *InstanceManager) Unregister(m *Authorizer) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+	mgr.unregister(m)
+}
+
+func (mgr *InstanceManager) unregister(m *Authorizer) {
+	gen := m.generation
+	if gen == nil {
+		return
+	}
+	if mgr.Members[m.Name] == m {
+		delete(mgr.Members, m.Name)
+	}
+	if mgr.PrimaryInstances[m.Context] == m {
+		delete(mgr.PrimaryInstances, m.Context)
+	}
+	if gen.primaries[m.Context] == m {
+		delete(gen.primaries, m.Context)
+	}
+	delete(gen.backlog, m.Name)
+	gen.members--
+	if gen.members < 1 && mgr.generations[gen.ctx] == gen {
+		delete(mgr.generations, gen.ctx)
+	}
+	m.generation = nil
+	m.manager = nil
+}
@@ -0,0 +1,134 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"context"
+	"fmt"
+	"github.com/greenpau/caddy-authorize/internal/tests"
+	"github.com/greenpau/caddy-authorize/pkg/acl"
+	"github.com/greenpau/caddy-authorize/pkg/errors"
+	"github.com/greenpau/caddy-authorize/pkg/utils"
+	"testing"
+)
+
+// newReloadConfig returns the instances of a config, i.e. the primary
+// instance and the non-primary instances of the default context. The
+// primary instance has the auth url path unique to the config.
+func newReloadConfig(i int) (*Authorizer, []*Authorizer) {
+	primary := &Authorizer{
+		PrimaryInstance: true,
+		AuthURLPath:     fmt.Sprintf("/auth/%d", i),
+		AccessListRules: []*acl.RuleConfiguration{
+			{
+				Conditions: []string{"match roles viewer"},
+				Action:     `allow`,
+			},
+		},
+		logger: utils.NewLogger(),
+	}
+	var secondaries []*Authorizer
+	for j := 0; j < 2; j++ {
+		secondaries = append(secondaries, &Authorizer{logger: utils.NewLogger()})
+	}
+	return primary, secondaries
+}
+
+func TestInstanceManagerReload(t *testing.T) {
+	var testcases = []struct {
+		name string
+		// The non-primary instances register prior to the primary one.
+		secondaryFirst bool
+		// The instances of the previous config are cleaned up prior to the
+		// provisioning of the next config.
+		cleanupFirst bool
+	}{
+		{name: "reload with primary first"},
+		{name: "reload with secondary first", secondaryFirst: true},
+		{name: "reload with cleanup first", cleanupFirst: true},
+		{name: "reload with secondary and cleanup first", secondaryFirst: true, cleanupFirst: true},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			mgr := NewInstanceManager()
+			var previous []*Authorizer
+			for i := 0; i < 5; i++ {
+				if tc.cleanupFirst {
+					for _, m := range previous {
+						m.Cleanup()
+					}
+				}
+				ctx, cancel := context.WithCancel(context.Background())
+				primary, secondaries := newReloadConfig(i)
+				instances := append([]*Authorizer{primary}, secondaries...)
+				if tc.secondaryFirst {
+					instances = append(secondaries, primary)
+				}
+				for _, m := range instances {
+					if err := mgr.Register(ctx, m); err != nil {
+						t.Fatalf("reload %d: unexpected error: %v", i, err)
+					}
+				}
+				for _, m := range instances {
+					if err := mgr.Validate(ctx, m); err != nil {
+						t.Fatalf("reload %d: unexpected error: %v", i, err)
+					}
+				}
+				for _, m := range secondaries {
+					tests.EvalObjects(t, "primary instance name", primary.Name, m.primaryInstanceName)
+					tests.EvalObjects(t, "auth url path", primary.AuthURLPath, m.AuthURLPath)
+				}
+				if !tc.cleanupFirst {
+					for _, m := range previous {
+						m.Cleanup()
+					}
+				}
+				cancel()
+				previous = instances
+
+				tests.EvalObjects(t, "members", 3, len(mgr.Members))
+				tests.EvalObjects(t, "generations", 1, len(mgr.generations))
+				tests.EvalObjects(t, "primary instance", primary.Name, mgr.PrimaryInstances["default"].Name)
+			}
+			for _, m := range previous {
+				m.Cleanup()
+			}
+			tests.EvalObjects(t, "members", 0, len(mgr.Members))
+			tests.EvalObjects(t, "generations", 0, len(mgr.generations))
+			tests.EvalObjects(t, "primary instances", 0, len(mgr.PrimaryInstances))
+		})
+	}
+}
+
+func TestInstanceManagerDuplicatePrimary(t *testing.T) {
+	mgr := NewInstanceManager()
+	ctx := context.Background()
+	first, _ := newReloadConfig(0)
+	second, _ := newReloadConfig(1)
+	if err := mgr.Register(ctx, first); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err := mgr.Register(ctx, second)
+	tests.EvalErr(t, err, "duplicate primary", true, errors.ErrTooManyPrimaryInstances.WithArgs("default"))
+	tests.EvalObjects(t, "members", 1, len(mgr.Members))
+
+	// The primary instance of another config is not a duplicate, regardless
+	// of the time passed since the provisioning of the first one.
+	third, _ := newReloadConfig(2)
+	if err := mgr.Register(context.WithValue(ctx, struct{}{}, "reload"), third); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tests.EvalObjects(t, "members", 2, len(mgr.Members))
+}
@@ -0,0 +1,219 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"github.com/greenpau/caddy-authorize/pkg/acl"
+	"github.com/prometheus/client_golang/prometheus"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// InstanceRuleCounters is the snapshot of the counters of the access list
+// rules of an Authorizer instance. When the policy is set, the counters are
+// the ones of the access list policy of the instance having the name.
+type InstanceRuleCounters struct {
+	Instance string              `json:"instance,omitempty" xml:"instance,omitempty" yaml:"instance,omitempty"`
+	Context  string              `json:"context,omitempty" xml:"context,omitempty" yaml:"context,omitempty"`
+	Policy   string              `json:"policy,omitempty" xml:"policy,omitempty" yaml:"policy,omitempty"`
+	Rules    []*acl.RuleCounter  `json:"rules,omitempty" xml:"rules,omitempty" yaml:"rules,omitempty"`
+	Shadow   *acl.ShadowCounters `json:"shadow,omitempty" xml:"shadow,omitempty" yaml:"shadow,omitempty"`
+}
+
+// ruleCounterCollector publishes the counters of the access list rules
+// returned by the getter as Prometheus metrics.
+type ruleCounterCollector struct {
+	getter     func() []*InstanceRuleCounters
+	matchDesc  *prometheus.Desc
+	missDesc   *prometheus.Desc
+	shadowDesc *prometheus.Desc
+}
+
+// denialCounter counts the denied requests by their reason codes.
+var denialCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "caddy",
+		Subsystem: "authorize",
+		Name:      "denials_total",
+		Help:      "Counter of the denied requests, by the reason of the denial.",
+	},
+	[]string{"context", "reason"},
+)
+
+// counterManagers holds the instance managers whose instances publish the
+// counters of the access list rules, in the order of their registration.
+var counterManagers struct {
+	mu       sync.Mutex
+	managers []*InstanceManager
+}
+
+func init() {
+	RegisterInstanceManager(AuthManager)
+	// Caddy serves the metrics of the default Prometheus registry.
+	prometheus.MustRegister(newRuleCounterCollector(GetRuleCounters))
+	prometheus.MustRegister(denialCounter)
+}
+
+// RegisterInstanceManager adds the instance manager to the ones whose
+// instances publish the counters of the access list rules, e.g. the
+// manager of the named authorization policies.
+func RegisterInstanceManager(mgr *InstanceManager) {
+	counterManagers.mu.Lock()
+	defer counterManagers.mu.Unlock()
+	for _, entry := range counterManagers.managers {
+		if entry == mgr {
+			return
+		}
+	}
+	counterManagers.managers = append(counterManagers.managers, mgr)
+}
+
+// UnregisterInstanceManager removes the instance manager added with
+// RegisterInstanceManager.
+func UnregisterInstanceManager(mgr *InstanceManager) {
+	counterManagers.mu.Lock()
+	defer counterManagers.mu.Unlock()
+	for i, entry := range counterManagers.managers {
+		if entry == mgr {
+			counterManagers.managers = append(counterManagers.managers[:i], counterManagers.managers[i+1:]...)
+			return
+		}
+	}
+}
+
+// GetRuleCounters returns the snapshot of the counters of the access list
+// rules of the instances of the registered instance managers, sorted by
+// instance name and policy name. When the managers have the instances of
+// the same name, e.g. while a config is being reloaded, the counters of
+// the most recently registered manager are returned.
+func GetRuleCounters() []*InstanceRuleCounters {
+	counterManagers.mu.Lock()
+	managers := append([]*InstanceManager(nil), counterManagers.managers...)
+	counterManagers.mu.Unlock()
+	var entries []*InstanceRuleCounters
+	seen := make(map[[3]string]bool)
+	for i := len(managers) - 1; i >= 0; i-- {
+		for _, entry := range managers[i].GetRuleCounters() {
+			k := [3]string{entry.Instance, entry.Context, entry.Policy}
+			if seen[k] {
+				continue
+			}
+			seen[k] = true
+			entries = append(entries, entry)
+		}
+	}
+	sortRuleCounters(entries)
+	return entries
+}
+
+func sortRuleCounters(entries []*InstanceRuleCounters) {
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Instance != entries[j].Instance {
+			return entries[i].Instance < entries[j].Instance
+		}
+		return entries[i].Policy < entries[j].Policy
+	})
+}
+
+func newRuleCounterCollector(getter func() []*InstanceRuleCounters) *ruleCounterCollector {
+	labels := []string{"instance", "context", "policy", "rule", "tag"}
+	return &ruleCounterCollector{
+		getter: getter,
+		matchDesc: prometheus.NewDesc(
+			prometheus.BuildFQName("caddy", "authorize", "acl_rule_matches_total"),
+			"Counter of the requests matching an access list rule.",
+			labels, nil,
+		),
+		missDesc: prometheus.NewDesc(
+			prometheus.BuildFQName("caddy", "authorize", "acl_rule_misses_total"),
+			"Counter of the requests not matching an access list rule.",
+			labels, nil,
+		),
+		shadowDesc: prometheus.NewDesc(
+			prometheus.BuildFQName("caddy", "authorize", "acl_shadow_decisions_total"),
+			"Counter of the decisions of a shadow access list, by their agreement with the enforced ones.",
+			[]string{"instance", "context", "result"}, nil,
+		),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *ruleCounterCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.matchDesc
+	ch <- c.missDesc
+	ch <- c.shadowDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *ruleCounterCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, entry := range c.getter() {
+		for _, rule := range entry.Rules {
+			labels := []string{entry.Instance, entry.Context, entry.Policy, strconv.Itoa(rule.Index), rule.Tag}
+			ch <- prometheus.MustNewConstMetric(c.matchDesc, prometheus.CounterValue, float64(rule.Match), labels...)
+			ch <- prometheus.MustNewConstMetric(c.missDesc, prometheus.CounterValue, float64(rule.Miss), labels...)
+		}
+		if entry.Shadow == nil {
+			continue
+		}
+		for result, v := range map[string]uint64{
+			"agree":       entry.Shadow.Agree,
+			"would_deny":  entry.Shadow.WouldDeny,
+			"would_allow": entry.Shadow.WouldAllow,
+		} {
+			ch <- prometheus.MustNewConstMetric(c.shadowDesc, prometheus.CounterValue, float64(v), entry.Instance, entry.Context, result)
+		}
+	}
+}
+
+// GetRuleCounters returns the snapshot of the counters of the access list
+// rules and of the shadow access lists of the provisioned instances, and of
+// the access list policies of the instances, sorted by instance name and
+// policy name. The access lists having neither rules with the counter
+// action nor shadow access list are omitted.
+func (mgr *InstanceManager) GetRuleCounters() []*InstanceRuleCounters {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+	var entries []*InstanceRuleCounters
+	for _, m := range mgr.Members {
+		if m.accessList == nil {
+			continue
+		}
+		counters := m.accessList.GetRuleCounters()
+		shadow := m.accessList.GetShadowCounters()
+		if len(counters) > 0 || shadow != nil {
+			entries = append(entries, &InstanceRuleCounters{
+				Instance: m.Name,
+				Context:  m.Context,
+				Rules:    counters,
+				Shadow:   shadow,
+			})
+		}
+		for name, accessList := range m.policyAccessLists {
+			counters := accessList.GetRuleCounters()
+			if len(counters) == 0 {
+				continue
+			}
+			entries = append(entries, &InstanceRuleCounters{
+				Instance: m.Name,
+				Context:  m.Context,
+				Policy:   name,
+				Rules:    counters,
+			})
+		}
+	}
+	sortRuleCounters(entries)
+	return entries
+}
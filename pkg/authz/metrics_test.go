@@ -0,0 +1,260 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"context"
+	"github.com/greenpau/caddy-authorize/internal/tests"
+	"github.com/greenpau/caddy-authorize/pkg/acl"
+	"github.com/greenpau/caddy-authorize/pkg/utils"
+	"github.com/prometheus/client_golang/prometheus"
+	"testing"
+)
+
+func TestRuleCounters(t *testing.T) {
+	var testcases = []struct {
+		name      string
+		instances []*Authorizer
+		inputs    []map[string]interface{}
+		want      []*InstanceRuleCounters
+		metrics   int
+	}{
+		{
+			name: "primary and secondary instances with counters",
+			instances: []*Authorizer{
+				{
+					Context:         "default",
+					PrimaryInstance: true,
+					AccessListRules: []*acl.RuleConfiguration{
+						{
+							Comment:    "allow viewers",
+							Conditions: []string{"match roles viewer"},
+							Action:     `allow counter`,
+						},
+					},
+				},
+				{
+					Context: "default",
+					AccessListRules: []*acl.RuleConfiguration{
+						{
+							Conditions: []string{"match roles admin"},
+							Action:     `allow`,
+						},
+						{
+							Conditions: []string{"match roles editor"},
+							Action:     `allow counter tag editors`,
+						},
+					},
+				},
+			},
+			inputs: []map[string]interface{}{
+				{"roles": []string{"viewer"}},
+				{"roles": []string{"editor"}},
+			},
+			want: []*InstanceRuleCounters{
+				{
+					Instance: "jwt-default-000001",
+					Context:  "default",
+					Rules: []*acl.RuleCounter{
+						{Index: 0, Comment: "allow viewers", Tag: "rule0", Match: 1, Miss: 1},
+					},
+				},
+				{
+					Instance: "jwt-default-000002",
+					Context:  "default",
+					Rules: []*acl.RuleCounter{
+						{Index: 1, Tag: "editors", Match: 1, Miss: 1},
+					},
+				},
+			},
+			metrics: 4,
+		},
+		{
+			name: "instance with shadow access list",
+			instances: []*Authorizer{
+				{
+					Context:         "default",
+					PrimaryInstance: true,
+					AccessListRules: []*acl.RuleConfiguration{
+						{
+							Conditions: []string{"match roles viewer editor"},
+							Action:     `allow`,
+						},
+					},
+					ShadowAccessListRules: []*acl.RuleConfiguration{
+						{
+							Conditions: []string{"match roles editor"},
+							Action:     `allow`,
+						},
+					},
+				},
+			},
+			inputs: []map[string]interface{}{
+				{"roles": []string{"viewer"}},
+				{"roles": []string{"editor"}},
+			},
+			want: []*InstanceRuleCounters{
+				{
+					Instance: "jwt-default-000001",
+					Context:  "default",
+					Shadow: &acl.ShadowCounters{
+						Agree:     1,
+						WouldDeny: 1,
+					},
+				},
+			},
+			metrics: 3,
+		},
+		{
+			name: "instance with access list policy counters",
+			instances: []*Authorizer{
+				{
+					Context:         "default",
+					PrimaryInstance: true,
+					AccessListRules: []*acl.RuleConfiguration{
+						{
+							Conditions: []string{"match roles viewer"},
+							Action:     `allow`,
+						},
+					},
+					AccessListPolicies: []*AccessListPolicy{
+						{
+							Name: "admins",
+							Rules: []*acl.RuleConfiguration{
+								{
+									Conditions: []string{"match roles admin"},
+									Action:     `allow counter tag admins`,
+								},
+							},
+						},
+					},
+					AccessListRoutes: []*AccessListRoute{
+						{Path: "/admin", Policy: "admins"},
+					},
+				},
+			},
+			inputs: []map[string]interface{}{
+				{"roles": []string{"viewer"}},
+				{"roles": []string{"admin"}},
+			},
+			want: []*InstanceRuleCounters{
+				{
+					Instance: "jwt-default-000001",
+					Context:  "default",
+					Policy:   "admins",
+					Rules: []*acl.RuleCounter{
+						{Index: 0, Tag: "admins", Match: 1, Miss: 1},
+					},
+				},
+			},
+			metrics: 2,
+		},
+		{
+			name: "instance without counters",
+			instances: []*Authorizer{
+				{
+					Context:         "default",
+					PrimaryInstance: true,
+					AccessListRules: []*acl.RuleConfiguration{
+						{
+							Conditions: []string{"match roles viewer"},
+							Action:     `allow`,
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			mgr := NewInstanceManager()
+			for _, m := range tc.instances {
+				m.logger = utils.NewLogger()
+				if err := mgr.Register(ctx, m); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				for _, input := range tc.inputs {
+					m.accessList.Allow(ctx, input)
+					for _, accessList := range m.policyAccessLists {
+						accessList.Allow(ctx, input)
+					}
+				}
+			}
+			tests.EvalObjects(t, "counters", tc.want, mgr.GetRuleCounters())
+
+			ch := make(chan prometheus.Metric, 10)
+			newRuleCounterCollector(mgr.GetRuleCounters).Collect(ch)
+			close(ch)
+			if len(ch) != tc.metrics {
+				t.Fatalf("unexpected metric count: %d (actual) vs. %d (expected)", len(ch), tc.metrics)
+			}
+		})
+	}
+}
+
+func TestGetRuleCountersOfRegisteredManagers(t *testing.T) {
+	ctx := context.Background()
+	newInstance := func(tag string) *Authorizer {
+		return &Authorizer{
+			Name:            "admins",
+			Context:         "admins",
+			PrimaryInstance: true,
+			AccessListRules: []*acl.RuleConfiguration{
+				{
+					Conditions: []string{"match roles admin"},
+					Action:     `allow counter tag ` + tag,
+				},
+			},
+			logger: utils.NewLogger(),
+		}
+	}
+	// The managers of the previous and the current config have the
+	// instances of the same name, e.g. the named policies being reloaded.
+	var managers []*InstanceManager
+	for _, tag := range []string{"previous", "current"} {
+		mgr := NewInstanceManager()
+		if err := mgr.Register(ctx, newInstance(tag)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		RegisterInstanceManager(mgr)
+		managers = append(managers, mgr)
+	}
+	defer func() {
+		for _, mgr := range managers {
+			UnregisterInstanceManager(mgr)
+		}
+	}()
+
+	want := []*InstanceRuleCounters{
+		{
+			Instance: "admins",
+			Context:  "admins",
+			Rules: []*acl.RuleCounter{
+				{Index: 0, Tag: "current"},
+			},
+		},
+	}
+	tests.EvalObjects(t, "counters", want, GetRuleCounters())
+
+	UnregisterInstanceManager(managers[1])
+	want[0].Rules[0].Tag = "previous"
+	tests.EvalObjects(t, "counters", want, GetRuleCounters())
+
+	UnregisterInstanceManager(managers[0])
+	if counters := GetRuleCounters(); len(counters) != 0 {
+		t.Fatalf("unexpected counters: %v", counters)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"github.com/greenpau/caddy-authorize/pkg/acl"
+	"github.com/greenpau/caddy-authorize/pkg/user"
+)
+
+// The names of the placeholders published for an authorized user.
+const (
+	placeholderClaimsPrefix = "http.auth.user.claims."
+	placeholderTokenSource  = "http.auth.user.token_source"
+	placeholderRuleTag      = "http.auth.user.rule_tag"
+)
+
+// placeholderSetter is implemented by caddy.Replacer. The interface keeps
+// the package independent of caddy.
+type placeholderSetter interface {
+	Set(variable string, value interface{})
+}
+
+// setPlaceholders publishes the claims of the user, the source of the
+// token, and the tag of the access list rule allowing the request. The
+// placeholders of the claims and the rule tag are cached with the user. The
+// access list is not evaluated again for the cached users unless its
+// decision could change, therefore the cached rule tag is published when
+// no decision was recorded for the request.
+func (m *Authorizer) setPlaceholders(repl placeholderSetter, usr *user.User, decision *acl.Decision) {
+	placeholders := usr.GetRequestPlaceholders()
+	if placeholders == nil {
+		placeholders = make(map[string]string)
+		for k, v := range usr.GetFlatClaims() {
+			placeholders[placeholderClaimsPrefix+k] = v
+		}
+		if decision != nil && decision.Allow {
+			placeholders[placeholderRuleTag] = decision.RuleTag
+		}
+		usr.SetRequestPlaceholders(placeholders)
+	}
+	for k, v := range placeholders {
+		repl.Set(k, v)
+	}
+	if decision != nil && decision.Allow {
+		repl.Set(placeholderRuleTag, decision.RuleTag)
+	}
+	repl.Set(placeholderTokenSource, usr.TokenSource)
+}
@@ -0,0 +1,258 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/greenpau/caddy-authorize/pkg/acl"
+	"github.com/greenpau/caddy-authorize/pkg/kms"
+	"github.com/greenpau/caddy-authorize/pkg/options"
+	"github.com/greenpau/caddy-authorize/pkg/validator"
+)
+
+// AccessListPolicy is a named access list of an Authorizer instance. The
+// policy applies to the requests matching its routes. The policy verifies
+// the tokens with its own keys and finds them in its own token sources,
+// when configured, and with the keys and the token sources of the instance
+// otherwise. The token validation options of the policy are enabled in
+// addition to the ones of the instance.
+type AccessListPolicy struct {
+	Name                        string                   `json:"name,omitempty" xml:"name,omitempty" yaml:"name,omitempty"`
+	Rules                       []*acl.RuleConfiguration `json:"rules,omitempty" xml:"rules,omitempty" yaml:"rules,omitempty"`
+	CryptoKeyConfigs            []*kms.CryptoKeyConfig   `json:"crypto_key_configs,omitempty" xml:"crypto_key_configs,omitempty" yaml:"crypto_key_configs,omitempty"`
+	AllowedTokenSources         []string                 `json:"allowed_token_sources,omitempty" xml:"allowed_token_sources,omitempty" yaml:"allowed_token_sources,omitempty"`
+	ValidateBearerHeader        bool                     `json:"validate_bearer_header,omitempty" xml:"validate_bearer_header,omitempty" yaml:"validate_bearer_header,omitempty"`
+	ValidateMethodPath          bool                     `json:"validate_method_path,omitempty" xml:"validate_method_path,omitempty" yaml:"validate_method_path,omitempty"`
+	ValidateAccessListPathClaim bool                     `json:"validate_access_list_path_claim,omitempty" xml:"validate_access_list_path_claim,omitempty" yaml:"validate_access_list_path_claim,omitempty"`
+	ValidateSourceAddress       bool                     `json:"validate_source_address,omitempty" xml:"validate_source_address,omitempty" yaml:"validate_source_address,omitempty"`
+}
+
+// AccessListRoute maps the requests with the path prefix and, optionally,
+// the HTTP methods to a named access list policy.
+type AccessListRoute struct {
+	Path    string   `json:"path,omitempty" xml:"path,omitempty" yaml:"path,omitempty"`
+	Methods []string `json:"methods,omitempty" xml:"methods,omitempty" yaml:"methods,omitempty"`
+	Policy  string   `json:"policy,omitempty" xml:"policy,omitempty" yaml:"policy,omitempty"`
+}
+
+// policyRoute is the compiled AccessListRoute.
+type policyRoute struct {
+	path           string
+	methods        map[string]bool
+	tokenValidator *validator.TokenValidator
+}
+
+// Validate validates AccessListPolicy.
+func (p *AccessListPolicy) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("undefined access list policy name")
+	}
+	if len(p.Rules) == 0 {
+		return fmt.Errorf("access list policy %q has no rules", p.Name)
+	}
+	return nil
+}
+
+// Validate validates AccessListRoute.
+func (r *AccessListRoute) Validate() error {
+	if !strings.HasPrefix(r.Path, "/") {
+		return fmt.Errorf("access list route path %q must begin with a slash", r.Path)
+	}
+	if r.Policy == "" {
+		return fmt.Errorf("access list route %q has no policy", r.Path)
+	}
+	for i, method := range r.Methods {
+		if method == "" {
+			return fmt.Errorf("access list route %q has empty method", r.Path)
+		}
+		r.Methods[i] = strings.ToUpper(method)
+	}
+	return nil
+}
+
+// match returns true when the request path is the route path or is
+// under it, e.g. the route path /api matches /api and /api/users, but
+// not /apiary.
+func (r *policyRoute) match(req *http.Request) bool {
+	p := getRoutePath(req)
+	if !strings.HasPrefix(p, r.path) {
+		return false
+	}
+	if len(p) != len(r.path) && !strings.HasSuffix(r.path, "/") && p[len(r.path)] != '/' {
+		return false
+	}
+	if len(r.methods) == 0 {
+		return true
+	}
+	return r.methods[req.Method]
+}
+
+// getRoutePath returns the request path the routes are matched against.
+// The escaped path is decoded and cleaned, i.e. /api/../admin and
+// /api/%2e%2e/admin become /admin. The trailing slash is preserved.
+func getRoutePath(req *http.Request) string {
+	p, err := url.PathUnescape(req.URL.EscapedPath())
+	if err != nil {
+		p = req.URL.Path
+	}
+	cleaned := path.Clean("/" + p)
+	if cleaned != "/" && strings.HasSuffix(p, "/") {
+		cleaned += "/"
+	}
+	return cleaned
+}
+
+// getOptions returns the token validator options of the policy, i.e. the
+// options of the instance with the options of the policy enabled.
+func (p *AccessListPolicy) getOptions(opts *options.TokenValidatorOptions) *options.TokenValidatorOptions {
+	policyOpts := *opts
+	if p.ValidateBearerHeader {
+		policyOpts.ValidateBearerHeader = true
+	}
+	if p.ValidateMethodPath {
+		policyOpts.ValidateMethodPath = true
+	}
+	if p.ValidateAccessListPathClaim {
+		policyOpts.ValidateAccessListPathClaim = true
+	}
+	if p.ValidateSourceAddress {
+		policyOpts.ValidateSourceAddress = true
+	}
+	return &policyOpts
+}
+
+// getVerifyKeys returns the token verification keys of the policy, or the
+// keys of the instance when the policy has no keys. The defaults of the
+// key store of the instance, e.g. the token name, apply to the keys of the
+// policy.
+func (p *AccessListPolicy) getVerifyKeys(defaults map[string]interface{}, keys []*kms.CryptoKey) ([]*kms.CryptoKey, error) {
+	if len(p.CryptoKeyConfigs) == 0 {
+		return keys, nil
+	}
+	ks := kms.NewCryptoKeyStore()
+	if defaults != nil {
+		if err := ks.AddDefaults(defaults); err != nil {
+			return nil, err
+		}
+	}
+	if err := ks.AddKeysWithConfigs(p.CryptoKeyConfigs); err != nil {
+		return nil, err
+	}
+	if err := ks.HasVerifyKeys(); err != nil {
+		return nil, err
+	}
+	return ks.GetVerifyKeys(), nil
+}
+
+// configurePolicies compiles the access list policies and the routes of an
+// Authorizer instance. Each policy gets its own token validator, because
+// the validator caches the users authorized by its access list. The routes
+// are ordered by the length of their paths, longest first, and the routes
+// with methods precede the ones without.
+func (m *Authorizer) configurePolicies(ctx context.Context, keys []*kms.CryptoKey) error {
+	validators := make(map[string]*validator.TokenValidator)
+	accessLists := make(map[string]*acl.AccessList)
+	for _, p := range m.AccessListPolicies {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		if _, exists := validators[p.Name]; exists {
+			return fmt.Errorf("access list policy %q is duplicate", p.Name)
+		}
+		accessList := acl.NewAccessList()
+		accessList.SetLogger(m.logger.Named("policy").Named(p.Name))
+		if m.AccessListTrace != nil {
+			accessList.EnableTrace()
+		}
+		accessList.EnableDecisions()
+		if err := accessList.AddRules(ctx, p.Rules); err != nil {
+			return fmt.Errorf("access list policy %q: %v", p.Name, err)
+		}
+		policyKeys, err := p.getVerifyKeys(m.CryptoKeyStoreConfig, keys)
+		if err != nil {
+			return fmt.Errorf("access list policy %q: %v", p.Name, err)
+		}
+		tokenSources := m.AllowedTokenSources
+		if len(p.AllowedTokenSources) > 0 {
+			tokenSources = p.AllowedTokenSources
+		}
+		tv, err := m.newTokenValidator(ctx, policyKeys, accessList, p.getOptions(m.opts), tokenSources)
+		if err != nil {
+			return fmt.Errorf("access list policy %q: %v", p.Name, err)
+		}
+		validators[p.Name] = tv
+		accessLists[p.Name] = accessList
+	}
+
+	var routes []*policyRoute
+	seen := make(map[string]bool)
+	for _, r := range m.AccessListRoutes {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+		tv, exists := validators[r.Policy]
+		if !exists {
+			return fmt.Errorf("access list route %q references undefined policy %q", r.Path, r.Policy)
+		}
+		route := &policyRoute{
+			path:           r.Path,
+			methods:        make(map[string]bool),
+			tokenValidator: tv,
+		}
+		keys := []string{r.Path + " *"}
+		if len(r.Methods) > 0 {
+			keys = nil
+			for _, method := range r.Methods {
+				route.methods[method] = true
+				keys = append(keys, r.Path+" "+method)
+			}
+		}
+		for _, k := range keys {
+			if seen[k] {
+				return fmt.Errorf("access list route %q is duplicate", k)
+			}
+			seen[k] = true
+		}
+		routes = append(routes, route)
+	}
+	sort.SliceStable(routes, func(i, j int) bool {
+		if len(routes[i].path) != len(routes[j].path) {
+			return len(routes[i].path) > len(routes[j].path)
+		}
+		return len(routes[i].methods) > 0 && len(routes[j].methods) == 0
+	})
+	m.policyRoutes = routes
+	m.policyAccessLists = accessLists
+	return nil
+}
+
+// getTokenValidator returns the token validator of the access list policy
+// matching the request. The token validator of the instance access list is
+// returned when no route matches the request.
+func (m *Authorizer) getTokenValidator(r *http.Request) *validator.TokenValidator {
+	for _, route := range m.policyRoutes {
+		if route.match(r) {
+			return route.tokenValidator
+		}
+	}
+	return m.tokenValidator
+}
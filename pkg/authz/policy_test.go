@@ -0,0 +1,262 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"context"
+	"fmt"
+	"github.com/greenpau/caddy-authorize/internal/tests"
+	"github.com/greenpau/caddy-authorize/internal/testutils"
+	"github.com/greenpau/caddy-authorize/pkg/acl"
+	"github.com/greenpau/caddy-authorize/pkg/errors"
+	"github.com/greenpau/caddy-authorize/pkg/kms"
+	"github.com/greenpau/caddy-authorize/pkg/user"
+	"github.com/greenpau/caddy-authorize/pkg/utils"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAccessListPolicies(t *testing.T) {
+	policies := []*AccessListPolicy{
+		{
+			Name:  "api",
+			Rules: []*acl.RuleConfiguration{{Conditions: []string{"match roles api"}, Action: `allow`}},
+		},
+		{
+			Name:  "api-users",
+			Rules: []*acl.RuleConfiguration{{Conditions: []string{"match roles users"}, Action: `allow`}},
+		},
+		{
+			Name:  "admin",
+			Rules: []*acl.RuleConfiguration{{Conditions: []string{"match roles admin"}, Action: `allow`}},
+		},
+	}
+	routes := []*AccessListRoute{
+		{Path: "/api", Policy: "api"},
+		{Path: "/api/users", Policy: "api-users"},
+		{Path: "/api/users", Methods: []string{"delete"}, Policy: "admin"},
+		{Path: "/admin/", Policy: "admin"},
+	}
+
+	var testcases = []struct {
+		name   string
+		method string
+		url    string
+		roles  []interface{}
+		want   bool
+	}{
+		{name: "default access list", url: "https://app.contoso.com/dashboard", roles: []interface{}{"viewer"}, want: true},
+		{name: "default access list denies api role", url: "https://app.contoso.com/dashboard", roles: []interface{}{"api"}},
+		{name: "api policy", url: "https://app.contoso.com/api/orders", roles: []interface{}{"api"}, want: true},
+		{name: "api policy denies viewer", url: "https://app.contoso.com/api", roles: []interface{}{"viewer"}},
+		{name: "api policy does not match sibling path", url: "https://app.contoso.com/apiary", roles: []interface{}{"viewer"}, want: true},
+		{name: "longest prefix policy", url: "https://app.contoso.com/api/users/jsmith", roles: []interface{}{"users"}, want: true},
+		{name: "longest prefix policy denies api role", url: "https://app.contoso.com/api/users", roles: []interface{}{"api"}},
+		{name: "method policy", method: "DELETE", url: "https://app.contoso.com/api/users/jsmith", roles: []interface{}{"admin"}, want: true},
+		{name: "method policy denies users role", method: "DELETE", url: "https://app.contoso.com/api/users/jsmith", roles: []interface{}{"users"}},
+		{name: "trailing slash policy", url: "https://app.contoso.com/admin/settings", roles: []interface{}{"admin"}, want: true},
+		{name: "trailing slash policy denies viewer", url: "https://app.contoso.com/admin/", roles: []interface{}{"viewer"}},
+		{name: "traversal out of api policy", url: "https://app.contoso.com/api/../admin/settings", roles: []interface{}{"api"}},
+		{name: "traversal into admin policy", url: "https://app.contoso.com/dashboard/../admin/settings", roles: []interface{}{"viewer"}},
+		{name: "encoded traversal into admin policy", url: "https://app.contoso.com/dashboard/%2e%2e/admin/settings", roles: []interface{}{"viewer"}},
+		{name: "uppercase encoded traversal into admin policy", url: "https://app.contoso.com/dashboard/%2E%2E/admin/settings", roles: []interface{}{"viewer"}},
+		{name: "mixed encoded traversal into admin policy", url: "https://app.contoso.com/dashboard/.%2e/admin/settings", roles: []interface{}{"viewer"}},
+		{name: "encoded slash traversal into admin policy", url: "https://app.contoso.com/dashboard%2f%2e%2e%2fadmin/settings", roles: []interface{}{"viewer"}},
+		{name: "duplicate slashes into admin policy", url: "https://app.contoso.com//admin//settings", roles: []interface{}{"viewer"}},
+		{name: "dot segment within api policy", url: "https://app.contoso.com/api/./orders", roles: []interface{}{"api"}, want: true},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := &Authorizer{
+				PrimaryInstance: true,
+				Context:         "default",
+				AccessListRules: []*acl.RuleConfiguration{
+					{Conditions: []string{"match roles viewer"}, Action: `allow`},
+				},
+				AccessListPolicies: policies,
+				AccessListRoutes:   routes,
+				logger:             utils.NewLogger(),
+			}
+			if err := NewInstanceManager().Register(context.Background(), m); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			result, err := m.Eval(&EvalRequest{
+				Claims: map[string]interface{}{"roles": tc.roles},
+				Method: tc.method,
+				URL:    tc.url,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			tests.EvalObjects(t, "allow", tc.want, result.Allow)
+		})
+	}
+}
+
+func TestAccessListPolicyConfig(t *testing.T) {
+	rules := []*acl.RuleConfiguration{{Conditions: []string{"match roles api"}, Action: `allow`}}
+	var testcases = []struct {
+		name      string
+		policies  []*AccessListPolicy
+		routes    []*AccessListRoute
+		shouldErr bool
+		err       error
+	}{
+		{
+			name:     "valid policy and route",
+			policies: []*AccessListPolicy{{Name: "api", Rules: rules}},
+			routes:   []*AccessListRoute{{Path: "/api", Methods: []string{"get"}, Policy: "api"}},
+		},
+		{
+			name:      "duplicate policy",
+			policies:  []*AccessListPolicy{{Name: "api", Rules: rules}, {Name: "api", Rules: rules}},
+			shouldErr: true,
+			err:       fmt.Errorf(`access list policy "api" is duplicate`),
+		},
+		{
+			name:      "route with undefined policy",
+			policies:  []*AccessListPolicy{{Name: "api", Rules: rules}},
+			routes:    []*AccessListRoute{{Path: "/admin", Policy: "admin"}},
+			shouldErr: true,
+			err:       fmt.Errorf(`access list route "/admin" references undefined policy "admin"`),
+		},
+		{
+			name:     "duplicate route",
+			policies: []*AccessListPolicy{{Name: "api", Rules: rules}},
+			routes: []*AccessListRoute{
+				{Path: "/api", Methods: []string{"get", "post"}, Policy: "api"},
+				{Path: "/api", Methods: []string{"POST"}, Policy: "api"},
+			},
+			shouldErr: true,
+			err:       fmt.Errorf(`access list route "/api POST" is duplicate`),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := &Authorizer{
+				PrimaryInstance:    true,
+				Context:            "default",
+				AccessListRules:    rules,
+				AccessListPolicies: tc.policies,
+				AccessListRoutes:   tc.routes,
+				logger:             utils.NewLogger(),
+			}
+			err := NewInstanceManager().Register(context.Background(), m)
+			if tc.err != nil {
+				tc.err = errors.ErrInvalidConfiguration.WithArgs("jwt-default-000001", tc.err)
+			}
+			if tests.EvalErr(t, err, tc.routes, tc.shouldErr, tc.err) {
+				return
+			}
+			tests.EvalObjects(t, "routes", len(tc.routes), len(m.policyRoutes))
+		})
+	}
+}
+
+func TestAccessListPolicyTokenSettings(t *testing.T) {
+	// The api policy verifies the bearer tokens in the Authorization header
+	// with its own key, whereas the instance verifies the cookies with the
+	// shared key.
+	apiSharedKey := "4a0c1e30-3c6e-4a5b-9d5a-2d6f2f7a0a61"
+	newToken := func(sharedKey string, roles string) string {
+		configs, err := kms.ParseCryptoKeyConfigs("crypto key sign-verify " + sharedKey)
+		if err != nil {
+			t.Fatalf("failed parsing key config: %v", err)
+		}
+		keys, err := kms.GetKeysFromConfigs(configs)
+		if err != nil {
+			t.Fatalf("failed getting keys: %v", err)
+		}
+		ks := kms.NewCryptoKeyStore()
+		if err := ks.AddKeys(keys); err != nil {
+			t.Fatalf("failed adding keys: %v", err)
+		}
+		usr, err := user.NewUser(map[string]interface{}{
+			"exp":   float64(time.Now().Add(10 * time.Minute).Unix()),
+			"sub":   "smithj@outlook.com",
+			"roles": roles,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := ks.SignToken("access_token", "HS512", usr); err != nil {
+			t.Fatalf("failed signing token: %v", err)
+		}
+		return usr.Token
+	}
+	instanceKeys, err := kms.ParseCryptoKeyConfigs("crypto key verify " + testutils.GetSharedKey())
+	if err != nil {
+		t.Fatalf("failed parsing key config: %v", err)
+	}
+	apiKeys, err := kms.ParseCryptoKeyConfigs("crypto key verify " + apiSharedKey)
+	if err != nil {
+		t.Fatalf("failed parsing key config: %v", err)
+	}
+	m := &Authorizer{
+		PrimaryInstance:     true,
+		Context:             "default",
+		CryptoKeyConfigs:    instanceKeys,
+		AllowedTokenSources: []string{"cookie"},
+		AccessListRules: []*acl.RuleConfiguration{
+			{Conditions: []string{"match roles admin"}, Action: `allow`},
+		},
+		AccessListPolicies: []*AccessListPolicy{
+			{
+				Name:                 "api",
+				Rules:                []*acl.RuleConfiguration{{Conditions: []string{"match roles api"}, Action: `allow`}},
+				CryptoKeyConfigs:     apiKeys,
+				AllowedTokenSources:  []string{"header"},
+				ValidateBearerHeader: true,
+			},
+		},
+		AccessListRoutes: []*AccessListRoute{{Path: "/api", Policy: "api"}},
+		logger:           utils.NewLogger(),
+	}
+	if err := NewInstanceManager().Register(context.Background(), m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	adminToken := newToken(testutils.GetSharedKey(), "admin")
+	apiToken := newToken(apiSharedKey, "api")
+
+	var testcases = []struct {
+		name   string
+		path   string
+		cookie string
+		bearer string
+		want   bool
+	}{
+		{name: "admin cookie", path: "/admin", cookie: adminToken, want: true},
+		{name: "admin bearer token is not accepted by instance", path: "/admin", bearer: adminToken},
+		{name: "api bearer token", path: "/api/orders", bearer: apiToken, want: true},
+		{name: "api cookie is not accepted by api policy", path: "/api/orders", cookie: apiToken},
+		{name: "admin bearer token is not verified by api policy key", path: "/api/orders", bearer: adminToken},
+		{name: "api cookie is not verified by instance key", path: "/admin", cookie: apiToken},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", tc.path, nil)
+			if tc.cookie != "" {
+				r.AddCookie(&http.Cookie{Name: "access_token", Value: tc.cookie})
+			}
+			if tc.bearer != "" {
+				r.Header.Set("Authorization", "Bearer "+tc.bearer)
+			}
+			_, ok, _ := m.Authenticate(httptest.NewRecorder(), r, nil)
+			tests.EvalObjects(t, "allow", tc.want, ok)
+		})
+	}
+}
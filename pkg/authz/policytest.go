@@ -0,0 +1,156 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"fmt"
+	"github.com/google/go-cmp/cmp"
+	"github.com/greenpau/caddy-authorize/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// PolicyTestCase is a test case of the policy of an Authorizer instance. It
+// holds the claims of the user, or a token, the request, and the expected
+// outcome of the evaluation of the request.
+type PolicyTestCase struct {
+	Name    string                 `json:"name,omitempty" xml:"name,omitempty" yaml:"name,omitempty"`
+	Token   string                 `json:"token,omitempty" xml:"token,omitempty" yaml:"token,omitempty"`
+	Claims  map[string]interface{} `json:"claims,omitempty" xml:"claims,omitempty" yaml:"claims,omitempty"`
+	Request *PolicyTestRequest     `json:"request,omitempty" xml:"request,omitempty" yaml:"request,omitempty"`
+	Expect  *PolicyTestOutcome     `json:"expect,omitempty" xml:"expect,omitempty" yaml:"expect,omitempty"`
+}
+
+// PolicyTestRequest is the request of a policy test case.
+type PolicyTestRequest struct {
+	Method        string `json:"method,omitempty" xml:"method,omitempty" yaml:"method,omitempty"`
+	URL           string `json:"url,omitempty" xml:"url,omitempty" yaml:"url,omitempty"`
+	SourceAddress string `json:"source_address,omitempty" xml:"source_address,omitempty" yaml:"source_address,omitempty"`
+}
+
+// PolicyTestOutcome is the outcome of a policy test case, i.e. whether the
+// request is allowed and the tag of the access list rule which decided it.
+// The rule is empty when no rule decided the outcome, e.g. no rule allowed
+// the request.
+type PolicyTestOutcome struct {
+	Allow bool   `json:"allow" xml:"allow" yaml:"allow"`
+	Rule  string `json:"rule" xml:"rule" yaml:"rule"`
+}
+
+// PolicyTestSuite runs policy test cases against an Authorizer instance.
+// The instance must have the access list trace configured.
+type PolicyTestSuite struct {
+	Cases      []*PolicyTestCase `json:"cases,omitempty" xml:"cases,omitempty" yaml:"cases,omitempty"`
+	authorizer *Authorizer
+}
+
+// NewPolicyTestSuite returns an instance of PolicyTestSuite with the test
+// cases in YAML format.
+func NewPolicyTestSuite(m *Authorizer, data []byte) (*PolicyTestSuite, error) {
+	if m.AccessListTrace == nil {
+		return nil, errors.ErrPolicyTestNoTrace.WithArgs(m.Name)
+	}
+	s := &PolicyTestSuite{authorizer: m}
+	if err := yaml.UnmarshalStrict(data, &s.Cases); err != nil {
+		return nil, errors.ErrPolicyTestParse.WithArgs(err)
+	}
+	for i, tc := range s.Cases {
+		if tc == nil || tc.Request == nil || tc.Expect == nil {
+			return nil, errors.ErrPolicyTestParse.WithArgs(fmt.Errorf("test case %d must have request and expect", i))
+		}
+		if tc.Name == "" {
+			tc.Name = fmt.Sprintf("case %d", i)
+		}
+		claims, err := normalizePolicyTestValue(tc.Claims)
+		if err != nil {
+			return nil, errors.ErrPolicyTestParse.WithArgs(err)
+		}
+		if claims != nil {
+			tc.Claims = claims.(map[string]interface{})
+		}
+	}
+	return s, nil
+}
+
+// Len returns the number of the test cases.
+func (s *PolicyTestSuite) Len() int {
+	return len(s.Cases)
+}
+
+// Run runs the test case at the index. It returns the name of the test case
+// and the difference between the expected and the actual outcome. The
+// difference is empty when the test case passed.
+func (s *PolicyTestSuite) Run(i int) (string, string, error) {
+	tc := s.Cases[i]
+	result, err := s.authorizer.Eval(&EvalRequest{
+		Token:         tc.Token,
+		Claims:        tc.Claims,
+		Method:        tc.Request.Method,
+		URL:           tc.Request.URL,
+		SourceAddress: tc.Request.SourceAddress,
+	})
+	if err != nil {
+		return tc.Name, "", err
+	}
+	got := &PolicyTestOutcome{Allow: result.Allow}
+	if result.Rule != nil {
+		got.Rule = result.Rule.Tag
+	}
+	return tc.Name, cmp.Diff(tc.Expect, got), nil
+}
+
+// normalizePolicyTestValue converts the maps decoded from YAML to the maps
+// having string keys, as expected for the claims of a user.
+func normalizePolicyTestValue(v interface{}) (interface{}, error) {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		if value == nil {
+			return nil, nil
+		}
+		m := make(map[string]interface{})
+		for k, item := range value {
+			normalized, err := normalizePolicyTestValue(item)
+			if err != nil {
+				return nil, err
+			}
+			m[k] = normalized
+		}
+		return m, nil
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{})
+		for k, item := range value {
+			key, ok := k.(string)
+			if !ok {
+				return nil, fmt.Errorf("claim key %v is not a string", k)
+			}
+			normalized, err := normalizePolicyTestValue(item)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = normalized
+		}
+		return m, nil
+	case []interface{}:
+		items := make([]interface{}, len(value))
+		for i, item := range value {
+			normalized, err := normalizePolicyTestValue(item)
+			if err != nil {
+				return nil, err
+			}
+			items[i] = normalized
+		}
+		return items, nil
+	}
+	return v, nil
+}
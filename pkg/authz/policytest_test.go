@@ -0,0 +1,197 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"context"
+	"fmt"
+	"github.com/greenpau/caddy-authorize/internal/tests"
+	"github.com/greenpau/caddy-authorize/pkg/acl"
+	"github.com/greenpau/caddy-authorize/pkg/errors"
+	"github.com/greenpau/caddy-authorize/pkg/utils"
+	"testing"
+)
+
+func newPolicyTestAuthorizer(t *testing.T, trace *AccessListTraceConfig) *Authorizer {
+	m := &Authorizer{
+		PrimaryInstance: true,
+		Context:         "default",
+		AccessListRules: []*acl.RuleConfiguration{
+			{
+				Conditions: []string{"prefix match claim.metadata.department eng"},
+				Action:     `allow stop tag engineering`,
+			},
+			{
+				Conditions: []string{"exact match host admin.contoso.com"},
+				Action:     `deny stop tag admin-host`,
+			},
+			{
+				Conditions: []string{"match roles admin editor"},
+				Action:     `allow tag editors`,
+			},
+		},
+		AccessListTrace: trace,
+		logger:          utils.NewLogger(),
+	}
+	if err := NewInstanceManager().Register(context.Background(), m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return m
+}
+
+func TestPolicyTestSuite(t *testing.T) {
+	m := newPolicyTestAuthorizer(t, &AccessListTraceConfig{})
+	suite, err := NewPolicyTestSuite(m, []byte(`
+- name: editor allowed on app
+  claims:
+    roles: [editor]
+  request:
+    url: https://app.contoso.com/dashboard
+  expect:
+    allow: true
+    rule: editors
+- name: editor denied on admin host
+  claims:
+    roles: [editor]
+  request:
+    method: POST
+    url: https://admin.contoso.com/users
+    source_address: 10.0.0.1
+  expect:
+    allow: false
+    rule: admin-host
+- name: engineering allowed on admin host
+  claims:
+    roles: [viewer]
+    metadata:
+      department: engineering
+  request:
+    url: https://admin.contoso.com/users
+  expect:
+    allow: true
+    rule: engineering
+- claims:
+    roles: [viewer]
+  request:
+    url: https://app.contoso.com/dashboard
+  expect:
+    allow: false
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tests.EvalPolicyTests(t, suite)
+}
+
+func TestPolicyTestSuiteDiff(t *testing.T) {
+	m := newPolicyTestAuthorizer(t, &AccessListTraceConfig{})
+	suite, err := NewPolicyTestSuite(m, []byte(`
+- name: viewer allowed on app
+  claims:
+    roles: [viewer]
+  request:
+    url: https://app.contoso.com/dashboard
+  expect:
+    allow: true
+    rule: viewers
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	name, diff, err := suite.Run(0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tests.EvalObjects(t, "name", "viewer allowed on app", name)
+	if diff == "" {
+		t.Fatalf("expected diff, but got none")
+	}
+}
+
+func TestNewPolicyTestSuite(t *testing.T) {
+	var testcases = []struct {
+		name      string
+		trace     *AccessListTraceConfig
+		data      string
+		want      []*PolicyTestCase
+		shouldErr bool
+		err       error
+	}{
+		{
+			name:  "parse test case with nested claims",
+			trace: &AccessListTraceConfig{},
+			data: `
+- claims:
+    roles: [viewer]
+    metadata:
+      department: eng
+  request:
+    url: https://app.contoso.com/
+  expect:
+    allow: true
+`,
+			want: []*PolicyTestCase{
+				{
+					Name: "case 0",
+					Claims: map[string]interface{}{
+						"roles":    []interface{}{"viewer"},
+						"metadata": map[string]interface{}{"department": "eng"},
+					},
+					Request: &PolicyTestRequest{URL: "https://app.contoso.com/"},
+					Expect:  &PolicyTestOutcome{Allow: true},
+				},
+			},
+		},
+		{
+			name:      "fail without access list trace",
+			data:      `[]`,
+			shouldErr: true,
+			err:       errors.ErrPolicyTestNoTrace.WithArgs("jwt-default-000001"),
+		},
+		{
+			name:  "fail without expected outcome",
+			trace: &AccessListTraceConfig{},
+			data: `
+- claims:
+    roles: [viewer]
+  request:
+    url: https://app.contoso.com/
+`,
+			shouldErr: true,
+			err:       errors.ErrPolicyTestParse.WithArgs(fmt.Errorf("test case 0 must have request and expect")),
+		},
+		{
+			name:  "fail with unknown field",
+			trace: &AccessListTraceConfig{},
+			data: `
+- foo: bar
+`,
+			shouldErr: true,
+			err: errors.ErrPolicyTestParse.WithArgs(
+				fmt.Errorf("yaml: unmarshal errors:\n  line 2: field foo not found in type authz.PolicyTestCase"),
+			),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := newPolicyTestAuthorizer(t, tc.trace)
+			suite, err := NewPolicyTestSuite(m, []byte(tc.data))
+			if tests.EvalErr(t, err, tc.data, tc.shouldErr, tc.err) {
+				return
+			}
+			tests.EvalObjects(t, "cases", tc.want, suite.Cases)
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"fmt"
+	"github.com/greenpau/caddy-authorize/pkg/errors"
+	"github.com/greenpau/caddy-authorize/pkg/user"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// SessionRefreshConfig is the configuration of the sliding sessions. When
+// a token found in a cookie is about to expire, the token is re-signed with
+// a fresh expiry, and the cookie is updated.
+type SessionRefreshConfig struct {
+	// The number of seconds prior to the expiry of a token when the token
+	// gets refreshed.
+	Window int `json:"window,omitempty" xml:"window,omitempty" yaml:"window,omitempty"`
+	// The lifetime of the refreshed token in seconds. Defaults to the token
+	// lifetime of the signing key.
+	Lifetime int `json:"lifetime,omitempty" xml:"lifetime,omitempty" yaml:"lifetime,omitempty"`
+	// The maximum age of a session in seconds, counted from the time of the
+	// authentication. The refreshed tokens do not outlive the session.
+	MaxSessionAge int `json:"max_session_age,omitempty" xml:"max_session_age,omitempty" yaml:"max_session_age,omitempty"`
+}
+
+// RevocationHook returns true when the token of the user is revoked, e.g.
+// by its jti claim. The revoked tokens are denied and never refreshed.
+type RevocationHook func(usr *user.User) bool
+
+// Validate validates SessionRefreshConfig.
+func (c *SessionRefreshConfig) Validate() error {
+	if c.Window <= 0 {
+		return fmt.Errorf("session refresh window %d is invalid", c.Window)
+	}
+	if c.Lifetime < 0 {
+		return fmt.Errorf("session refresh lifetime %d is invalid", c.Lifetime)
+	}
+	if c.MaxSessionAge <= 0 {
+		return fmt.Errorf("session refresh max age %d is invalid", c.MaxSessionAge)
+	}
+	if c.Lifetime > 0 && c.Lifetime <= c.Window {
+		return fmt.Errorf("session refresh lifetime %d must exceed window %d", c.Lifetime, c.Window)
+	}
+	return nil
+}
+
+// revocationHooks are the hooks of an instance. The hooks could be added
+// while the instance handles the requests.
+type revocationHooks struct {
+	mu    sync.RWMutex
+	hooks []RevocationHook
+}
+
+// AddRevocationHook adds the hook checking whether a token is revoked. The
+// hooks are the only way to revoke the tokens, i.e. there is no Caddyfile
+// or JSON directive for the revocation. The hooks of the primary instance
+// apply to the instances of its context without hooks, including the ones
+// added after the registration of the instances.
+func (m *Authorizer) AddRevocationHook(hook RevocationHook) {
+	if m.revocationHooks == nil {
+		m.revocationHooks = &revocationHooks{}
+	}
+	m.revocationHooks.add(hook)
+}
+
+func (m *Authorizer) isRevoked(usr *user.User) bool {
+	hooks := m.revocationHooks.get()
+	if len(hooks) == 0 {
+		hooks = m.primaryRevocationHooks.get()
+	}
+	for _, hook := range hooks {
+		if hook(usr) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *revocationHooks) add(hook RevocationHook) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.hooks = append(h.hooks, hook)
+}
+
+func (h *revocationHooks) get() []RevocationHook {
+	if h == nil {
+		return nil
+	}
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.hooks
+}
+
+// refreshSession re-signs the token found in a cookie when the token is
+// within the refresh window of its expiry. The refreshed token keeps the
+// claims of the original one, including jti and auth_time, i.e. the time of
+// the authentication. The tokens without auth_time are not refreshed, because
+// the iat claim of a re-issued token says nothing about the age of the
+// session. The expiry of the refreshed token does not exceed the maximum age
+// of the session.
+func (m *Authorizer) refreshSession(w http.ResponseWriter, r *http.Request, usr *user.User) error {
+	if usr.TokenSource != "cookie" {
+		return nil
+	}
+	now := time.Now().Unix()
+	if usr.Claims.ExpiresAt-now > int64(m.SessionRefreshConfig.Window) {
+		return nil
+	}
+	claims := usr.GetTokenClaims()
+	v, ok := claims["auth_time"].(float64)
+	if !ok || v <= 0 {
+		// The age of the session is unknown.
+		return nil
+	}
+	authTime := int64(v)
+	lifetime := int64(m.SessionRefreshConfig.Lifetime)
+	if lifetime == 0 {
+		lifetime = int64(m.keystore.GetTokenLifetime(usr.TokenName, nil))
+	}
+	expiresAt := now + lifetime
+	if maxExpiresAt := authTime + int64(m.SessionRefreshConfig.MaxSessionAge); expiresAt > maxExpiresAt {
+		expiresAt = maxExpiresAt
+	}
+	if expiresAt <= usr.Claims.ExpiresAt {
+		return nil
+	}
+	claims["auth_time"] = authTime
+	claims["iat"] = now
+	claims["exp"] = expiresAt
+	token, err := m.keystore.SignClaims(usr.TokenName, nil, claims)
+	if err != nil {
+		return errors.ErrSessionRefreshSign.WithArgs(err)
+	}
+	cookie := m.newCookie(r, usr.TokenName, token, int(expiresAt-now))
+	w.Header().Add("Set-Cookie", cookie.String())
+	return nil
+}
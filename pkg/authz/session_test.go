@@ -0,0 +1,301 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"context"
+	"fmt"
+	jwtlib "github.com/golang-jwt/jwt"
+	"github.com/greenpau/caddy-authorize/internal/tests"
+	"github.com/greenpau/caddy-authorize/internal/testutils"
+	"github.com/greenpau/caddy-authorize/pkg/acl"
+	"github.com/greenpau/caddy-authorize/pkg/kms"
+	"github.com/greenpau/caddy-authorize/pkg/user"
+	"github.com/greenpau/caddy-authorize/pkg/utils"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSessionRefreshConfig(t *testing.T) {
+	var testcases = []struct {
+		name      string
+		config    *SessionRefreshConfig
+		shouldErr bool
+		err       error
+	}{
+		{
+			name:   "valid config",
+			config: &SessionRefreshConfig{Window: 300, MaxSessionAge: 86400},
+		},
+		{
+			name:   "valid config with lifetime",
+			config: &SessionRefreshConfig{Window: 300, Lifetime: 900, MaxSessionAge: 86400},
+		},
+		{
+			name:      "config without window",
+			config:    &SessionRefreshConfig{MaxSessionAge: 86400},
+			shouldErr: true,
+			err:       fmt.Errorf("session refresh window 0 is invalid"),
+		},
+		{
+			name:      "config without max session age",
+			config:    &SessionRefreshConfig{Window: 300},
+			shouldErr: true,
+			err:       fmt.Errorf("session refresh max age 0 is invalid"),
+		},
+		{
+			name:      "config with negative lifetime",
+			config:    &SessionRefreshConfig{Window: 300, Lifetime: -1, MaxSessionAge: 86400},
+			shouldErr: true,
+			err:       fmt.Errorf("session refresh lifetime -1 is invalid"),
+		},
+		{
+			name:      "config with lifetime within window",
+			config:    &SessionRefreshConfig{Window: 300, Lifetime: 300, MaxSessionAge: 86400},
+			shouldErr: true,
+			err:       fmt.Errorf("session refresh lifetime 300 must exceed window 300"),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.Validate()
+			tests.EvalErr(t, err, tc.config, tc.shouldErr, tc.err)
+		})
+	}
+}
+
+func TestAuthenticateSessionRefresh(t *testing.T) {
+	now := time.Now().Unix()
+	newToken := func(jti string, authTime, issuedAt, expiresAt int64) string {
+		claims := jwtlib.MapClaims{
+			"jti":      jti,
+			"iat":      issuedAt,
+			"exp":      expiresAt,
+			"sub":      "jsmith",
+			"roles":    "guest",
+			"metadata": map[string]interface{}{"department": "engineering"},
+		}
+		if authTime > 0 {
+			claims["auth_time"] = authTime
+		}
+		token, err := jwtlib.NewWithClaims(jwtlib.SigningMethodHS512, claims).SignedString([]byte(testutils.GetSharedKey()))
+		if err != nil {
+			t.Fatalf("failed signing token: %v", err)
+		}
+		return token
+	}
+	keys, err := kms.ParseCryptoKeyConfigs("crypto key sign-verify " + testutils.GetSharedKey())
+	if err != nil {
+		t.Fatalf("failed parsing key config: %v", err)
+	}
+	m := &Authorizer{
+		PrimaryInstance:  true,
+		Context:          "default",
+		AuthURLPath:      "/auth",
+		CryptoKeyConfigs: keys,
+		AccessListRules: []*acl.RuleConfiguration{
+			{Conditions: []string{"match roles guest"}, Action: `allow`},
+		},
+		SessionRefreshConfig: &SessionRefreshConfig{
+			Window:        300,
+			Lifetime:      900,
+			MaxSessionAge: 3600,
+		},
+		ValidateBearerHeader: true,
+		logger:               utils.NewLogger(),
+	}
+	m.AddRevocationHook(func(usr *user.User) bool {
+		return usr.Claims.ID == "revoked"
+	})
+	if err := NewInstanceManager().Register(context.Background(), m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var testcases = []struct {
+		name   string
+		token  string
+		header bool
+		// The expected lifetime of the refreshed token, counted from now.
+		want       int64
+		refreshed  bool
+		authorized bool
+	}{
+		{
+			name:       "token outside of refresh window",
+			token:      newToken("a1", now-600, now-600, now+600),
+			authorized: true,
+		},
+		{
+			name:       "token within refresh window",
+			token:      newToken("a2", now-600, now-600, now+120),
+			want:       900,
+			refreshed:  true,
+			authorized: true,
+		},
+		{
+			name:       "token within refresh window near max session age",
+			token:      newToken("a3", now-3500, now-600, now+60),
+			want:       100,
+			refreshed:  true,
+			authorized: true,
+		},
+		{
+			name:       "token within refresh window at max session age",
+			token:      newToken("a4", now-3600, now-600, now+60),
+			authorized: true,
+		},
+		{
+			name:       "token within refresh window with recent iat past max session age",
+			token:      newToken("a6", now-4000, now-60, now+60),
+			authorized: true,
+		},
+		{
+			name:       "token within refresh window without auth_time",
+			token:      newToken("a7", 0, now-600, now+120),
+			authorized: true,
+		},
+		{
+			name:       "token within refresh window in authorization header",
+			token:      newToken("a5", now-600, now-600, now+120),
+			header:     true,
+			authorized: true,
+		},
+		{
+			name:  "revoked token within refresh window",
+			token: newToken("revoked", now-600, now-600, now+120),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/dashboard", nil)
+			if tc.header {
+				r.Header.Set("Authorization", "Bearer "+tc.token)
+			} else {
+				r.Header.Set("Cookie", "access_token="+tc.token)
+			}
+			w := httptest.NewRecorder()
+			_, ok, _ := m.Authenticate(w, r, nil)
+			tests.EvalObjects(t, "authorized", tc.authorized, ok)
+			if !tc.refreshed {
+				for _, cookie := range w.Result().Cookies() {
+					if cookie.Value != "delete" {
+						t.Fatalf("unexpected cookie: %v", cookie)
+					}
+				}
+				return
+			}
+			cookies := w.Result().Cookies()
+			if len(cookies) != 1 {
+				t.Fatalf("expected refreshed cookie, got %v", cookies)
+			}
+			cookie := cookies[0]
+			tests.EvalObjects(t, "cookie", "access_token", cookie.Name)
+			if !cookie.HttpOnly || !strings.HasPrefix(cookie.Path, "/") {
+				t.Fatalf("unexpected cookie attributes: %v", cookie)
+			}
+			parsedToken, err := jwtlib.Parse(cookie.Value, func(*jwtlib.Token) (interface{}, error) {
+				return []byte(testutils.GetSharedKey()), nil
+			})
+			if err != nil {
+				t.Fatalf("failed parsing refreshed token: %v", err)
+			}
+			claims := parsedToken.Claims.(jwtlib.MapClaims)
+			original, _ := jwtlib.Parse(tc.token, func(*jwtlib.Token) (interface{}, error) {
+				return []byte(testutils.GetSharedKey()), nil
+			})
+			originalClaims := original.Claims.(jwtlib.MapClaims)
+			lifetime := int64(claims["exp"].(float64)) - int64(claims["iat"].(float64))
+			if lifetime < tc.want-2 || lifetime > tc.want {
+				t.Fatalf("unexpected lifetime of refreshed token: %d, want %d", lifetime, tc.want)
+			}
+			tests.EvalObjects(t, "max age", int64(cookie.MaxAge), lifetime)
+			tests.EvalObjects(t, "auth_time", originalClaims["auth_time"], claims["auth_time"])
+			for _, k := range []string{"iat", "exp", "auth_time"} {
+				delete(claims, k)
+				delete(originalClaims, k)
+			}
+			tests.EvalObjects(t, "claims", map[string]interface{}(originalClaims), map[string]interface{}(claims))
+		})
+	}
+}
+
+func TestRevocationHooks(t *testing.T) {
+	newToken := func(jti string) string {
+		token, err := jwtlib.NewWithClaims(jwtlib.SigningMethodHS512, jwtlib.MapClaims{
+			"jti":   jti,
+			"exp":   time.Now().Add(10 * time.Minute).Unix(),
+			"sub":   "jsmith",
+			"roles": "guest",
+		}).SignedString([]byte(testutils.GetSharedKey()))
+		if err != nil {
+			t.Fatalf("failed signing token: %v", err)
+		}
+		return token
+	}
+	keys, err := kms.ParseCryptoKeyConfigs("crypto key verify " + testutils.GetSharedKey())
+	if err != nil {
+		t.Fatalf("failed parsing key config: %v", err)
+	}
+	mgr := NewInstanceManager()
+	primary := &Authorizer{
+		Name:             "primary",
+		PrimaryInstance:  true,
+		Context:          "default",
+		AuthURLPath:      "/auth",
+		CryptoKeyConfigs: keys,
+		AccessListRules: []*acl.RuleConfiguration{
+			{Conditions: []string{"match roles guest"}, Action: `allow`},
+		},
+		logger: utils.NewLogger(),
+	}
+	secondary := &Authorizer{Name: "secondary", Context: "default", logger: utils.NewLogger()}
+	secondaryWithHook := &Authorizer{Name: "secondary with hook", Context: "default", logger: utils.NewLogger()}
+	secondaryWithHook.AddRevocationHook(func(usr *user.User) bool {
+		return usr.Claims.ID == "revoked by secondary"
+	})
+	for _, m := range []*Authorizer{primary, secondary, secondaryWithHook} {
+		if err := mgr.Register(context.Background(), m); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	// The hook is added after the registration of the instances.
+	primary.AddRevocationHook(func(usr *user.User) bool {
+		return usr.Claims.ID == "revoked by primary"
+	})
+
+	var testcases = []struct {
+		name string
+		m    *Authorizer
+		jti  string
+		want bool
+	}{
+		{name: "primary with valid token", m: primary, jti: "a1", want: true},
+		{name: "primary with revoked token", m: primary, jti: "revoked by primary"},
+		{name: "secondary with revoked token", m: secondary, jti: "revoked by primary"},
+		{name: "secondary with token revoked by another secondary", m: secondary, jti: "revoked by secondary", want: true},
+		{name: "secondary with own hook and token revoked by primary", m: secondaryWithHook, jti: "revoked by primary", want: true},
+		{name: "secondary with own hook and revoked token", m: secondaryWithHook, jti: "revoked by secondary"},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/dashboard", nil)
+			r.Header.Set("Cookie", "access_token="+newToken(tc.jti))
+			_, ok, _ := tc.m.Authenticate(httptest.NewRecorder(), r, nil)
+			tests.EvalObjects(t, "authorized", tc.want, ok)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/greenpau/caddy-authorize/pkg/acl"
+	"github.com/greenpau/caddy-authorize/pkg/user"
+	"go.uber.org/zap"
+	"net/http"
+	"strings"
+)
+
+// AccessListTraceConfig contains the configuration of the access list
+// decision traces. The traces are logged at debug level. When the header
+// is set, the trace is added to the response in the header, provided the
+// user has one of the roles.
+type AccessListTraceConfig struct {
+	Header string   `json:"header,omitempty" xml:"header,omitempty" yaml:"header,omitempty"`
+	Roles  []string `json:"roles,omitempty" xml:"roles,omitempty" yaml:"roles,omitempty"`
+}
+
+// Validate validates AccessListTraceConfig.
+func (c *AccessListTraceConfig) Validate() error {
+	c.Header = strings.TrimSpace(c.Header)
+	if c.Header == "" && len(c.Roles) > 0 {
+		return fmt.Errorf("undefined trace header name")
+	}
+	if c.Header != "" && len(c.Roles) == 0 {
+		return fmt.Errorf("trace header %q has no roles", c.Header)
+	}
+	return nil
+}
+
+// handleTrace logs the access list decision trace and, when the user has
+// one of the configured roles, adds it to the response header.
+func (m *Authorizer) handleTrace(w http.ResponseWriter, usr *user.User, trace *acl.DecisionTrace) {
+	if trace.Reason == "" {
+		// The access list was not evaluated, e.g. the token is invalid.
+		return
+	}
+	m.logger.Debug(
+		"access list decision trace",
+		zap.String("instance_name", m.Name),
+		zap.Any("trace", trace),
+	)
+	if !m.isTraceRequester(usr) {
+		return
+	}
+	b, err := json.Marshal(trace)
+	if err != nil {
+		m.logger.Error("failed encoding access list decision trace", zap.Error(err))
+		return
+	}
+	w.Header().Set(m.AccessListTrace.Header, string(b))
+}
+
+// isTraceRequester returns true when the decision trace is added to the
+// response header for the user, i.e. the user has one of the trace roles.
+func (m *Authorizer) isTraceRequester(usr *user.User) bool {
+	if m.AccessListTrace == nil || m.AccessListTrace.Header == "" || usr == nil || usr.Claims == nil {
+		return false
+	}
+	for _, role := range usr.Claims.Roles {
+		for _, traceRole := range m.AccessListTrace.Roles {
+			if role == traceRole {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,170 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/greenpau/caddy-authorize/internal/tests"
+	"github.com/greenpau/caddy-authorize/internal/testutils"
+	"github.com/greenpau/caddy-authorize/pkg/acl"
+	"github.com/greenpau/caddy-authorize/pkg/kms"
+	"github.com/greenpau/caddy-authorize/pkg/user"
+	"github.com/greenpau/caddy-authorize/pkg/utils"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAccessListTrace(t *testing.T) {
+	var testcases = []struct {
+		name      string
+		config    *AccessListTraceConfig
+		roles     []string
+		trace     *acl.DecisionTrace
+		want      map[string]interface{}
+		shouldErr bool
+		err       error
+	}{
+		{
+			name:   "add trace header for admin",
+			config: &AccessListTraceConfig{Header: "X-Authorize-Trace", Roles: []string{"admin"}},
+			roles:  []string{"viewer", "admin"},
+			trace:  &acl.DecisionTrace{Reason: acl.DecisionReasonNoAllow},
+			want: map[string]interface{}{
+				"header": `{"allow":false,"reason":"no_allow","rules":null}`,
+			},
+		},
+		{
+			name:   "skip trace header for non-admin",
+			config: &AccessListTraceConfig{Header: "X-Authorize-Trace", Roles: []string{"admin"}},
+			roles:  []string{"viewer"},
+			trace:  &acl.DecisionTrace{Reason: acl.DecisionReasonNoAllow},
+			want: map[string]interface{}{
+				"header": "",
+			},
+		},
+		{
+			name:   "skip trace header when access list is not evaluated",
+			config: &AccessListTraceConfig{Header: "X-Authorize-Trace", Roles: []string{"admin"}},
+			roles:  []string{"admin"},
+			trace:  &acl.DecisionTrace{},
+			want: map[string]interface{}{
+				"header": "",
+			},
+		},
+		{
+			name:   "log trace without header",
+			config: &AccessListTraceConfig{},
+			roles:  []string{"admin"},
+			trace:  &acl.DecisionTrace{Reason: acl.DecisionReasonNoAllow},
+			want: map[string]interface{}{
+				"header": "",
+			},
+		},
+		{
+			name:      "trace header without roles",
+			config:    &AccessListTraceConfig{Header: "X-Authorize-Trace"},
+			shouldErr: true,
+			err:       fmt.Errorf(`trace header "X-Authorize-Trace" has no roles`),
+		},
+		{
+			name:      "trace roles without header",
+			config:    &AccessListTraceConfig{Roles: []string{"admin"}},
+			shouldErr: true,
+			err:       fmt.Errorf("undefined trace header name"),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.Validate()
+			if tests.EvalErr(t, err, tc.config, tc.shouldErr, tc.err) {
+				return
+			}
+			m := &Authorizer{AccessListTrace: tc.config, logger: utils.NewLogger()}
+			w := httptest.NewRecorder()
+			usr := &user.User{Claims: &user.Claims{Roles: tc.roles}}
+			m.handleTrace(w, usr, tc.trace)
+			got := map[string]interface{}{
+				"header": w.Header().Get("X-Authorize-Trace"),
+			}
+			tests.EvalObjects(t, "output", tc.want, got)
+		})
+	}
+}
+
+func TestAuthenticateTraceCachedUser(t *testing.T) {
+	var testcases = []struct {
+		name   string
+		roles  string
+		header bool
+	}{
+		{name: "trace header for admin", roles: "admin", header: true},
+		{name: "no trace header for viewer", roles: "viewer"},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			usr, err := user.NewUser(map[string]interface{}{
+				"exp":   float64(time.Now().Add(10 * time.Minute).Unix()),
+				"sub":   "smithj@outlook.com",
+				"roles": tc.roles,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := testutils.NewTestCryptoKeyStore().SignToken("access_token", "HS512", usr); err != nil {
+				t.Fatalf("failed signing token: %v", err)
+			}
+			keys, err := kms.ParseCryptoKeyConfigs("crypto key verify " + testutils.GetSharedKey())
+			if err != nil {
+				t.Fatalf("failed parsing key config: %v", err)
+			}
+			m := &Authorizer{
+				PrimaryInstance:  true,
+				Context:          "default",
+				AuthURLPath:      "/auth",
+				CryptoKeyConfigs: keys,
+				AccessListRules: []*acl.RuleConfiguration{
+					{Conditions: []string{"match roles admin viewer"}, Action: `allow tag staff`},
+				},
+				AccessListTrace: &AccessListTraceConfig{Header: "X-Authorize-Trace", Roles: []string{"admin"}},
+				logger:          utils.NewLogger(),
+			}
+			if err := NewInstanceManager().Register(context.Background(), m); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			// The second request is authorized with the cached user.
+			for i := 0; i < 2; i++ {
+				r := httptest.NewRequest("GET", "/dashboard", nil)
+				r.Header.Set("Cookie", "access_token="+usr.Token)
+				w := httptest.NewRecorder()
+				if _, ok, err := m.Authenticate(w, r, nil); !ok {
+					t.Fatalf("unexpected authentication failure: %v", err)
+				}
+				got := w.Header().Get("X-Authorize-Trace")
+				if !tc.header {
+					tests.EvalObjects(t, "trace header", "", got)
+					continue
+				}
+				trace := &acl.DecisionTrace{}
+				if err := json.Unmarshal([]byte(got), trace); err != nil {
+					t.Fatalf("request %d: failed decoding trace header %q: %v", i+1, got, err)
+				}
+				tests.EvalObjects(t, "trace reason", acl.DecisionReasonExplicitAllow, trace.Reason)
+			}
+		})
+	}
+}
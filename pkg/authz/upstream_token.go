@@ -0,0 +1,148 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"fmt"
+	"github.com/greenpau/caddy-authorize/pkg/errors"
+	"github.com/greenpau/caddy-authorize/pkg/grantor"
+	"github.com/greenpau/caddy-authorize/pkg/kms"
+	"github.com/greenpau/caddy-authorize/pkg/user"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const defaultUpstreamTokenLifetime = 60
+
+var (
+	defaultUpstreamTokenClaims = []string{"sub", "name", "email", "roles"}
+	// The claims set by the grantor.
+	reservedUpstreamTokenClaims = map[string]bool{
+		"iss": true,
+		"aud": true,
+		"exp": true,
+		"iat": true,
+		"nbf": true,
+		"act": true,
+	}
+)
+
+// UpstreamTokenConfig is the configuration of the token issued to the
+// upstream services in exchange for the validated token, see RFC 8693. The
+// issued token replaces the Authorization header of the request.
+type UpstreamTokenConfig struct {
+	// The configs of the keys signing the token. The keys are separate from
+	// the keys verifying the tokens.
+	CryptoKeyConfigs []*kms.CryptoKeyConfig `json:"crypto_key_configs,omitempty" xml:"crypto_key_configs,omitempty" yaml:"crypto_key_configs,omitempty"`
+	// The audience of the token, i.e. the upstream services.
+	Audience []string `json:"audience,omitempty" xml:"audience,omitempty" yaml:"audience,omitempty"`
+	// The issuer of the token. It is also the acting party of the act claim.
+	Issuer string `json:"issuer,omitempty" xml:"issuer,omitempty" yaml:"issuer,omitempty"`
+	// The claims copied from the validated token, by their field names or
+	// their dotted paths. Defaults to sub, name, email, and roles.
+	Claims []string `json:"claims,omitempty" xml:"claims,omitempty" yaml:"claims,omitempty"`
+	// The lifetime of the token in seconds. Defaults to 60.
+	Lifetime int `json:"lifetime,omitempty" xml:"lifetime,omitempty" yaml:"lifetime,omitempty"`
+	// The signing method, e.g. HS256. Defaults to the preferred method of
+	// the key.
+	SignMethod string `json:"sign_method,omitempty" xml:"sign_method,omitempty" yaml:"sign_method,omitempty"`
+}
+
+// Validate validates UpstreamTokenConfig.
+func (c *UpstreamTokenConfig) Validate() error {
+	if len(c.CryptoKeyConfigs) == 0 {
+		return fmt.Errorf("upstream token has no crypto key configs")
+	}
+	if len(c.Audience) == 0 {
+		return fmt.Errorf("upstream token has no audience")
+	}
+	c.Issuer = strings.TrimSpace(c.Issuer)
+	if c.Issuer == "" {
+		return fmt.Errorf("upstream token has no issuer")
+	}
+	if c.Lifetime < 0 {
+		return fmt.Errorf("upstream token lifetime %d is invalid", c.Lifetime)
+	}
+	if c.Lifetime == 0 {
+		c.Lifetime = defaultUpstreamTokenLifetime
+	}
+	if len(c.Claims) == 0 {
+		c.Claims = defaultUpstreamTokenClaims
+	}
+	for _, k := range c.Claims {
+		if reservedUpstreamTokenClaims[k] {
+			return fmt.Errorf("upstream token claim %q is reserved", k)
+		}
+	}
+	return nil
+}
+
+// newTokenGrantor returns the grantor with the signing keys of the config.
+func (c *UpstreamTokenConfig) newTokenGrantor() (*grantor.TokenGrantor, error) {
+	g := grantor.NewTokenGrantor()
+	if err := g.AddKeysWithConfigs(c.CryptoKeyConfigs); err != nil {
+		return nil, err
+	}
+	if err := g.Validate(); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// getClaims returns the claims of the upstream token. The subject of the
+// act claim is the issuer. When the validated token has the act claim, it
+// is nested in the act claim, i.e. the prior actors are preserved.
+func (c *UpstreamTokenConfig) getClaims(usr *user.User) map[string]interface{} {
+	claims := make(map[string]interface{})
+	for _, k := range c.Claims {
+		if v := usr.GetClaimByField(k); v != nil {
+			claims[k] = v
+		}
+	}
+	now := time.Now()
+	claims["iss"] = c.Issuer
+	if len(c.Audience) == 1 {
+		claims["aud"] = c.Audience[0]
+	} else {
+		claims["aud"] = c.Audience
+	}
+	claims["iat"] = now.Unix()
+	claims["exp"] = now.Add(time.Duration(c.Lifetime) * time.Second).Unix()
+	act := map[string]interface{}{"sub": c.Issuer}
+	if v := usr.GetClaimByPath("act"); v != nil {
+		act["act"] = v
+	}
+	claims["act"] = act
+	return claims
+}
+
+// grantUpstreamToken replaces the Authorization header of the request with
+// the token issued to the upstream services. The validated token is removed
+// from the cookie or the query parameter it came from, regardless of the
+// strip token setting, i.e. the upstream services see the issued token only.
+func (m *Authorizer) grantUpstreamToken(r *http.Request, usr *user.User) error {
+	var signMethod interface{}
+	if m.UpstreamTokenConfig.SignMethod != "" {
+		signMethod = m.UpstreamTokenConfig.SignMethod
+	}
+	token, err := m.grantor.GrantToken(signMethod, m.UpstreamTokenConfig.getClaims(usr))
+	if err != nil {
+		return errors.ErrUpstreamTokenGrant.WithArgs(err)
+	}
+	removeAuthToken(r, usr)
+	r.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
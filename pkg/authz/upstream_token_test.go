@@ -0,0 +1,282 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"context"
+	"fmt"
+	jwtlib "github.com/golang-jwt/jwt"
+	"github.com/greenpau/caddy-authorize/internal/tests"
+	"github.com/greenpau/caddy-authorize/internal/testutils"
+	"github.com/greenpau/caddy-authorize/pkg/acl"
+	"github.com/greenpau/caddy-authorize/pkg/kms"
+	"github.com/greenpau/caddy-authorize/pkg/utils"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUpstreamTokenConfig(t *testing.T) {
+	keys, err := kms.ParseCryptoKeyConfigs("crypto key sign upstream-secret")
+	if err != nil {
+		t.Fatalf("failed parsing key config: %v", err)
+	}
+	var testcases = []struct {
+		name      string
+		config    *UpstreamTokenConfig
+		want      map[string]interface{}
+		shouldErr bool
+		err       error
+	}{
+		{
+			name: "config with defaults",
+			config: &UpstreamTokenConfig{
+				CryptoKeyConfigs: keys,
+				Audience:         []string{"https://api.local"},
+				Issuer:           "https://gateway.local",
+			},
+			want: map[string]interface{}{
+				"claims":   []string{"sub", "name", "email", "roles"},
+				"lifetime": 60,
+			},
+		},
+		{
+			name:      "config without keys",
+			config:    &UpstreamTokenConfig{Audience: []string{"https://api.local"}, Issuer: "https://gateway.local"},
+			shouldErr: true,
+			err:       fmt.Errorf("upstream token has no crypto key configs"),
+		},
+		{
+			name:      "config without audience",
+			config:    &UpstreamTokenConfig{CryptoKeyConfigs: keys, Issuer: "https://gateway.local"},
+			shouldErr: true,
+			err:       fmt.Errorf("upstream token has no audience"),
+		},
+		{
+			name:      "config without issuer",
+			config:    &UpstreamTokenConfig{CryptoKeyConfigs: keys, Audience: []string{"https://api.local"}},
+			shouldErr: true,
+			err:       fmt.Errorf("upstream token has no issuer"),
+		},
+		{
+			name: "config with negative lifetime",
+			config: &UpstreamTokenConfig{
+				CryptoKeyConfigs: keys,
+				Audience:         []string{"https://api.local"},
+				Issuer:           "https://gateway.local",
+				Lifetime:         -1,
+			},
+			shouldErr: true,
+			err:       fmt.Errorf("upstream token lifetime -1 is invalid"),
+		},
+		{
+			name: "config with reserved claim",
+			config: &UpstreamTokenConfig{
+				CryptoKeyConfigs: keys,
+				Audience:         []string{"https://api.local"},
+				Issuer:           "https://gateway.local",
+				Claims:           []string{"sub", "act"},
+			},
+			shouldErr: true,
+			err:       fmt.Errorf(`upstream token claim "act" is reserved`),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.Validate()
+			if tests.EvalErr(t, err, tc.config, tc.shouldErr, tc.err) {
+				return
+			}
+			got := map[string]interface{}{
+				"claims":   tc.config.Claims,
+				"lifetime": tc.config.Lifetime,
+			}
+			tests.EvalObjects(t, "config", tc.want, got)
+		})
+	}
+}
+
+func TestAuthenticateUpstreamToken(t *testing.T) {
+	// The act claim of the validated token is not a part of user claims.
+	userToken, err := jwtlib.NewWithClaims(jwtlib.SigningMethodHS512, jwtlib.MapClaims{
+		"exp":   time.Now().Add(10 * time.Minute).Unix(),
+		"iss":   "https://idp.local",
+		"sub":   "jsmith",
+		"email": "jsmith@contoso.com",
+		"roles": "guest",
+		"act":   map[string]interface{}{"sub": "https://portal.local"},
+	}).SignedString([]byte(testutils.GetSharedKey()))
+	if err != nil {
+		t.Fatalf("failed signing token: %v", err)
+	}
+	keys, err := kms.ParseCryptoKeyConfigs("crypto key verify " + testutils.GetSharedKey())
+	if err != nil {
+		t.Fatalf("failed parsing key config: %v", err)
+	}
+	upstreamKeys, err := kms.ParseCryptoKeyConfigs("crypto key sign upstream-secret")
+	if err != nil {
+		t.Fatalf("failed parsing key config: %v", err)
+	}
+	m := &Authorizer{
+		PrimaryInstance:  true,
+		Context:          "default",
+		AuthURLPath:      "/auth",
+		CryptoKeyConfigs: keys,
+		AccessListRules: []*acl.RuleConfiguration{
+			{Conditions: []string{"match roles guest"}, Action: `allow`},
+		},
+		UpstreamTokenConfig: &UpstreamTokenConfig{
+			CryptoKeyConfigs: upstreamKeys,
+			Audience:         []string{"https://api.local"},
+			Issuer:           "https://gateway.local",
+			Claims:           []string{"sub", "email", "roles", "name"},
+			SignMethod:       "HS256",
+		},
+		ValidateBearerHeader: true,
+		logger:               utils.NewLogger(),
+	}
+	if err := NewInstanceManager().Register(context.Background(), m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	r := httptest.NewRequest("GET", "/api/orders", nil)
+	r.Header.Set("Authorization", "Bearer "+userToken)
+	if _, ok, err := m.Authenticate(httptest.NewRecorder(), r, nil); !ok {
+		t.Fatalf("unexpected authentication failure: %v", err)
+	}
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == userToken {
+		t.Fatalf("the authorization header was not replaced")
+	}
+	parsedToken, err := jwtlib.Parse(token, func(*jwtlib.Token) (interface{}, error) {
+		return []byte("upstream-secret"), nil
+	})
+	if err != nil {
+		t.Fatalf("failed parsing upstream token: %v", err)
+	}
+	tests.EvalObjects(t, "method", "HS256", parsedToken.Method.Alg())
+	claims := parsedToken.Claims.(jwtlib.MapClaims)
+	tests.EvalObjects(t, "lifetime", float64(60), claims["exp"].(float64)-claims["iat"].(float64))
+	delete(claims, "exp")
+	delete(claims, "iat")
+	want := map[string]interface{}{
+		"iss":   "https://gateway.local",
+		"aud":   "https://api.local",
+		"sub":   "jsmith",
+		"email": "jsmith@contoso.com",
+		"roles": []interface{}{"guest"},
+		"act": map[string]interface{}{
+			"sub": "https://gateway.local",
+			"act": map[string]interface{}{"sub": "https://portal.local"},
+		},
+	}
+	tests.EvalObjects(t, "claims", want, map[string]interface{}(claims))
+}
+
+func TestAuthenticateUpstreamTokenRemovesSourceToken(t *testing.T) {
+	userToken, err := jwtlib.NewWithClaims(jwtlib.SigningMethodHS512, jwtlib.MapClaims{
+		"exp":   time.Now().Add(10 * time.Minute).Unix(),
+		"iss":   "https://idp.local",
+		"sub":   "jsmith",
+		"email": "jsmith@contoso.com",
+		"roles": "guest",
+	}).SignedString([]byte(testutils.GetSharedKey()))
+	if err != nil {
+		t.Fatalf("failed signing token: %v", err)
+	}
+	keys, err := kms.ParseCryptoKeyConfigs("crypto key verify " + testutils.GetSharedKey())
+	if err != nil {
+		t.Fatalf("failed parsing key config: %v", err)
+	}
+	upstreamKeys, err := kms.ParseCryptoKeyConfigs("crypto key sign upstream-secret")
+	if err != nil {
+		t.Fatalf("failed parsing key config: %v", err)
+	}
+	// The strip token setting is disabled.
+	m := &Authorizer{
+		PrimaryInstance:  true,
+		Context:          "default",
+		AuthURLPath:      "/auth",
+		CryptoKeyConfigs: keys,
+		AccessListRules: []*acl.RuleConfiguration{
+			{Conditions: []string{"match roles guest"}, Action: `allow`},
+		},
+		UpstreamTokenConfig: &UpstreamTokenConfig{
+			CryptoKeyConfigs: upstreamKeys,
+			Audience:         []string{"https://api.local"},
+			Issuer:           "https://gateway.local",
+			SignMethod:       "HS256",
+		},
+		logger: utils.NewLogger(),
+	}
+	if err := NewInstanceManager().Register(context.Background(), m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var testcases = []struct {
+		name    string
+		request func() *http.Request
+		want    map[string]interface{}
+	}{
+		{
+			name: "token in cookie",
+			request: func() *http.Request {
+				r := httptest.NewRequest("GET", "/api/orders", nil)
+				r.AddCookie(&http.Cookie{Name: "theme", Value: "dark"})
+				r.AddCookie(&http.Cookie{Name: "access_token", Value: userToken})
+				return r
+			},
+			want: map[string]interface{}{
+				"cookie": "theme=dark",
+				"query":  "",
+			},
+		},
+		{
+			name: "token in query parameter",
+			request: func() *http.Request {
+				return httptest.NewRequest("GET", "/api/orders?page=2&access_token="+userToken, nil)
+			},
+			want: map[string]interface{}{
+				"cookie": "",
+				"query":  "page=2",
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.request()
+			if _, ok, err := m.Authenticate(httptest.NewRecorder(), r, nil); !ok {
+				t.Fatalf("unexpected authentication failure: %v", err)
+			}
+			got := map[string]interface{}{
+				"cookie": r.Header.Get("Cookie"),
+				"query":  r.URL.RawQuery,
+			}
+			tests.EvalObjects(t, "request", tc.want, got)
+			authHeader := r.Header.Get("Authorization")
+			if strings.Contains(authHeader, userToken) {
+				t.Fatalf("the authorization header has the validated token")
+			}
+			token := strings.TrimPrefix(authHeader, "Bearer ")
+			if _, err := jwtlib.Parse(token, func(*jwtlib.Token) (interface{}, error) {
+				return []byte("upstream-secret"), nil
+			}); err != nil {
+				t.Fatalf("failed parsing upstream token: %v", err)
+			}
+		})
+	}
+}
@@ -19,4 +19,8 @@ const (
 	ErrAccessListRuleConfig          StandardError = "acl rule configuration error: %v: %v"
 	ErrAccessListRuleConditionConfig StandardError = "acl rule condition configuration error: %v: %v"
 	ErrAccessListNoRules             StandardError = "acl has no rules"
+	ErrAccessListFileRead            StandardError = "acl file %q read error: %v"
+	ErrAccessListFileParse           StandardError = "acl file %q parse error: %v"
+	ErrAccessListFileUnsupported     StandardError = "acl file %q has unsupported extension, expected .json, .yaml, or .yml"
+	ErrAccessListFileRules           StandardError = "acl file %q rule error: %v"
 )
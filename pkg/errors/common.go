@@ -134,4 +134,39 @@ const (
 
 	// InstanceManager errors.
 	ErrInstanceManagerValidate StandardError = "instance %q validation failed: %v"
+
+	// Offline evaluation errors.
+	ErrEvalNotProvisioned   StandardError = "instance %q is not provisioned"
+	ErrEvalNoCredentials    StandardError = "evaluation requires either token or claims"
+	ErrEvalMixedCredentials StandardError = "evaluation accepts either token or claims, not both"
+	ErrEvalRequest          StandardError = "evaluation request is invalid: %v"
+	ErrEvalClaims           StandardError = "evaluation claims are invalid: %v"
+
+	// Policy test errors.
+	ErrPolicyTestNoTrace StandardError = "instance %q has no access list trace configured"
+	ErrPolicyTestParse   StandardError = "policy test cases are invalid: %v"
+
+	// Named policy errors.
+	ErrPolicyNotFound     StandardError = "authorization policy %q not found"
+	ErrPolicyNil          StandardError = "authorization policy %q is nil"
+	ErrPolicyMixedConfig  StandardError = "authorize with %q must not have its own configuration"
+	ErrPolicyScopedConfig StandardError = "authorization policy %q must not have primary or context directives"
+
+	// Page template errors.
+	ErrPageTemplateUnsupported StandardError = "page %q is unsupported"
+	ErrPageTemplateLoad        StandardError = "failed loading %q page template %q: %v"
+
+	// Endpoint errors.
+	ErrEndpointNoPrimaryInstance StandardError = "no primary authorization instance found in %q context"
+	ErrEndpointMixedConfig       StandardError = "endpoint must reference either policy %q or context %q"
+	ErrLogoutMethod              StandardError = "logout method %s is not allowed"
+	ErrLogoutOrigin              StandardError = "logout request origin %q is not %q"
+
+	// Upstream token errors.
+	ErrUpstreamTokenGrant StandardError = "failed granting upstream token: %v"
+
+	// Session errors.
+	ErrTokenRevoked         StandardError = "token is revoked"
+	ErrSessionRefreshSign   StandardError = "failed signing refreshed token: %v"
+	ErrSessionRefreshNoKeys StandardError = "session refresh requires signing keys: %v"
 )
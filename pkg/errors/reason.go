@@ -0,0 +1,88 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errors
+
+import (
+	"errors"
+)
+
+// ReasonCode is the reason of the denial of a request.
+type ReasonCode string
+
+// Reason Codes
+const (
+	ReasonUnknown         ReasonCode = "unknown"
+	ReasonNoToken         ReasonCode = "no_token"
+	ReasonMalformed       ReasonCode = "malformed"
+	ReasonBadSignature    ReasonCode = "bad_signature"
+	ReasonExpired         ReasonCode = "expired"
+	ReasonNotYetValid     ReasonCode = "not_yet_valid"
+	ReasonIssuerMismatch  ReasonCode = "issuer_mismatch"
+	ReasonACLDenied       ReasonCode = "acl_denied"
+	ReasonAddressMismatch ReasonCode = "address_mismatch"
+	ReasonPathClaimDenied ReasonCode = "path_claim_denied"
+	ReasonRevoked         ReasonCode = "revoked"
+)
+
+// ReasonError is an error with the reason code.
+type ReasonError struct {
+	Reason ReasonCode
+	err    error
+}
+
+// Error returns the message of the underlying error.
+func (e *ReasonError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *ReasonError) Unwrap() error {
+	return e.err
+}
+
+// WithReason returns the error with the reason code.
+func WithReason(reason ReasonCode, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &ReasonError{Reason: reason, err: err}
+}
+
+// GetReasonCode returns the reason code of the error. The reason code is
+// either carried by the error, or derived from the well-known errors.
+func GetReasonCode(err error) ReasonCode {
+	if err == nil {
+		return ""
+	}
+	var reasonErr *ReasonError
+	if errors.As(err, &reasonErr) {
+		return reasonErr.Reason
+	}
+	switch {
+	case errors.Is(err, ErrNoTokenFound):
+		return ReasonNoToken
+	case errors.Is(err, ErrExpiredToken):
+		return ReasonExpired
+	case errors.Is(err, ErrAccessNotAllowed):
+		return ReasonACLDenied
+	case errors.Is(err, ErrAccessNotAllowedByPathACL):
+		return ReasonPathClaimDenied
+	case errors.Is(err, ErrSourceAddressNotFound), errors.Is(err, ErrSourceAddressMismatch):
+		return ReasonAddressMismatch
+	case errors.Is(err, ErrTokenRevoked):
+		return ReasonRevoked
+	}
+	return ReasonUnknown
+}
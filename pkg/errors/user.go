@@ -23,3 +23,12 @@ const (
 	ErrCheckpointEmpty         StandardError = "failed creating checkpoint: empty input"
 	ErrFrontendLinkInvalidType StandardError = "failed creating frontend link with %T data type: %v"
 )
+
+// Role Hierarchy Errors
+const (
+	ErrRoleHierarchyInvalidRole    StandardError = "role hierarchy entry for role %q implying %v is invalid"
+	ErrRoleHierarchyInvalidAlias   StandardError = "role hierarchy alias %q of role %q is invalid"
+	ErrRoleHierarchyDuplicateAlias StandardError = "role hierarchy alias %q is duplicate"
+	ErrRoleHierarchyAliasConflict  StandardError = "role hierarchy alias %q is also a role implying other roles"
+	ErrRoleHierarchyCycle          StandardError = "role hierarchy has a cycle: %s"
+)
@@ -0,0 +1,70 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grantor
+
+import (
+	"github.com/greenpau/caddy-authorize/pkg/errors"
+	"github.com/greenpau/caddy-authorize/pkg/kms"
+)
+
+// TokenGrantor issues the tokens signed with its own keys, e.g. the tokens
+// issued to upstream services in exchange for the validated tokens. The
+// keys of the grantor are separate from the keys verifying the tokens.
+type TokenGrantor struct {
+	keystore *kms.CryptoKeyStore
+}
+
+// NewTokenGrantor returns an instance of TokenGrantor.
+func NewTokenGrantor() *TokenGrantor {
+	return &TokenGrantor{
+		keystore: kms.NewCryptoKeyStore(),
+	}
+}
+
+// AddKeysWithConfigs adds the signing keys to TokenGrantor by providing
+// their configurations.
+func (g *TokenGrantor) AddKeysWithConfigs(cfgs []*kms.CryptoKeyConfig) error {
+	keys, err := kms.GetKeysFromConfigs(cfgs)
+	if err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if k.Sign == nil || !k.Sign.Token.Capable {
+			return errors.ErrTokenGrantorKeyNoSigningCapability
+		}
+	}
+	return g.keystore.AddKeys(keys)
+}
+
+// Validate checks whether TokenGrantor has the signing keys.
+func (g *TokenGrantor) Validate() error {
+	if err := g.keystore.HasSignKeys(); err != nil {
+		return errors.ErrTokenGrantorNoSigningKeysFound
+	}
+	return nil
+}
+
+// GrantToken signs the claims with the first signing key and returns the
+// signed token. When the signing method is nil, the key uses its default
+// method.
+func (g *TokenGrantor) GrantToken(signMethod interface{}, claims map[string]interface{}) (string, error) {
+	if len(claims) == 0 {
+		return "", errors.ErrTokenGrantorNoClaimsFound
+	}
+	if err := g.Validate(); err != nil {
+		return "", err
+	}
+	return g.keystore.SignClaims(nil, signMethod, claims)
+}
@@ -0,0 +1,97 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grantor
+
+import (
+	"fmt"
+	jwtlib "github.com/golang-jwt/jwt"
+	"github.com/greenpau/caddy-authorize/internal/tests"
+	"github.com/greenpau/caddy-authorize/pkg/errors"
+	"github.com/greenpau/caddy-authorize/pkg/kms"
+	"testing"
+)
+
+func TestGrantToken(t *testing.T) {
+	var testcases = []struct {
+		name      string
+		config    string
+		method    interface{}
+		claims    map[string]interface{}
+		shouldErr bool
+		err       error
+	}{
+		{
+			name:   "grant token with shared key",
+			config: "crypto key sign foobar",
+			claims: map[string]interface{}{"sub": "jsmith", "aud": "https://api.local"},
+		},
+		{
+			name:   "grant token with preferred method",
+			config: "crypto key sign foobar",
+			method: "HS256",
+			claims: map[string]interface{}{"sub": "jsmith"},
+		},
+		{
+			name:      "grant token with public key",
+			config:    "crypto key k9738a405e99 verify from file ./../../testdata/rskeys/test_2_pub.pem",
+			claims:    map[string]interface{}{"sub": "jsmith"},
+			shouldErr: true,
+			err:       errors.ErrTokenGrantorKeyNoSigningCapability,
+		},
+		{
+			name:      "grant token without keys",
+			claims:    map[string]interface{}{"sub": "jsmith"},
+			shouldErr: true,
+			err:       errors.ErrTokenGrantorNoSigningKeysFound,
+		},
+		{
+			name:      "grant token without claims",
+			config:    "crypto key sign foobar",
+			shouldErr: true,
+			err:       errors.ErrTokenGrantorNoClaimsFound,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewTokenGrantor()
+			msgs := []string{fmt.Sprintf("test name: %s", tc.name)}
+			if tc.config != "" {
+				cfgs, err := kms.ParseCryptoKeyConfigs(tc.config)
+				if err != nil {
+					t.Fatalf("failed parsing key config: %v", err)
+				}
+				if err := g.AddKeysWithConfigs(cfgs); err != nil {
+					if tests.EvalErrWithLog(t, err, "grantor", tc.shouldErr, tc.err, msgs) {
+						return
+					}
+				}
+			}
+			token, err := g.GrantToken(tc.method, tc.claims)
+			if tests.EvalErrWithLog(t, err, "grantor", tc.shouldErr, tc.err, msgs) {
+				return
+			}
+			parsedToken, err := jwtlib.Parse(token, func(*jwtlib.Token) (interface{}, error) {
+				return []byte("foobar"), nil
+			})
+			if err != nil {
+				t.Fatalf("failed parsing token: %v", err)
+			}
+			if tc.method != nil {
+				tests.EvalObjectsWithLog(t, "method", tc.method, parsedToken.Method.Alg(), msgs)
+			}
+			tests.EvalObjectsWithLog(t, "claims", tc.claims, map[string]interface{}(parsedToken.Claims.(jwtlib.MapClaims)), msgs)
+		})
+	}
+}
@@ -42,18 +42,28 @@ var jsRedirTmpl = template.Must(template.New("js_redir").Parse(`
 `))
 
 // HandleJSRedirect redirects the requests to configured auth URL by responding an HTML
-// with javascript doing the real redirection.
+// with javascript doing the real redirection. The page is rendered with the
+// template and the page data in the options, when present.
 func HandleJSRedirect(w http.ResponseWriter, r *http.Request, opts map[string]interface{}) {
 	authURLPath, sep, redirectParameter, redirectURL, redirect := redirectParameters(w, r, opts)
 	if !redirect {
 		return
 	}
 
-	w.WriteHeader(403)
-	jsRedirTmpl.Execute(w, map[string]string{
-		"AuthURLPath": authURLPath,
-		"Sep":         sep,
-		"RedirParam":  redirectParameter,
-		"RedirURL":    redirectURL,
-	})
+	tmpl := jsRedirTmpl
+	if v, exists := opts["template"]; exists {
+		tmpl = v.(*template.Template)
+	}
+	data := &PageData{}
+	if v, exists := opts["page_data"]; exists {
+		data = v.(*PageData)
+	}
+	data.AuthURLPath = authURLPath
+	data.Sep = sep
+	data.RedirParam = redirectParameter
+	data.RedirURL = redirectURL
+
+	if err := HandlePage(w, 403, tmpl, data); err != nil && tmpl != jsRedirTmpl {
+		HandlePage(w, 403, jsRedirTmpl, data)
+	}
 }
@@ -0,0 +1,124 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers
+
+import (
+	"bytes"
+	"github.com/greenpau/caddy-authorize/pkg/errors"
+	"html/template"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// The names of the pages.
+const (
+	PageUnauthorized = "unauthorized"
+	PageForbidden    = "forbidden"
+	PageRedirect     = "redirect"
+)
+
+var forbiddenTmpl = template.Must(template.New(PageForbidden).Parse(`
+<html>
+	<body>
+		<p>Forbidden</p>
+	</body>
+</html>
+`))
+
+// The unauthorized page has no built-in template. Without the template,
+// the unauthorized response is left to Caddy.
+var builtinPages = map[string]*template.Template{
+	PageForbidden: forbiddenTmpl,
+	PageRedirect:  jsRedirTmpl,
+}
+
+// PageData is the data available to the page templates.
+type PageData struct {
+	Method string `json:"method,omitempty" xml:"method,omitempty" yaml:"method,omitempty"`
+	URL    string `json:"url,omitempty" xml:"url,omitempty" yaml:"url,omitempty"`
+	Host   string `json:"host,omitempty" xml:"host,omitempty" yaml:"host,omitempty"`
+	Path   string `json:"path,omitempty" xml:"path,omitempty" yaml:"path,omitempty"`
+	// The reason of the denial of the request.
+	Reason errors.ReasonCode `json:"reason,omitempty" xml:"reason,omitempty" yaml:"reason,omitempty"`
+	// The non-sensitive claims of the user, when available.
+	Claims map[string]interface{} `json:"claims,omitempty" xml:"claims,omitempty" yaml:"claims,omitempty"`
+	// The parameters of the redirect page.
+	AuthURLPath string `json:"auth_url_path,omitempty" xml:"auth_url_path,omitempty" yaml:"auth_url_path,omitempty"`
+	Sep         string `json:"sep,omitempty" xml:"sep,omitempty" yaml:"sep,omitempty"`
+	RedirParam  string `json:"redir_param,omitempty" xml:"redir_param,omitempty" yaml:"redir_param,omitempty"`
+	RedirURL    string `json:"redir_url,omitempty" xml:"redir_url,omitempty" yaml:"redir_url,omitempty"`
+}
+
+// NewPageData returns the page data of the request.
+func NewPageData(r *http.Request, reason errors.ReasonCode, claims map[string]interface{}) *PageData {
+	return &PageData{
+		Method: r.Method,
+		URL:    r.URL.String(),
+		Host:   r.Host,
+		Path:   r.URL.Path,
+		Reason: reason,
+		Claims: claims,
+	}
+}
+
+// LoadPageTemplates parses the template files of the pages. The pages
+// without the template files fall back to the built-in templates.
+func LoadPageTemplates(paths map[string]string) (map[string]*template.Template, error) {
+	pages := make(map[string]*template.Template)
+	for name, tmpl := range builtinPages {
+		pages[name] = tmpl
+	}
+	for name, fp := range paths {
+		switch name {
+		case PageUnauthorized, PageForbidden, PageRedirect:
+		default:
+			return nil, errors.ErrPageTemplateUnsupported.WithArgs(name)
+		}
+		tmpl, err := template.New(filepath.Base(fp)).ParseFiles(fp)
+		if err != nil {
+			return nil, errors.ErrPageTemplateLoad.WithArgs(name, fp, err)
+		}
+		pages[name] = tmpl
+	}
+	return pages, nil
+}
+
+// AcceptsHTML returns true when the Accept header of the request asks
+// for HTML.
+func AcceptsHTML(r *http.Request) bool {
+	for _, entry := range strings.Split(r.Header.Get("Accept"), ",") {
+		switch strings.ToLower(strings.TrimSpace(strings.Split(entry, ";")[0])) {
+		case "text/html", "application/xhtml+xml":
+			return true
+		}
+	}
+	return false
+}
+
+// HandlePage responds with the status code and the page rendered with the
+// template. Nothing is written when the rendering fails.
+func HandlePage(w http.ResponseWriter, code int, tmpl *template.Template, data *PageData) error {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+	w.WriteHeader(code)
+	w.Write(buf.Bytes())
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers
+
+import (
+	"fmt"
+	"github.com/greenpau/caddy-authorize/internal/tests"
+	"github.com/greenpau/caddy-authorize/pkg/errors"
+	"io/ioutil"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadPageTemplates(t *testing.T) {
+	dir := t.TempDir()
+	redirectPage := filepath.Join(dir, "redirect.html")
+	if err := ioutil.WriteFile(redirectPage, []byte(`<a href="{{.AuthURLPath}}?{{.RedirParam}}={{.RedirURL}}">{{.Reason}}</a>`), 0600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	invalidPage := filepath.Join(dir, "invalid.html")
+	if err := ioutil.WriteFile(invalidPage, []byte(`{{.Reason`), 0600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var testcases = []struct {
+		name      string
+		paths     map[string]string
+		want      map[string]interface{}
+		body      string
+		shouldErr bool
+		err       error
+	}{
+		{
+			name: "built-in pages",
+			want: map[string]interface{}{
+				"unauthorized": false,
+				"forbidden":    true,
+				"redirect":     true,
+			},
+			body: "window.location = final_url;",
+		},
+		{
+			name:  "custom redirect page",
+			paths: map[string]string{"redirect": redirectPage},
+			want: map[string]interface{}{
+				"unauthorized": false,
+				"forbidden":    true,
+				"redirect":     true,
+			},
+			body: `<a href="/auth?redirect_url=http%3a%2f%2fexample.com%2fapp">expired</a>`,
+		},
+		{
+			name:      "unsupported page",
+			paths:     map[string]string{"foobar": redirectPage},
+			shouldErr: true,
+			err:       errors.ErrPageTemplateUnsupported.WithArgs("foobar"),
+		},
+		{
+			name:      "invalid page template",
+			paths:     map[string]string{"forbidden": invalidPage},
+			shouldErr: true,
+			err: errors.ErrPageTemplateLoad.WithArgs(
+				"forbidden", invalidPage,
+				fmt.Errorf(`template: invalid.html:1: unclosed action`),
+			),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			pages, err := LoadPageTemplates(tc.paths)
+			if tests.EvalErr(t, err, tc.paths, tc.shouldErr, tc.err) {
+				return
+			}
+			got := make(map[string]interface{})
+			for _, name := range []string{PageUnauthorized, PageForbidden, PageRedirect} {
+				_, exists := pages[name]
+				got[name] = exists
+			}
+			r := httptest.NewRequest("GET", "/app", nil)
+			w := httptest.NewRecorder()
+			data := NewPageData(r, errors.ReasonExpired, nil)
+			HandleJSRedirect(w, r, map[string]interface{}{
+				"auth_url_path":                "/auth",
+				"auth_redirect_query_disabled": false,
+				"redirect_param":               "redirect_url",
+				"template":                     pages[PageRedirect],
+				"page_data":                    data,
+			})
+			tests.EvalObjects(t, "pages", tc.want, got)
+			if !strings.Contains(w.Body.String(), tc.body) {
+				t.Fatalf("redirect page has no %q: %s", tc.body, w.Body.String())
+			}
+		})
+	}
+}
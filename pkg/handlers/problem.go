@@ -0,0 +1,77 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers
+
+import (
+	"encoding/json"
+	"github.com/greenpau/caddy-authorize/pkg/errors"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// The error codes of the WWW-Authenticate header, see RFC 6750, Section 3.1.
+const (
+	BearerErrorInvalidToken      = "invalid_token"
+	BearerErrorInsufficientScope = "insufficient_scope"
+)
+
+// IsAPIRequest returns true when the request originates from an API client,
+// as opposed to a browser. The request is an API request when it carries
+// a bearer token in the Authorization header, or when its Accept header
+// asks for JSON and not for HTML.
+func IsAPIRequest(r *http.Request) bool {
+	if v := r.Header.Get("Authorization"); len(v) > 7 && strings.EqualFold(v[:7], "bearer ") {
+		return true
+	}
+	var acceptsJSON bool
+	for _, entry := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType := strings.ToLower(strings.TrimSpace(strings.Split(entry, ";")[0]))
+		switch {
+		case mediaType == "text/html":
+			return false
+		case mediaType == "application/json", strings.HasSuffix(mediaType, "+json"):
+			acceptsJSON = true
+		}
+	}
+	return acceptsJSON
+}
+
+// HandleBearerError responds to API clients with the status code, the
+// RFC 6750 WWW-Authenticate header, and the RFC 7807 problem details
+// extended with the reason code. The error code is empty when the request
+// has no token, see RFC 6750, Section 3.1.
+func HandleBearerError(w http.ResponseWriter, code int, errorCode string, reason errors.ReasonCode, detail string) {
+	challenge := "Bearer"
+	if errorCode != "" {
+		challenge += ` error="` + errorCode + `"`
+		if detail != "" {
+			challenge += `, error_description="` + strings.ReplaceAll(detail, `"`, `'`) + `"`
+		}
+	}
+	w.Header().Set("WWW-Authenticate", challenge)
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Cache-Control", "no-store")
+	body, _ := json.Marshal(map[string]interface{}{
+		"type":   "about:blank",
+		"title":  http.StatusText(code),
+		"status": code,
+		"detail": detail,
+		"reason": reason,
+	})
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.WriteHeader(code)
+	w.Write(body)
+}
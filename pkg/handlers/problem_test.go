@@ -0,0 +1,46 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers
+
+import (
+	"github.com/greenpau/caddy-authorize/internal/tests"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIsAPIRequest(t *testing.T) {
+	var testcases = []struct {
+		name    string
+		headers map[string]string
+		want    bool
+	}{
+		{name: "request without headers"},
+		{name: "browser request", headers: map[string]string{"Accept": "text/html,application/xhtml+xml,*/*;q=0.8"}},
+		{name: "browser request accepting json", headers: map[string]string{"Accept": "application/json, text/html"}},
+		{name: "json request", headers: map[string]string{"Accept": "application/json"}, want: true},
+		{name: "problem json request", headers: map[string]string{"Accept": "application/problem+json; charset=utf-8"}, want: true},
+		{name: "bearer token request", headers: map[string]string{"Authorization": "bearer foobar"}, want: true},
+		{name: "basic auth request", headers: map[string]string{"Authorization": "Basic foobar"}},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/", nil)
+			for k, v := range tc.headers {
+				r.Header.Set(k, v)
+			}
+			tests.EvalObjects(t, "api request", tc.want, IsAPIRequest(r))
+		})
+	}
+}
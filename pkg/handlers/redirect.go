@@ -15,11 +15,13 @@
 package handlers
 
 import (
-	//"go.uber.org/zap"
-
+	"net"
 	"net/http"
 	"net/url"
 	"strings"
+
+	//"go.uber.org/zap"
+	"github.com/greenpau/caddy-authorize/pkg/utils/urlutils"
 )
 
 // HandleHeaderRedirect redirects the requests to configured auth URL by setting Location header and sending 302.
@@ -62,39 +64,24 @@ func redirectParameters(w http.ResponseWriter, r *http.Request, opts map[string]
 		return authURLPath, "", "", "", true
 	}
 	sep = "?"
-	redirectURL = r.RequestURI
-	if strings.HasPrefix(redirectURL, "/") {
-		redirHost := r.Header.Get("X-Forwarded-Host")
-		if redirHost == "" {
-			redirHost = r.Host
-		}
-		redirProto := r.Header.Get("X-Forwarded-Proto")
-		if redirProto == "" {
-			if r.TLS == nil {
-				redirProto = "http"
-			} else {
-				redirProto = "https"
-			}
-		}
-		redirPort := r.Header.Get("X-Forwarded-Port")
-
-		redirectBaseURL := redirProto + "://" + redirHost
-		if redirPort != "" {
-			switch redirPort {
-			case "443":
-				if redirProto != "https" {
-					redirectBaseURL += ":" + redirPort
-				}
-			case "80":
-				if redirProto != "http" {
-					redirectBaseURL += ":" + redirPort
-				}
-			default:
-				redirectBaseURL += ":" + redirPort
-			}
+	var proxies []*net.IPNet
+	if v, exists := opts["trusted_proxies"]; exists {
+		proxies = v.([]*net.IPNet)
+	}
+	requestURI := r.RequestURI
+	if !strings.HasPrefix(requestURI, "/") {
+		// The request target is in absolute form.
+		requestURI = r.URL.RequestURI()
+	}
+	redirectBaseURL := urlutils.GetTrustedBaseURL(r, proxies)
+	if v, exists := opts["redirect_hosts"]; exists {
+		u, err := url.Parse(redirectBaseURL)
+		if err != nil || !urlutils.IsAllowedHost(u.Host, v.([]string)) {
+			// Refuse the redirect back to the host not in the allowlist.
+			return authURLPath, "", "", "", true
 		}
-		redirectURL = redirectBaseURL + r.RequestURI
 	}
+	redirectURL = redirectBaseURL + requestURI
 
 	if strings.Contains(authURLPath, "?") {
 		sep = "&"
@@ -25,7 +25,6 @@ import (
 	"github.com/greenpau/caddy-authorize/pkg/shared"
 	"github.com/greenpau/caddy-authorize/pkg/user"
 	"go.uber.org/zap"
-	"strings"
 )
 
 var (
@@ -247,6 +246,7 @@ func (ks *CryptoKeyStore) AddKey(k *CryptoKey) error {
 // ParseToken parses JWT token and returns User instance.
 func (ks *CryptoKeyStore) ParseToken(tokenName, token string) (*user.User, error) {
 	var issuerURL string
+	reason, precedence := errors.ReasonBadSignature, 0
 	for _, k := range ks.verifyKeys {
 		if _, exists := reservedTokenNames[tokenName]; !exists {
 			if tokenName != k.Verify.Token.Name {
@@ -255,13 +255,19 @@ func (ks *CryptoKeyStore) ParseToken(tokenName, token string) (*user.User, error
 		}
 		parsedToken, err := jwtlib.Parse(token, k.ProvideKey)
 		if err != nil {
-			if strings.Contains(err.Error(), "is expired") {
-				for k, v := range parsedToken.Claims.(jwtlib.MapClaims) {
-					if k == "iss" {
-						issuerURL = v.(string)
+			r, p := getParseTokenReason(err)
+			if r == errors.ReasonExpired && parsedToken != nil {
+				// The issuer of the expired token is trusted only when the
+				// signature of the token is valid.
+				if claims, ok := parsedToken.Claims.(jwtlib.MapClaims); ok {
+					if v, ok := claims["iss"].(string); ok {
+						issuerURL = v
 					}
 				}
 			}
+			if p > precedence {
+				reason, precedence = r, p
+			}
 			continue
 		}
 		userData := make(map[string]interface{})
@@ -270,6 +276,8 @@ func (ks *CryptoKeyStore) ParseToken(tokenName, token string) (*user.User, error
 		}
 		usr, err := user.NewUser(userData)
 		if err != nil {
+			// The token is signed, but its claims are invalid.
+			reason, precedence = errors.ReasonMalformed, 3
 			continue
 		}
 		return usr, nil
@@ -277,9 +285,33 @@ func (ks *CryptoKeyStore) ParseToken(tokenName, token string) (*user.User, error
 	if issuerURL != "" {
 		usr := &user.User{}
 		usr.Authenticator.URL = issuerURL
-		return usr, errors.ErrCryptoKeyStoreParseTokenFailed
+		return usr, errors.WithReason(reason, errors.ErrCryptoKeyStoreParseTokenFailed)
+	}
+	return nil, errors.WithReason(reason, errors.ErrCryptoKeyStoreParseTokenFailed)
+}
+
+// getParseTokenReason returns the reason of the token parsing error and
+// its precedence. When the token fails verification with multiple keys,
+// the reason found with the key having verified the signature of the
+// token takes precedence.
+func getParseTokenReason(err error) (errors.ReasonCode, int) {
+	vErr, ok := err.(*jwtlib.ValidationError)
+	if !ok {
+		return errors.ReasonMalformed, 1
 	}
-	return nil, errors.ErrCryptoKeyStoreParseTokenFailed
+	switch {
+	case vErr.Errors&jwtlib.ValidationErrorMalformed != 0:
+		return errors.ReasonMalformed, 1
+	case vErr.Errors&(jwtlib.ValidationErrorUnverifiable|jwtlib.ValidationErrorSignatureInvalid) != 0:
+		return errors.ReasonBadSignature, 2
+	case vErr.Errors&jwtlib.ValidationErrorExpired != 0:
+		return errors.ReasonExpired, 3
+	case vErr.Errors&(jwtlib.ValidationErrorNotValidYet|jwtlib.ValidationErrorIssuedAt) != 0:
+		return errors.ReasonNotYetValid, 3
+	case vErr.Errors&jwtlib.ValidationErrorIssuer != 0:
+		return errors.ReasonIssuerMismatch, 3
+	}
+	return errors.ReasonMalformed, 1
 }
 
 // SignToken signs user claims and add signed token to user identity.
@@ -301,6 +333,24 @@ func (ks *CryptoKeyStore) SignToken(tokenName, signMethod interface{}, usr *user
 	return errors.ErrCryptoKeyStoreSignTokenFailed
 }
 
+// SignClaims signs the claims, as opposed to the claims of a user, and
+// returns the signed token.
+func (ks *CryptoKeyStore) SignClaims(tokenName, signMethod interface{}, claims map[string]interface{}) (string, error) {
+	for _, k := range ks.signKeys {
+		if tokenName != nil {
+			if tokenName.(string) != k.Sign.Token.Name {
+				continue
+			}
+		}
+		response, err := k.sign(signMethod, jwtlib.MapClaims(claims))
+		if err != nil {
+			return "", err
+		}
+		return response.(string), nil
+	}
+	return "", errors.ErrCryptoKeyStoreSignTokenFailed
+}
+
 // GetTokenLifetime returns lifetime for a signed token.
 func (ks *CryptoKeyStore) GetTokenLifetime(tokenName, signMethod interface{}) int {
 	for _, k := range ks.signKeys {
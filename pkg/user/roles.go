@@ -0,0 +1,216 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/greenpau/caddy-authorize/pkg/errors"
+)
+
+// RoleHierarchy holds the roles implied by other roles, e.g. admin implies
+// editor, and the aliases of the roles, e.g. administrator is an alias of
+// admin. The roles of a user are expanded with the implied roles prior
+// to the evaluation of the access list.
+type RoleHierarchy struct {
+	Implies map[string][]string `json:"implies,omitempty" xml:"implies,omitempty" yaml:"implies,omitempty"`
+	Aliases map[string]string   `json:"aliases,omitempty" xml:"aliases,omitempty" yaml:"aliases,omitempty"`
+	// Holds the map of the roles to the roles they imply, directly or
+	// transitively.
+	expanded map[string][]string
+}
+
+// NewRoleHierarchy returns an instance of RoleHierarchy.
+func NewRoleHierarchy() *RoleHierarchy {
+	return &RoleHierarchy{
+		Implies: make(map[string][]string),
+		Aliases: make(map[string]string),
+	}
+}
+
+// AddRole adds the roles implied by a role.
+func (h *RoleHierarchy) AddRole(role string, implied ...string) error {
+	if role == "" || len(implied) == 0 {
+		return errors.ErrRoleHierarchyInvalidRole.WithArgs(role, implied)
+	}
+	if h.Implies == nil {
+		h.Implies = make(map[string][]string)
+	}
+	for _, s := range implied {
+		if s == "" {
+			return errors.ErrRoleHierarchyInvalidRole.WithArgs(role, implied)
+		}
+		h.Implies[role] = append(h.Implies[role], s)
+	}
+	h.expanded = nil
+	return nil
+}
+
+// AddAlias adds an alias of a role.
+func (h *RoleHierarchy) AddAlias(alias, role string) error {
+	if alias == "" || role == "" {
+		return errors.ErrRoleHierarchyInvalidAlias.WithArgs(alias, role)
+	}
+	if h.Aliases == nil {
+		h.Aliases = make(map[string]string)
+	}
+	if _, exists := h.Aliases[alias]; exists {
+		return errors.ErrRoleHierarchyDuplicateAlias.WithArgs(alias)
+	}
+	h.Aliases[alias] = role
+	h.expanded = nil
+	return nil
+}
+
+// Validate checks whether the role hierarchy has no cycles and computes
+// the expanded roles for each of the roles.
+func (h *RoleHierarchy) Validate() error {
+	edges := make(map[string][]string)
+	for role, implied := range h.Implies {
+		edges[role] = append(edges[role], implied...)
+	}
+	for alias, role := range h.Aliases {
+		if _, exists := h.Implies[alias]; exists {
+			return errors.ErrRoleHierarchyAliasConflict.WithArgs(alias)
+		}
+		edges[alias] = append(edges[alias], role)
+	}
+
+	var roles []string
+	for role := range edges {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+
+	// The state of a role is 1 when it is being visited, and 2 when its
+	// expanded roles are known.
+	state := make(map[string]int)
+	expanded := make(map[string][]string)
+	var path []string
+	var visit func(string) error
+	visit = func(role string) error {
+		switch state[role] {
+		case 1:
+			for i, s := range path {
+				if s == role {
+					return errors.ErrRoleHierarchyCycle.WithArgs(strings.Join(append(path[i:], role), " -> "))
+				}
+			}
+		case 2:
+			return nil
+		}
+		state[role] = 1
+		path = append(path, role)
+		seen := map[string]bool{role: true}
+		var entries []string
+		for _, implied := range edges[role] {
+			if err := visit(implied); err != nil {
+				return err
+			}
+			for _, s := range append([]string{implied}, expanded[implied]...) {
+				if seen[s] {
+					continue
+				}
+				seen[s] = true
+				entries = append(entries, s)
+			}
+		}
+		path = path[:len(path)-1]
+		state[role] = 2
+		expanded[role] = entries
+		return nil
+	}
+	for _, role := range roles {
+		if err := visit(role); err != nil {
+			return err
+		}
+	}
+	h.expanded = expanded
+	return nil
+}
+
+// Expand returns the roles along with the roles they imply. The original
+// roles come first, in their original order. The roles are returned as is
+// when none of them implies other roles.
+func (h *RoleHierarchy) Expand(roles []string) []string {
+	entries, _ := h.expand(roles)
+	return entries
+}
+
+// expand returns the expanded roles, and whether any role was added to
+// the roles, i.e. the duplicate roles being removed does not count.
+func (h *RoleHierarchy) expand(roles []string) ([]string, bool) {
+	if h == nil || len(h.expanded) == 0 {
+		return roles, false
+	}
+	var found bool
+	for _, role := range roles {
+		if _, exists := h.expanded[role]; exists {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return roles, false
+	}
+	seen := make(map[string]bool)
+	var entries []string
+	for _, role := range roles {
+		if seen[role] {
+			continue
+		}
+		seen[role] = true
+		entries = append(entries, role)
+	}
+	var added bool
+	for _, role := range roles {
+		for _, s := range h.expanded[role] {
+			if seen[s] {
+				continue
+			}
+			seen[s] = true
+			entries = append(entries, s)
+			added = true
+		}
+	}
+	return entries, added
+}
+
+// ExpandRoles expands the roles of the user with the roles implied by them.
+// The roles used by HasRole, HasRoles, and the access list are updated
+// accordingly.
+func (u *User) ExpandRoles(h *RoleHierarchy) {
+	if u.Claims == nil {
+		return
+	}
+	roles, added := h.expand(u.Claims.Roles)
+	if !added {
+		return
+	}
+	u.Claims.Roles = roles
+	if u.tkv != nil {
+		u.tkv["roles"] = roles
+	}
+	if u.mkv != nil {
+		u.mkv["roles"] = roles
+	}
+	if u.rkv == nil {
+		u.rkv = make(map[string]interface{})
+	}
+	for _, role := range roles {
+		u.rkv[role] = true
+	}
+}
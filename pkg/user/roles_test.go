@@ -0,0 +1,155 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"github.com/greenpau/caddy-authorize/internal/tests"
+	"github.com/greenpau/caddy-authorize/pkg/errors"
+	"testing"
+)
+
+func TestRoleHierarchy(t *testing.T) {
+	var testcases = []struct {
+		name      string
+		implies   map[string][]string
+		aliases   map[string]string
+		roles     []string
+		want      map[string]interface{}
+		shouldErr bool
+		err       error
+	}{
+		{
+			name: "expand transitive roles",
+			implies: map[string][]string{
+				"admin":  {"editor"},
+				"editor": {"viewer"},
+			},
+			roles: []string{"admin"},
+			want: map[string]interface{}{
+				"roles":     []string{"admin", "editor", "viewer"},
+				"has_role":  true,
+				"has_roles": true,
+			},
+		},
+		{
+			name: "expand alias",
+			implies: map[string][]string{
+				"admin":  {"editor"},
+				"editor": {"viewer"},
+			},
+			aliases: map[string]string{
+				"administrator": "admin",
+			},
+			roles: []string{"guest", "administrator"},
+			want: map[string]interface{}{
+				"roles":     []string{"guest", "administrator", "admin", "editor", "viewer"},
+				"has_role":  true,
+				"has_roles": true,
+			},
+		},
+		{
+			name: "expand duplicate roles",
+			implies: map[string][]string{
+				"admin":  {"editor"},
+				"editor": {"viewer"},
+			},
+			// The expanded roles have the same number of roles as the
+			// original ones, because the duplicates are removed.
+			roles: []string{"admin", "admin", "admin"},
+			want: map[string]interface{}{
+				"roles":     []string{"admin", "editor", "viewer"},
+				"has_role":  true,
+				"has_roles": true,
+			},
+		},
+		{
+			name: "keep roles without implied roles",
+			implies: map[string][]string{
+				"admin": {"editor", "viewer"},
+			},
+			roles: []string{"viewer"},
+			want: map[string]interface{}{
+				"roles":     []string{"viewer"},
+				"has_role":  true,
+				"has_roles": false,
+			},
+		},
+		{
+			name: "fail with cycle",
+			implies: map[string][]string{
+				"admin":  {"editor"},
+				"editor": {"viewer"},
+				"viewer": {"admin"},
+			},
+			shouldErr: true,
+			err:       errors.ErrRoleHierarchyCycle.WithArgs("admin -> editor -> viewer -> admin"),
+		},
+		{
+			name: "fail with alias cycle",
+			aliases: map[string]string{
+				"admin":         "administrator",
+				"administrator": "admin",
+			},
+			shouldErr: true,
+			err:       errors.ErrRoleHierarchyCycle.WithArgs("admin -> administrator -> admin"),
+		},
+		{
+			name: "fail with alias implying roles",
+			implies: map[string][]string{
+				"administrator": {"editor"},
+			},
+			aliases: map[string]string{
+				"administrator": "admin",
+			},
+			shouldErr: true,
+			err:       errors.ErrRoleHierarchyAliasConflict.WithArgs("administrator"),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewRoleHierarchy()
+			for role, implied := range tc.implies {
+				if err := h.AddRole(role, implied...); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			for alias, role := range tc.aliases {
+				if err := h.AddAlias(alias, role); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			err := h.Validate()
+			if tests.EvalErr(t, err, tc.implies, tc.shouldErr, tc.err) {
+				return
+			}
+			var roles []interface{}
+			for _, role := range tc.roles {
+				roles = append(roles, role)
+			}
+			usr, err := NewUser(map[string]interface{}{"roles": roles})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			usr.ExpandRoles(h)
+			got := map[string]interface{}{
+				"roles":     usr.Claims.Roles,
+				"has_role":  usr.HasRole("viewer"),
+				"has_roles": usr.HasRoles("admin", "editor", "viewer"),
+			}
+			tests.EvalObjects(t, "user", tc.want, got)
+			tests.EvalObjects(t, "acl roles", usr.Claims.Roles, usr.GetData()["roles"])
+		})
+	}
+}
@@ -17,6 +17,7 @@ package user
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -50,13 +51,18 @@ type User struct {
 	Locked          bool          `json:"locked,omitempty" xml:"locked,omitempty" yaml:"locked,omitempty"`
 	requestHeaders  map[string]string
 	requestIdentity map[string]interface{}
-	Cached          bool `json:"cached,omitempty" xml:"cached,omitempty" yaml:"cached,omitempty"`
+	// Holds the placeholders associated with the user.
+	requestPlaceholders map[string]string
+	Cached              bool `json:"cached,omitempty" xml:"cached,omitempty" yaml:"cached,omitempty"`
 	// Holds the map for all the claims parsed from a token.
 	mkv map[string]interface{}
 	// Holds the map for a subset of claims necessary for ACL evaluation.
 	tkv map[string]interface{}
 	// Holds the map of the user roles.
 	rkv map[string]interface{}
+	// Holds the map for all the claims, including custom ones, as found
+	// in a token.
+	ckv map[string]interface{}
 }
 
 // Checkpoint represents additional checks that a user needs to pass. Once
@@ -149,6 +155,16 @@ func (u *User) GetRequestIdentity() map[string]interface{} {
 	return u.requestIdentity
 }
 
+// SetRequestPlaceholders sets request placeholders associated with the user.
+func (u *User) SetRequestPlaceholders(m map[string]string) {
+	u.requestPlaceholders = m
+}
+
+// GetRequestPlaceholders returns request placeholders associated with the user.
+func (u *User) GetRequestPlaceholders() map[string]string {
+	return u.requestPlaceholders
+}
+
 // HasRole checks whether a user has any of the provided roles.
 func (u *User) HasRole(roles ...string) bool {
 	for _, role := range roles {
@@ -643,6 +659,7 @@ func NewUser(data interface{}) (*User, error) {
 	u.Claims = c
 	u.mkv = mkv
 	u.tkv = tkv
+	u.ckv = m
 	return u, nil
 }
 
@@ -701,6 +718,152 @@ func (u *User) GetClaimValueByField(k string) string {
 	return ""
 }
 
+// GetClaimValuesByPath returns the values of the claim found via the
+// provided dotted path, e.g. metadata.department. The strings, booleans,
+// numbers, and the lists of them are converted to a list of strings.
+// If the path does not resolve, the function returns nil.
+func (u *User) GetClaimValuesByPath(path string) []string {
+	return getClaimValues(u.GetClaimByPath(path))
+}
+
+// GetClaimByPath returns the claim found via the provided dotted path, e.g.
+// metadata.department, as it was parsed from a token. If the path does not
+// resolve, the function returns nil.
+func (u *User) GetClaimByPath(path string) interface{} {
+	if u.ckv == nil {
+		return nil
+	}
+	var v interface{} = u.ckv
+	for _, k := range strings.Split(path, ".") {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		if v, ok = m[k]; !ok {
+			return nil
+		}
+	}
+	return v
+}
+
+// GetClaimByField returns the claim found by its field name, e.g. email, or
+// by its dotted path, e.g. metadata.department. The roles are the ones
+// normalized from the claims of a token, e.g. groups. The claims absent
+// from a token, e.g. mail, fall back to their normalized values.
+func (u *User) GetClaimByField(k string) interface{} {
+	if v, ok := u.mkv[k].([]string); ok {
+		return v
+	}
+	if v := u.GetClaimByPath(k); v != nil {
+		return v
+	}
+	return u.mkv[k]
+}
+
+// GetClaimValuesByField returns the values of the claim found by its field
+// name or by its dotted path. See GetClaimByField for the lookup rules.
+func (u *User) GetClaimValuesByField(k string) []string {
+	return getClaimValues(u.GetClaimByField(k))
+}
+
+func getClaimValues(v interface{}) []string {
+	switch data := v.(type) {
+	case []string:
+		return data
+	case []interface{}:
+		var values []string
+		for _, entry := range data {
+			if value, ok := getClaimScalarValue(entry); ok {
+				values = append(values, value)
+			}
+		}
+		return values
+	default:
+		if value, ok := getClaimScalarValue(data); ok {
+			return []string{value}
+		}
+	}
+	return nil
+}
+
+// GetTokenClaims returns a copy of the claims, including custom ones, as
+// found in a token.
+func (u *User) GetTokenClaims() map[string]interface{} {
+	if u.ckv == nil {
+		return nil
+	}
+	claims := make(map[string]interface{}, len(u.ckv))
+	for k, v := range u.ckv {
+		claims[k] = v
+	}
+	return claims
+}
+
+// GetFlatClaims returns the values of all the claims found in a token, by
+// their dotted paths, e.g. metadata.department. The lists of strings,
+// booleans, and numbers are joined with spaces. The entries of the other
+// lists are found by their indexes, e.g. groups.0.name.
+func (u *User) GetFlatClaims() map[string]string {
+	if u.ckv == nil {
+		return nil
+	}
+	claims := make(map[string]string)
+	flattenClaims(claims, "", u.ckv)
+	return claims
+}
+
+func flattenClaims(claims map[string]string, prefix string, v interface{}) {
+	switch data := v.(type) {
+	case map[string]interface{}:
+		for k, entry := range data {
+			flattenClaims(claims, prefix+k+".", entry)
+		}
+		return
+	case []string:
+		claims[strings.TrimSuffix(prefix, ".")] = strings.Join(data, " ")
+		return
+	case []interface{}:
+		values := make([]string, 0, len(data))
+		for _, entry := range data {
+			value, ok := getClaimScalarValue(entry)
+			if !ok {
+				values = nil
+				break
+			}
+			values = append(values, value)
+		}
+		if values != nil {
+			claims[strings.TrimSuffix(prefix, ".")] = strings.Join(values, " ")
+			return
+		}
+		for i, entry := range data {
+			flattenClaims(claims, prefix+strconv.Itoa(i)+".", entry)
+		}
+		return
+	}
+	if value, ok := getClaimScalarValue(v); ok {
+		claims[strings.TrimSuffix(prefix, ".")] = value
+	}
+}
+
+func getClaimScalarValue(v interface{}) (string, bool) {
+	switch data := v.(type) {
+	case string:
+		return data, true
+	case bool:
+		return strconv.FormatBool(data), true
+	case float64:
+		return strconv.FormatFloat(data, 'f', -1, 64), true
+	case int:
+		return strconv.Itoa(data), true
+	case int64:
+		return strconv.FormatInt(data, 10), true
+	case json.Number:
+		return data.String(), true
+	}
+	return "", false
+}
+
 // NewCheckpoints returns Checkpoint instances.
 func NewCheckpoints(v interface{}) ([]*Checkpoint, error) {
 	var entries []string
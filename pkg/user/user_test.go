@@ -476,3 +476,72 @@ func TestNewClaimsFromMap(t *testing.T) {
 		})
 	}
 }
+
+func TestGetClaimValuesByPath(t *testing.T) {
+	data := []byte(`{
+        "email": "jsmith@contoso.com",
+        "email_verified": true,
+        "tenant_id": 42,
+        "ratio": 0.5,
+        "teams": ["admin", "staff", 1, {"foo": "bar"}],
+        "metadata": {
+            "department": "engineering",
+            "location": {"city": "nyc"}
+        }
+    }`)
+	testcases := []struct {
+		name string
+		path string
+		want []string
+	}{
+		{name: "string claim", path: "email", want: []string{"jsmith@contoso.com"}},
+		{name: "bool claim", path: "email_verified", want: []string{"true"}},
+		{name: "integer claim", path: "tenant_id", want: []string{"42"}},
+		{name: "float claim", path: "ratio", want: []string{"0.5"}},
+		{name: "list claim", path: "teams", want: []string{"admin", "staff", "1"}},
+		{name: "nested claim", path: "metadata.department", want: []string{"engineering"}},
+		{name: "deeply nested claim", path: "metadata.location.city", want: []string{"nyc"}},
+		{name: "object claim", path: "metadata.location"},
+		{name: "nested path in string claim", path: "email.domain"},
+		{name: "claim not found", path: "metadata.title"},
+	}
+	usr, err := NewUser(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := usr.GetClaimValuesByPath(tc.path)
+			tests.EvalObjects(t, "values", tc.want, got)
+		})
+	}
+}
+
+func TestGetFlatClaims(t *testing.T) {
+	data := []byte(`{
+        "email": "jsmith@contoso.com",
+        "email_verified": true,
+        "tenant_id": 42,
+        "teams": ["admin", "staff", 1],
+        "projects": [{"name": "ops"}, {"name": "dev"}],
+        "metadata": {
+            "department": "engineering",
+            "location": {"city": "nyc"}
+        }
+    }`)
+	usr, err := NewUser(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"email":                  "jsmith@contoso.com",
+		"email_verified":         "true",
+		"tenant_id":              "42",
+		"teams":                  "admin staff 1",
+		"projects.0.name":        "ops",
+		"projects.1.name":        "dev",
+		"metadata.department":    "engineering",
+		"metadata.location.city": "nyc",
+	}
+	tests.EvalObjects(t, "claims", want, usr.GetFlatClaims())
+}
@@ -0,0 +1,143 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// GetRequestHost returns the lowercase host name of the request without
+// the port number.
+func GetRequestHost(r *http.Request) string {
+	host := r.Host
+	if host == "" {
+		host = r.URL.Host
+	}
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.ToLower(host)
+}
+
+// GetRequestScheme returns the scheme of the request, i.e. http or https.
+func GetRequestScheme(r *http.Request) string {
+	if r.TLS != nil {
+		return "https"
+	}
+	if r.URL.Scheme != "" {
+		return strings.ToLower(r.URL.Scheme)
+	}
+	return "http"
+}
+
+// PlaceholderResolver returns the value of a placeholder. It is implemented
+// by caddy.Replacer. The interface keeps the package independent of caddy.
+type PlaceholderResolver interface {
+	Get(variable string) (interface{}, bool)
+}
+
+type placeholderResolverContextKey struct{}
+
+// NewPlaceholderResolverContext returns a context carrying the resolver of
+// the placeholders of a request.
+func NewPlaceholderResolverContext(ctx context.Context, resolver PlaceholderResolver) context.Context {
+	return context.WithValue(ctx, placeholderResolverContextKey{}, resolver)
+}
+
+// GetRequestPlaceholderValues returns the values of the request placeholder,
+// e.g. http.request.uri.path.1, http.request.cookie.tenant, or
+// http.vars.tenant. When the context carries a resolver, i.e. the request
+// is being handled by Caddy, the placeholder is resolved by the resolver.
+// Otherwise, the values of a subset of the Caddy request placeholders are
+// derived from the request.
+func GetRequestPlaceholderValues(ctx context.Context, r *http.Request, name string) []string {
+	if resolver, ok := ctx.Value(placeholderResolverContextKey{}).(PlaceholderResolver); ok {
+		v, found := resolver.Get(name)
+		if !found {
+			return nil
+		}
+		return getPlaceholderStringValues(v)
+	}
+	return getRequestPlaceholderValues(r, name)
+}
+
+func getPlaceholderStringValues(v interface{}) []string {
+	switch data := v.(type) {
+	case nil:
+		return nil
+	case string:
+		if data == "" {
+			return nil
+		}
+		return []string{data}
+	case []string:
+		return data
+	case fmt.Stringer:
+		return getPlaceholderStringValues(data.String())
+	}
+	return []string{fmt.Sprint(v)}
+}
+
+func getRequestPlaceholderValues(r *http.Request, name string) []string {
+	switch name {
+	case "http.request.host":
+		return []string{GetRequestHost(r)}
+	case "http.request.method":
+		return []string{r.Method}
+	case "http.request.scheme":
+		return []string{GetRequestScheme(r)}
+	case "http.request.uri":
+		return []string{r.URL.RequestURI()}
+	case "http.request.uri.path":
+		return []string{r.URL.Path}
+	case "http.request.uri.query":
+		return []string{r.URL.RawQuery}
+	}
+	switch {
+	case strings.HasPrefix(name, "http.request.uri.path."):
+		idx, err := strconv.Atoi(strings.TrimPrefix(name, "http.request.uri.path."))
+		if err != nil || idx < 0 {
+			return nil
+		}
+		parts := strings.Split(r.URL.Path, "/")
+		if len(parts) > 0 && parts[0] == "" {
+			parts = parts[1:]
+		}
+		if idx >= len(parts) {
+			return nil
+		}
+		return []string{parts[idx]}
+	case strings.HasPrefix(name, "http.request.host.labels."):
+		idx, err := strconv.Atoi(strings.TrimPrefix(name, "http.request.host.labels."))
+		if err != nil || idx < 0 {
+			return nil
+		}
+		labels := strings.Split(GetRequestHost(r), ".")
+		if idx >= len(labels) {
+			return nil
+		}
+		return []string{labels[len(labels)-idx-1]}
+	case strings.HasPrefix(name, "http.request.header."):
+		return r.Header.Values(strings.TrimPrefix(name, "http.request.header."))
+	case strings.HasPrefix(name, "http.request.uri.query."):
+		return r.URL.Query()[strings.TrimPrefix(name, "http.request.uri.query.")]
+	}
+	return nil
+}
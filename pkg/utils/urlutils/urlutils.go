@@ -15,7 +15,10 @@
 package urlutils
 
 import (
+	"fmt"
+	"net"
 	"net/http"
+	"strings"
 )
 
 // GetCurrentURL returns current URL.
@@ -57,3 +60,83 @@ func GetCurrentBaseURL(r *http.Request) string {
 
 	return redirectBaseURL
 }
+
+// ParseTrustedProxies parses the IP addresses and the CIDR networks of the
+// trusted proxies.
+func ParseTrustedProxies(entries []string) ([]*net.IPNet, error) {
+	var proxies []*net.IPNet
+	for _, entry := range entries {
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("trusted proxy %q is invalid", entry)
+			}
+			if ip.To4() != nil {
+				entry += "/32"
+			} else {
+				entry += "/128"
+			}
+		}
+		_, network, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("trusted proxy %q is invalid", entry)
+		}
+		proxies = append(proxies, network)
+	}
+	return proxies, nil
+}
+
+// IsTrustedProxy returns true when the request comes from one of the
+// trusted proxies.
+func IsTrustedProxy(r *http.Request, proxies []*net.IPNet) bool {
+	if len(proxies) == 0 {
+		return false
+	}
+	addr := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, network := range proxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetTrustedBaseURL returns current base URL. The X-Forwarded headers are
+// used only when the request comes from one of the trusted proxies.
+func GetTrustedBaseURL(r *http.Request, proxies []*net.IPNet) string {
+	if IsTrustedProxy(r, proxies) {
+		return GetCurrentBaseURL(r)
+	}
+	if r.TLS == nil {
+		return "http://" + r.Host
+	}
+	return "https://" + r.Host
+}
+
+// IsAllowedHost returns true when the host matches one of the allowed
+// hosts. The allowed host either matches the host exactly, with or without
+// the port, or it is a wildcard, e.g. *.contoso.com, matching subdomains.
+func IsAllowedHost(host string, hosts []string) bool {
+	host = strings.ToLower(host)
+	hostname := host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		hostname = h
+	}
+	for _, entry := range hosts {
+		entry = strings.ToLower(entry)
+		switch {
+		case entry == host, entry == hostname:
+			return true
+		case strings.HasPrefix(entry, "*.") && strings.HasSuffix(hostname, entry[1:]):
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+// Copyright 2020 Paul Greenberg greenpau@outlook.com
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package urlutils
+
+import (
+	"fmt"
+	"github.com/greenpau/caddy-authorize/internal/tests"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetTrustedBaseURL(t *testing.T) {
+	proxies, err := ParseTrustedProxies([]string{"10.0.0.0/8", "192.168.1.1", "::1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var testcases = []struct {
+		name       string
+		remoteAddr string
+		want       string
+	}{
+		{name: "spoofed headers from client", remoteAddr: "203.0.113.10:4321", want: "http://app.contoso.com"},
+		{name: "headers from trusted network", remoteAddr: "10.1.2.3:4321", want: "https://evil.com"},
+		{name: "headers from trusted address", remoteAddr: "192.168.1.1:4321", want: "https://evil.com"},
+		{name: "headers from trusted ipv6 address", remoteAddr: "[::1]:4321", want: "https://evil.com"},
+		{name: "headers from untrusted neighbor", remoteAddr: "192.168.1.2:4321", want: "http://app.contoso.com"},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "http://app.contoso.com/dashboard", nil)
+			r.RemoteAddr = tc.remoteAddr
+			r.Header.Set("X-Forwarded-Host", "evil.com")
+			r.Header.Set("X-Forwarded-Proto", "https")
+			tests.EvalObjects(t, "base url", tc.want, GetTrustedBaseURL(r, proxies))
+		})
+	}
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	for _, entry := range []string{"foobar", "10.0.0.0/33", ""} {
+		_, err := ParseTrustedProxies([]string{entry})
+		tests.EvalErr(t, err, entry, true, fmt.Errorf("trusted proxy %q is invalid", entry))
+	}
+}
+
+func TestIsAllowedHost(t *testing.T) {
+	hosts := []string{"app.contoso.com", "*.apps.contoso.com", "localhost:8443"}
+	var testcases = []struct {
+		host string
+		want bool
+	}{
+		{host: "app.contoso.com", want: true},
+		{host: "APP.contoso.com:443", want: true},
+		{host: "portal.apps.contoso.com", want: true},
+		{host: "localhost:8443", want: true},
+		{host: "localhost"},
+		{host: "apps.contoso.com"},
+		{host: "evilapps.contoso.com"},
+		{host: "app.contoso.com.evil.com"},
+		{host: "evil.com"},
+		{host: ""},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.host, func(t *testing.T) {
+			tests.EvalObjects(t, "allowed", tc.want, IsAllowedHost(tc.host, hosts))
+		})
+	}
+}
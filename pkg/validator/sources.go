@@ -18,6 +18,7 @@ import (
 	"context"
 	"github.com/greenpau/caddy-authorize/pkg/errors"
 	"github.com/greenpau/caddy-authorize/pkg/user"
+	"github.com/greenpau/caddy-authorize/pkg/utils"
 	"net/http"
 	"strings"
 )
@@ -135,6 +136,40 @@ func (v *TokenValidator) parseCookies(ctx context.Context, r *http.Request) (str
 // Authorize authorizes HTTP requests based on the presence and the content of
 // the tokens in the requests.
 func (v *TokenValidator) Authorize(ctx context.Context, r *http.Request) (usr *user.User, err error) {
+	usr, err = v.parseRequestToken(ctx, r)
+	if err != nil {
+		return usr, err
+	}
+	if err := v.guardian.authorize(ctx, r, usr); err != nil {
+		return usr, err
+	}
+	return usr, nil
+}
+
+// Authenticate validates the token of HTTP request without authorizing the
+// request, i.e. neither the access list, nor the path-based access lists,
+// nor the method and path rules apply. The source address bound to the
+// token is validated when enabled.
+func (v *TokenValidator) Authenticate(ctx context.Context, r *http.Request) (*user.User, error) {
+	usr, err := v.parseRequestToken(ctx, r)
+	if err != nil {
+		return usr, err
+	}
+	if v.opts != nil && v.opts.ValidateSourceAddress {
+		if usr.Claims.Address == "" {
+			return usr, errors.ErrSourceAddressNotFound
+		}
+		reqAddr := utils.GetSourceAddress(r)
+		if usr.Claims.Address != reqAddr {
+			return usr, errors.ErrSourceAddressMismatch.WithArgs(usr.Claims.Address, reqAddr)
+		}
+	}
+	return usr, nil
+}
+
+// parseRequestToken finds the token of HTTP request and returns the user
+// of the token. The previously validated users are found in the cache.
+func (v *TokenValidator) parseRequestToken(ctx context.Context, r *http.Request) (*user.User, error) {
 	var token, tokenName, tokenSource string
 	var found bool
 	for _, sourceName := range v.tokenSources {
@@ -159,20 +194,44 @@ func (v *TokenValidator) Authorize(ctx context.Context, r *http.Request) (usr *u
 	}
 
 	// Perform cache lookup for the previously obtained credentials.
-	usr = v.cache.Get(token)
+	usr := v.cache.Get(token)
 	if usr == nil {
 		// The user is not in the cache.
+		var err error
 		usr, err = v.keystore.ParseToken(tokenName, token)
 		if err != nil {
-			return usr, errors.ErrValidatorInvalidToken.WithArgs(err)
+			return usr, errors.WithReason(errors.GetReasonCode(err), errors.ErrValidatorInvalidToken.WithArgs(err))
 		}
-	}
-
-	if err := v.guardian.authorize(ctx, r, usr); err != nil {
-		return usr, err
+		// The cached users have their roles expanded already.
+		usr.ExpandRoles(v.roleHierarchy)
 	}
 	usr.TokenSource = tokenSource
 	usr.TokenName = tokenName
 	usr.Token = token
 	return usr, nil
 }
+
+// AuthorizeUser authorizes HTTP requests on behalf of the user, whose token
+// has been validated elsewhere. It applies the same access list and request
+// validation as Authorize, but skips the token discovery and verification.
+// The roles of the user are expanded with the role hierarchy.
+func (v *TokenValidator) AuthorizeUser(ctx context.Context, r *http.Request, usr *user.User) error {
+	usr.ExpandRoles(v.roleHierarchy)
+	return v.guardian.authorize(ctx, r, usr)
+}
+
+type reauthorizationContextKey struct{}
+
+// ReauthorizeUser authorizes HTTP requests on behalf of the user, which was
+// authorized already. Unlike AuthorizeUser, the access list is evaluated for
+// the cached users even when its decision holds for the subsequent
+// requests, e.g. to record the decision trace.
+func (v *TokenValidator) ReauthorizeUser(ctx context.Context, r *http.Request, usr *user.User) error {
+	ctx = context.WithValue(ctx, reauthorizationContextKey{}, true)
+	return v.guardian.authorize(ctx, r, usr)
+}
+
+func isReauthorization(ctx context.Context) bool {
+	v, _ := ctx.Value(reauthorizationContextKey{}).(bool)
+	return v
+}
@@ -75,6 +75,7 @@ type TokenValidator struct {
 	guardian        guardian
 	tokenSources    []string
 	opts            *options.TokenValidatorOptions
+	roleHierarchy   *user.RoleHierarchy
 }
 
 // NewTokenValidator returns an instance of TokenValidator
@@ -151,17 +152,19 @@ func (v *TokenValidator) GetSourcePriority() []string {
 }
 
 func (g *guardianBase) authorize(ctx context.Context, r *http.Request, usr *user.User) error {
-	if usr.Cached {
+	// The cached users were authorized when cached. The decision is made
+	// again unless it holds for the subsequent requests.
+	if usr.Cached && g.accessList.IsCacheable() && !isReauthorization(ctx) {
 		return nil
 	}
-	if userAllowed := g.accessList.Allow(ctx, usr.GetData()); !userAllowed {
+	if userAllowed := g.accessList.Allow(ctx, getInputData(ctx, r, usr, g.accessList.GetInputFields())); !userAllowed {
 		return errors.ErrAccessNotAllowed
 	}
 	return nil
 }
 
 func (g *guardianWithSrcAddr) authorize(ctx context.Context, r *http.Request, usr *user.User) error {
-	if userAllowed := g.accessList.Allow(ctx, usr.GetData()); !userAllowed {
+	if userAllowed := g.accessList.Allow(ctx, getInputData(ctx, r, usr, g.accessList.GetInputFields())); !userAllowed {
 		return errors.ErrAccessNotAllowed
 	}
 	if usr.Claims.Address == "" {
@@ -175,7 +178,7 @@ func (g *guardianWithSrcAddr) authorize(ctx context.Context, r *http.Request, us
 }
 
 func (g *guardianWithPathClaim) authorize(ctx context.Context, r *http.Request, usr *user.User) error {
-	if userAllowed := g.accessList.Allow(ctx, usr.GetData()); !userAllowed {
+	if userAllowed := g.accessList.Allow(ctx, getInputData(ctx, r, usr, g.accessList.GetInputFields())); !userAllowed {
 		return errors.ErrAccessNotAllowed
 	}
 	if usr.Claims.AccessList == nil {
@@ -190,7 +193,7 @@ func (g *guardianWithPathClaim) authorize(ctx context.Context, r *http.Request,
 }
 
 func (g *guardianWithSrcAddrPathClaim) authorize(ctx context.Context, r *http.Request, usr *user.User) error {
-	if userAllowed := g.accessList.Allow(ctx, usr.GetData()); !userAllowed {
+	if userAllowed := g.accessList.Allow(ctx, getInputData(ctx, r, usr, g.accessList.GetInputFields())); !userAllowed {
 		return errors.ErrAccessNotAllowed
 	}
 	if usr.Claims.Address == "" {
@@ -218,6 +221,7 @@ func (g *guardianWithMethodPath) authorize(ctx context.Context, r *http.Request,
 	}
 	kv["method"] = r.Method
 	kv["path"] = r.URL.Path
+	addInputData(ctx, kv, r, usr, g.accessList.GetInputFields())
 	if userAllowed := g.accessList.Allow(ctx, kv); !userAllowed {
 		return errors.ErrAccessNotAllowed
 	}
@@ -231,6 +235,7 @@ func (g *guardianWithMethodPathSrcAddr) authorize(ctx context.Context, r *http.R
 	}
 	kv["method"] = r.Method
 	kv["path"] = r.URL.Path
+	addInputData(ctx, kv, r, usr, g.accessList.GetInputFields())
 	if userAllowed := g.accessList.Allow(ctx, kv); !userAllowed {
 		return errors.ErrAccessNotAllowed
 	}
@@ -251,6 +256,7 @@ func (g *guardianWithMethodPathPathClaim) authorize(ctx context.Context, r *http
 	}
 	kv["method"] = r.Method
 	kv["path"] = r.URL.Path
+	addInputData(ctx, kv, r, usr, g.accessList.GetInputFields())
 	if userAllowed := g.accessList.Allow(ctx, kv); !userAllowed {
 		return errors.ErrAccessNotAllowed
 	}
@@ -272,6 +278,7 @@ func (g *guardianWithMethodPathSrcAddrPathClaim) authorize(ctx context.Context,
 	}
 	kv["method"] = r.Method
 	kv["path"] = r.URL.Path
+	addInputData(ctx, kv, r, usr, g.accessList.GetInputFields())
 	if userAllowed := g.accessList.Allow(ctx, kv); !userAllowed {
 		return errors.ErrAccessNotAllowed
 	}
@@ -294,6 +301,61 @@ func (g *guardianWithMethodPathSrcAddrPathClaim) authorize(ctx context.Context,
 	return errors.ErrAccessNotAllowedByPathACL
 }
 
+// getInputData returns user claim fields and, when referenced by an ACL,
+// the fields derived from HTTP request, the claim path fields, and the
+// resolved placeholders.
+func getInputData(ctx context.Context, r *http.Request, usr *user.User, fields []string) map[string]interface{} {
+	if len(fields) == 0 {
+		return usr.GetData()
+	}
+	kv := make(map[string]interface{})
+	for k, v := range usr.GetData() {
+		kv[k] = v
+	}
+	addInputData(ctx, kv, r, usr, fields)
+	return kv
+}
+
+// addInputData adds the fields derived from HTTP request, e.g. host,
+// scheme, header.X-Tenant, or query.tenant, the claim path fields,
+// e.g. claim.metadata.department, and the resolved placeholders, e.g.
+// {http.request.uri.path.1} or {claim.sub}, to the ACL input data. The
+// request placeholders are resolved by the resolver carried by the context,
+// if any. The fields with no value in the request or in the claims are not
+// being added.
+func addInputData(ctx context.Context, kv map[string]interface{}, r *http.Request, usr *user.User, fields []string) {
+	for _, field := range fields {
+		switch {
+		case field == "host":
+			kv[field] = utils.GetRequestHost(r)
+		case field == "scheme":
+			kv[field] = utils.GetRequestScheme(r)
+		case strings.HasPrefix(field, "header."):
+			if values := r.Header.Values(strings.TrimPrefix(field, "header.")); len(values) > 0 {
+				kv[field] = values
+			}
+		case strings.HasPrefix(field, "query."):
+			if values, exists := r.URL.Query()[strings.TrimPrefix(field, "query.")]; exists && len(values) > 0 {
+				kv[field] = values
+			}
+		case strings.HasPrefix(field, "claim."):
+			if values := usr.GetClaimValuesByPath(strings.TrimPrefix(field, "claim.")); len(values) > 0 {
+				kv[field] = values
+			}
+		case strings.HasPrefix(field, "{claim."):
+			path := strings.TrimSuffix(strings.TrimPrefix(field, "{claim."), "}")
+			if values := usr.GetClaimValuesByPath(path); len(values) > 0 {
+				kv[field] = values
+			}
+		case strings.HasPrefix(field, "{"):
+			name := strings.TrimSuffix(strings.TrimPrefix(field, "{"), "}")
+			if values := utils.GetRequestPlaceholderValues(ctx, r, name); len(values) > 0 {
+				kv[field] = values
+			}
+		}
+	}
+}
+
 // Configure adds access list and keys for the verification of tokens.
 func (v *TokenValidator) Configure(ctx context.Context, keys []*kms.CryptoKey, accessList *acl.AccessList, opts *options.TokenValidatorOptions) error {
 	if err := v.addKeys(ctx, keys); err != nil {
@@ -383,6 +445,13 @@ func (v *TokenValidator) addKeys(ctx context.Context, keys []*kms.CryptoKey) err
 	return nil
 }
 
+// SetRoleHierarchy sets the role hierarchy used to expand the roles of the
+// users prior to the evaluation of the access list. The hierarchy must be
+// validated.
+func (v *TokenValidator) SetRoleHierarchy(h *user.RoleHierarchy) {
+	v.roleHierarchy = h
+}
+
 // CacheUser adds a user to token validator cache.
 func (v *TokenValidator) CacheUser(usr *user.User) error {
 	return v.cache.Add(usr)
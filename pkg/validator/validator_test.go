@@ -16,9 +16,13 @@ package validator
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -31,6 +35,7 @@ import (
 	"github.com/greenpau/caddy-authorize/pkg/user"
 	"github.com/greenpau/caddy-authorize/pkg/utils"
 
+	jwtlib "github.com/golang-jwt/jwt"
 	"github.com/google/go-cmp/cmp"
 )
 
@@ -143,6 +148,15 @@ var (
 	}
 
 	// Create viewer persona
+	engineer = `{
+        "exp": ` + fmt.Sprintf("%d", time.Now().Add(10*time.Minute).Unix()) + `,
+        "iat": ` + fmt.Sprintf("%d", time.Now().Add(10*time.Minute*-1).Unix()) + `,
+        "nbf": ` + fmt.Sprintf("%d", time.Date(2015, 10, 10, 12, 0, 0, 0, time.UTC).Unix()) + `,
+        "sub": "smithj@outlook.com",
+        "email_verified": true,
+        "metadata": {"department": "engineering"}
+    }`
+
 	viewer = `{
         "exp": ` + fmt.Sprintf("%d", time.Now().Add(10*time.Minute).Unix()) + `,
         "iat": ` + fmt.Sprintf("%d", time.Now().Add(10*time.Minute*-1).Unix()) + `,
@@ -186,6 +200,51 @@ var (
 		},
 	}
 
+	// Create access list with default deny that allows readers only
+	// when the request targets a specific tenant.
+	requestFieldsACL = []*acl.RuleConfiguration{
+		{
+			Conditions: []string{
+				"match scope read:books",
+				"match host app.contoso.com",
+				"match header.x-tenant contoso",
+				"match query.view summary",
+			},
+			Action: `allow`,
+		},
+	}
+
+	// Create access list with default deny that allows engineering
+	// department only.
+	claimFieldsACL = []*acl.RuleConfiguration{
+		{
+			Conditions: []string{
+				"match claim.email_verified true",
+				"match claim.metadata.department engineering",
+			},
+			Action: `allow`,
+		},
+	}
+
+	// Create access list with default deny that allows users accessing
+	// their own resources or the resources of their tenant.
+	placeholderACL = []*acl.RuleConfiguration{
+		{
+			Conditions: []string{
+				"prefix match path /users/",
+				"match sub {http.request.uri.path.1}",
+			},
+			Action: `allow stop`,
+		},
+		{
+			Conditions: []string{
+				"prefix match path /tenants/",
+				"match claim.metadata.department {http.request.host.labels.3}",
+			},
+			Action: `allow stop`,
+		},
+	}
+
 	// Create access list with default allow that denies editor
 	defaultRolesAllowACL = []*acl.RuleConfiguration{
 		{
@@ -302,9 +361,12 @@ func TestAuthorize(t *testing.T) {
 		config                      []*acl.RuleConfiguration
 		method                      string
 		path                        string
+		host                        string
+		headers                     map[string]string
 		sourceAddress               string
 		enableBearer                bool
 		cacheUser                   bool
+		customClaims                bool
 		validateAccessListPathClaim bool
 		validateSourceAddress       bool
 		validateMethodPath          bool
@@ -900,6 +962,128 @@ func TestAuthorize(t *testing.T) {
 			shouldErr:             true,
 			err:                   errors.ErrSourceAddressMismatch.WithArgs("10.10.10.10", "20.20.20.20"),
 		},
+		{
+			name:      "user with viewer scope claim and request fields acl",
+			claims:    viewer,
+			config:    requestFieldsACL,
+			method:    "GET",
+			path:      "/app/page3/allowed?view=summary",
+			host:      "App.Contoso.com:8443",
+			headers:   map[string]string{"X-Tenant": "contoso"},
+			cacheUser: true,
+		},
+		{
+			name:      "user with viewer scope claim and request fields acl and mismatched header",
+			claims:    viewer,
+			config:    requestFieldsACL,
+			method:    "GET",
+			path:      "/app/page3/allowed?view=summary",
+			host:      "app.contoso.com",
+			headers:   map[string]string{"X-Tenant": "fabrikam"},
+			shouldErr: true,
+			err:       errors.ErrAccessNotAllowed,
+		},
+		{
+			name:      "user with viewer scope claim and request fields acl and missing query parameter",
+			claims:    viewer,
+			config:    requestFieldsACL,
+			method:    "GET",
+			path:      "/app/page3/allowed",
+			host:      "app.contoso.com",
+			headers:   map[string]string{"X-Tenant": "contoso"},
+			shouldErr: true,
+			err:       errors.ErrAccessNotAllowed,
+		},
+		{
+			name:               "user with viewer scope claim and request fields acl with method and path",
+			claims:             viewer,
+			config:             requestFieldsACL,
+			method:             "GET",
+			path:               "/app/page3/allowed?view=summary",
+			host:               "app.contoso.com",
+			headers:            map[string]string{"X-Tenant": "contoso"},
+			validateMethodPath: true,
+		},
+		{
+			name:               "user with viewer scope claim and request fields acl with method and path and wrong host",
+			claims:             viewer,
+			config:             requestFieldsACL,
+			method:             "GET",
+			path:               "/app/page3/allowed?view=summary",
+			host:               "app.fabrikam.com",
+			headers:            map[string]string{"X-Tenant": "contoso"},
+			validateMethodPath: true,
+			shouldErr:          true,
+			err:                errors.ErrAccessNotAllowed,
+		},
+		{
+			name:         "user with custom claims and claim fields acl",
+			claims:       engineer,
+			config:       claimFieldsACL,
+			method:       "GET",
+			path:         "/app/page3/allowed",
+			customClaims: true,
+			cacheUser:    true,
+		},
+		{
+			name:               "user with custom claims and claim fields acl with method and path",
+			claims:             engineer,
+			config:             claimFieldsACL,
+			method:             "GET",
+			path:               "/app/page3/allowed",
+			customClaims:       true,
+			validateMethodPath: true,
+		},
+		{
+			name:      "user without custom claims and claim fields acl",
+			claims:    viewer,
+			config:    claimFieldsACL,
+			method:    "GET",
+			path:      "/app/page3/allowed",
+			shouldErr: true,
+			err:       errors.ErrAccessNotAllowed,
+		},
+		{
+			name:               "user accessing own resource with placeholder acl",
+			claims:             viewer,
+			config:             placeholderACL,
+			method:             "GET",
+			path:               "/users/smithj@outlook.com/profile",
+			validateMethodPath: true,
+			cacheUser:          true,
+		},
+		{
+			name:               "user accessing resource of another user with placeholder acl",
+			claims:             viewer,
+			config:             placeholderACL,
+			method:             "GET",
+			path:               "/users/jane.smith@outlook.com/profile",
+			validateMethodPath: true,
+			shouldErr:          true,
+			err:                errors.ErrAccessNotAllowed,
+		},
+		{
+			name:               "user accessing resource of own tenant with placeholder acl with method and path",
+			claims:             engineer,
+			config:             placeholderACL,
+			method:             "GET",
+			path:               "/tenants/settings",
+			host:               "engineering.apps.contoso.com",
+			customClaims:       true,
+			validateMethodPath: true,
+		},
+		{
+			name:               "user accessing resource of another tenant with placeholder acl with method and path",
+			claims:             engineer,
+			config:             placeholderACL,
+			method:             "GET",
+			path:               "/tenants/settings",
+			host:               "marketing.apps.contoso.com",
+			customClaims:       true,
+			validateMethodPath: true,
+			shouldErr:          true,
+			err:                errors.ErrAccessNotAllowed,
+		},
 		{
 			name:            "validator options disabled",
 			claims:          viewer,
@@ -979,6 +1163,17 @@ func TestAuthorize(t *testing.T) {
 					t.Fatal(err)
 				}
 				token = usr.Token
+				if tc.customClaims {
+					// The signing of user claims drops custom claims.
+					m := make(jwtlib.MapClaims)
+					if err := json.Unmarshal([]byte(tc.claims), &m); err != nil {
+						t.Fatal(err)
+					}
+					token, err = jwtlib.NewWithClaims(jwtlib.SigningMethodHS512, m).SignedString(signingKey.Sign.Secret)
+					if err != nil {
+						t.Fatal(err)
+					}
+				}
 			}
 
 			if tc.name == "bad token" {
@@ -1043,6 +1238,14 @@ func TestAuthorize(t *testing.T) {
 				req.Header.Set("X-Real-Ip", tc.sourceAddress)
 			}
 
+			if tc.host != "" {
+				req.Host = tc.host
+			}
+
+			for k, v := range tc.headers {
+				req.Header.Set(k, v)
+			}
+
 			w := httptest.NewRecorder()
 			handler(w, req)
 			w.Result()
@@ -1050,6 +1253,194 @@ func TestAuthorize(t *testing.T) {
 	}
 }
 
+func TestAuthorizeCachedUser(t *testing.T) {
+	testcases := []struct {
+		name string
+		// The rules of the file, before and after the reload.
+		rules       string
+		reloadRules string
+		shadow      []*acl.RuleConfiguration
+		// The outcomes of the first request, the request of the cached
+		// user, and the request of the cached user after the reload.
+		want           []error
+		shadowCounters *acl.ShadowCounters
+	}{
+		{
+			name:        "cached user after reload of denying file acl",
+			rules:       "- conditions: [\"match scopes read:books\"]\n  action: allow\n",
+			reloadRules: "- conditions: [\"match scopes read:books\"]\n  action: deny\n",
+			want:        []error{nil, nil, errors.ErrAccessNotAllowed},
+		},
+		{
+			name:        "cached user after reload of allowing file acl",
+			rules:       "- conditions: [\"match scopes read:books\"]\n  action: allow\n",
+			reloadRules: "- conditions: [\"always match scopes any\"]\n  action: allow\n",
+			want:        []error{nil, nil, nil},
+		},
+		{
+			name: "cached user with shadow acl",
+			shadow: []*acl.RuleConfiguration{
+				{Conditions: []string{"match scopes read:books"}, Action: `deny`},
+			},
+			want:           []error{nil, nil, nil},
+			shadowCounters: &acl.ShadowCounters{WouldDeny: 3},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			logger := utils.NewLogger()
+			accessList := acl.NewAccessList()
+			accessList.SetLogger(logger)
+
+			var filePath string
+			if tc.rules != "" {
+				tmpDir, err := tests.TempDir("TestAuthorizeCachedUser")
+				if err != nil {
+					t.Fatalf("failed creating temp dir: %v", err)
+				}
+				defer os.RemoveAll(tmpDir)
+				filePath = filepath.Join(tmpDir, "rules.yaml")
+				if err := ioutil.WriteFile(filePath, []byte(tc.rules), 0600); err != nil {
+					t.Fatalf("failed writing %s: %v", filePath, err)
+				}
+				if err := accessList.WatchFile(ctx, filePath, 0); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				defer accessList.Close()
+			} else {
+				if err := accessList.AddRules(ctx, defaultDenyACL); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			if tc.shadow != nil {
+				shadow := acl.NewAccessList()
+				if err := shadow.AddRules(ctx, tc.shadow); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				accessList.SetShadow(shadow)
+			}
+
+			keys := testutils.NewTestCryptoKeyStore().GetKeys()
+			validator := NewTokenValidator()
+			if err := validator.Configure(ctx, keys, accessList, options.NewTokenValidatorOptions()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			usr, err := user.NewUser(viewer)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := keys[0].SignToken("HS512", usr); err != nil {
+				t.Fatal(err)
+			}
+			req, err := http.NewRequest("GET", "/app/viewer", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("Authorization", fmt.Sprintf("access_token=%s", usr.Token))
+
+			var got []error
+			for i := range tc.want {
+				if i == 2 && tc.reloadRules != "" {
+					// The file is replaced with the rules of the same size,
+					// the modification time tells the change apart.
+					modTime := time.Now().Add(time.Minute)
+					if err := ioutil.WriteFile(filePath, []byte(tc.reloadRules), 0600); err != nil {
+						t.Fatalf("failed writing %s: %v", filePath, err)
+					}
+					if err := os.Chtimes(filePath, modTime, modTime); err != nil {
+						t.Fatalf("failed updating %s: %v", filePath, err)
+					}
+					if err := accessList.WatchFile(ctx, filePath, 0); err != nil {
+						t.Fatalf("unexpected error: %v", err)
+					}
+				}
+				authorizedUser, err := validator.Authorize(ctx, req)
+				got = append(got, err)
+				if i == 0 && err == nil {
+					if err := validator.CacheUser(authorizedUser); err != nil {
+						t.Fatalf("unexpected error: %v", err)
+					}
+				}
+				if i > 0 && err == nil && !authorizedUser.Cached {
+					t.Fatalf("request %d: expected cached user", i)
+				}
+			}
+			tests.EvalObjects(t, "errors", tc.want, got)
+			tests.EvalObjects(t, "shadow counters", tc.shadowCounters, accessList.GetShadowCounters())
+		})
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	testcases := []struct {
+		name                  string
+		claims                string
+		validateSourceAddress bool
+		// The outcomes of Authenticate and Authorize.
+		want []error
+	}{
+		{
+			name:   "user allowed by access list",
+			claims: viewer,
+			want:   []error{nil, nil},
+		},
+		{
+			name:   "user denied by access list",
+			claims: editor,
+			want:   []error{nil, errors.ErrAccessNotAllowed},
+		},
+		{
+			name:                  "user without source address",
+			claims:                viewer,
+			validateSourceAddress: true,
+			want:                  []error{errors.ErrSourceAddressNotFound, errors.ErrSourceAddressNotFound},
+		},
+		{
+			name: "user without token",
+			want: []error{errors.ErrNoTokenFound, errors.ErrNoTokenFound},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			accessList := acl.NewAccessList()
+			accessList.SetLogger(utils.NewLogger())
+			if err := accessList.AddRules(ctx, defaultDenyACL); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			opts := options.NewTokenValidatorOptions()
+			opts.ValidateMethodPath = true
+			opts.ValidateSourceAddress = tc.validateSourceAddress
+			keys := testutils.NewTestCryptoKeyStore().GetKeys()
+			validator := NewTokenValidator()
+			if err := validator.Configure(ctx, keys, accessList, opts); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			req, err := http.NewRequest("GET", "/whoami", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.claims != "" {
+				usr, err := user.NewUser(tc.claims)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := keys[0].SignToken("HS512", usr); err != nil {
+					t.Fatal(err)
+				}
+				req.Header.Set("Authorization", fmt.Sprintf("access_token=%s", usr.Token))
+			}
+			var got []error
+			_, err = validator.Authenticate(ctx, req)
+			got = append(got, err)
+			_, err = validator.Authorize(ctx, req)
+			got = append(got, err)
+			tests.EvalObjects(t, "errors", tc.want, got)
+		})
+	}
+}
+
 func TestAddKeys(t *testing.T) {
 	testcases := []struct {
 		name                 string
@@ -21,6 +21,7 @@ import (
 	"github.com/caddyserver/caddy/v2/modules/caddyhttp"
 	"github.com/caddyserver/caddy/v2/modules/caddyhttp/caddyauth"
 	"github.com/greenpau/caddy-authorize/pkg/authz"
+	"github.com/greenpau/caddy-authorize/pkg/errors"
 	"github.com/satori/go.uuid"
 	"net/http"
 )
@@ -33,6 +34,9 @@ func init() {
 // the presence and content of JWT token.
 type AuthMiddleware struct {
 	Authorizer *authz.Authorizer `json:"authorizer,omitempty" xml:"authorizer,omitempty" yaml:"authorizer,omitempty"`
+	// The name of the authorization policy defined in the authorization app.
+	// The policy is used instead of the own configuration of the middleware.
+	Policy string `json:"policy,omitempty" xml:"policy,omitempty" yaml:"policy,omitempty"`
 }
 
 // CaddyModule returns the Caddy module information.
@@ -45,26 +49,47 @@ func (AuthMiddleware) CaddyModule() caddy.ModuleInfo {
 
 // Provision provisions JWT authorization provider
 func (m *AuthMiddleware) Provision(ctx caddy.Context) error {
+	if m.Policy != "" {
+		if m.Authorizer != nil {
+			return errors.ErrPolicyMixedConfig.WithArgs(m.Policy)
+		}
+		app, err := ctx.App(appName)
+		if err != nil {
+			return err
+		}
+		policy, err := app.(*App).getPolicy(m.Policy)
+		if err != nil {
+			return err
+		}
+		m.Authorizer = policy
+		return nil
+	}
 	opts := make(map[string]interface{})
 	opts["logger"] = ctx.Logger(m)
+	opts["context"] = ctx.Context
 	return m.Authorizer.Provision(opts)
 }
 
 // UnmarshalCaddyfile unmarshals a caddyfile
 func (m *AuthMiddleware) UnmarshalCaddyfile(d *caddyfile.Dispenser) (err error) {
 
-	authorizer, err := parseCaddyfile(httpcaddyfile.Helper{Dispenser: d})
+	mw, err := parseAuthorizeDirective(httpcaddyfile.Helper{Dispenser: d})
 	if err != nil {
 		return err
 	}
 
-	m.Authorizer = authorizer
+	m.Authorizer = mw.Authorizer
+	m.Policy = mw.Policy
 
 	return nil
 }
 
-// Validate implements caddy.Validator.
+// Validate implements caddy.Validator. The policies are validated by the
+// authorization app.
 func (m *AuthMiddleware) Validate() error {
+	if m.Policy != "" {
+		return nil
+	}
 	return m.Authorizer.Validate()
 }
 
@@ -73,6 +98,9 @@ func (m AuthMiddleware) Authenticate(w http.ResponseWriter, r *http.Request) (ca
 	reqID := GetRequestID(r)
 	opts := make(map[string]interface{})
 	opts["request_id"] = reqID
+	if repl, ok := r.Context().Value(caddy.ReplacerCtxKey).(*caddy.Replacer); ok {
+		opts["replacer"] = repl
+	}
 	user, authOK, err := m.Authorizer.Authenticate(w, r, opts)
 	if user == nil {
 		return caddyauth.User{}, authOK, err
@@ -93,10 +121,19 @@ func (m AuthMiddleware) Authenticate(w http.ResponseWriter, r *http.Request) (ca
 	return userIdentity, authOK, err
 }
 
+// Cleanup implements caddy.CleanerUpper.
+func (m *AuthMiddleware) Cleanup() error {
+	if m.Policy != "" {
+		return nil
+	}
+	return m.Authorizer.Cleanup()
+}
+
 // Interface guards
 var (
 	_ caddy.Provisioner       = (*AuthMiddleware)(nil)
 	_ caddy.Validator         = (*AuthMiddleware)(nil)
+	_ caddy.CleanerUpper      = (*AuthMiddleware)(nil)
 	_ caddyauth.Authenticator = (*AuthMiddleware)(nil)
 	_ caddyfile.Unmarshaler   = (*AuthMiddleware)(nil)
 )